@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package alt_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/alt"
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+	"github.com/katalvlaran/lvlath/internal/testutil"
+)
+
+// AI-HINTS (file):
+//   - dijkstra.Distances is the oracle for both bounds and query distances.
+//   - WithHeuristicCheck turns any consistency violation into a test failure.
+//   - Integer weights keep every comparison exact.
+
+// randomRoadNetwork builds a reproducible weighted road network with random
+// planar coordinates. mode "directed" and "undirected" give plain graphs with
+// one edge kind; only "mixed" enables per-edge direction overrides and loops.
+func randomRoadNetwork(t *testing.T, seed int64, vertexCount, edgeCount int, mode string) (*core.Graph, map[string][2]float64) {
+	t.Helper()
+
+	rng := rand.New(rand.NewSource(seed))
+	graph := testutil.RandomGraph(t, rng, testutil.Spec{
+		Vertices: vertexCount,
+		Edges:    edgeCount,
+		Mode:     testutil.Mode(mode),
+		Loops:    mode == string(testutil.Mixed),
+	})
+
+	coordinates := make(map[string][2]float64, vertexCount)
+	for index := 0; index < vertexCount; index++ {
+		coordinates[testutil.VertexID(index)] = [2]float64{rng.Float64(), rng.Float64()}
+	}
+
+	return graph, coordinates
+}
+
+// TestLandmarks_MatchDijkstra verifies bounds and query distances for every
+// strategy against dijkstra on random graphs.
+//
+// Implementation:
+//   - Stage 1: Build random plain directed, plain undirected, and mixed multigraphs.
+//   - Stage 2: Preprocess with each strategy.
+//   - Stage 3: For all pairs, LowerBound <= distance, +Inf bound only when
+//     unreachable, and ShortestPathTo (with heuristic check) matches dijkstra.
+func TestLandmarks_MatchDijkstra(t *testing.T) {
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 3; seed++ {
+			graph, coordinates := randomRoadNetwork(t, seed, 20, 45, mode)
+			position := alt.WithCoordinates(func(vertexID string) (float64, float64) {
+				return coordinates[vertexID][0], coordinates[vertexID][1]
+			})
+
+			for _, strategy := range []alt.Strategy{alt.StrategyFarthest, alt.StrategyRandom, alt.StrategyPlanar} {
+				landmarks, err := alt.Preprocess(graph, alt.WithLandmarkCount(4), alt.WithStrategy(strategy), position)
+				if err != nil {
+					t.Fatalf("%s/%d/%s Preprocess failed: %v", mode, seed, strategy, err)
+				}
+				if len(landmarks.IDs()) != 4 {
+					t.Fatalf("%s/%d/%s: %d landmarks, want 4", mode, seed, strategy, len(landmarks.IDs()))
+				}
+
+				for _, sourceID := range graph.Vertices() {
+					want, err := dijkstra.Distances(graph, sourceID)
+					if err != nil {
+						t.Fatalf("Distances failed: %v", err)
+					}
+					for _, targetID := range graph.Vertices() {
+						bound, err := landmarks.LowerBound(sourceID, targetID)
+						if err != nil || bound > want[targetID] {
+							t.Fatalf("%s/%d/%s bound %s->%s = %v (err=%v), distance %v",
+								mode, seed, strategy, sourceID, targetID, bound, err, want[targetID])
+						}
+
+						_, distance, err := landmarks.ShortestPathTo(graph, sourceID, targetID, dijkstra.WithHeuristicCheck())
+						if math.IsInf(want[targetID], 1) {
+							if !errors.Is(err, dijkstra.ErrNoPath) {
+								t.Fatalf("%s->%s: err=%v want ErrNoPath", sourceID, targetID, err)
+							}
+							continue
+						}
+						if err != nil || distance != want[targetID] {
+							t.Fatalf("%s/%d/%s %s->%s: got=%v err=%v want=%v",
+								mode, seed, strategy, sourceID, targetID, distance, err, want[targetID])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestLandmarks_PlainGraphsMatchShortestPathTo pins ALT on graphs without
+// mixed-edge mode, the common road-network shape.
+//
+// Implementation:
+//   - Stage 1: Preprocess a plain directed chain a->b->c, then random plain
+//     directed and undirected networks.
+//   - Stage 2: Every ShortestPathTo answer matches dijkstra.ShortestPathTo in
+//     distance and ErrNoPath, and its path joins source to target.
+func TestLandmarks_PlainGraphsMatchShortestPathTo(t *testing.T) {
+	chain, err := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	if err != nil {
+		t.Fatalf("NewGraph failed: %v", err)
+	}
+	_, _ = chain.AddEdge("a", "b", 1)
+	_, _ = chain.AddEdge("b", "c", 2)
+	graphs := map[string]*core.Graph{"chain": chain}
+	for _, mode := range []string{"directed", "undirected"} {
+		for seed := int64(11); seed <= 13; seed++ {
+			graphs[fmt.Sprintf("%s/%d", mode, seed)], _ = randomRoadNetwork(t, seed, 25, 60, mode)
+		}
+	}
+
+	for name, graph := range graphs {
+		landmarks, err := alt.Preprocess(graph, alt.WithLandmarkCount(3))
+		if err != nil {
+			t.Fatalf("%s Preprocess failed: %v", name, err)
+		}
+		for _, sourceID := range graph.Vertices() {
+			for _, targetID := range graph.Vertices() {
+				_, want, wantErr := dijkstra.ShortestPathTo(graph, sourceID, targetID)
+				path, got, err := landmarks.ShortestPathTo(graph, sourceID, targetID, dijkstra.WithHeuristicCheck())
+				if errors.Is(wantErr, dijkstra.ErrNoPath) {
+					if !errors.Is(err, dijkstra.ErrNoPath) {
+						t.Fatalf("%s %s->%s: err=%v want ErrNoPath", name, sourceID, targetID, err)
+					}
+					continue
+				}
+				if wantErr != nil || err != nil || got != want {
+					t.Fatalf("%s %s->%s: got=%v err=%v want=%v err=%v", name, sourceID, targetID, got, err, want, wantErr)
+				}
+				if path[0] != sourceID || path[len(path)-1] != targetID {
+					t.Fatalf("%s %s->%s: path %v", name, sourceID, targetID, path)
+				}
+			}
+		}
+	}
+}
+
+// TestLandmarks_RefreshAndValidation verifies Refresh after a weight change and
+// the sentinel contract.
+//
+// Implementation:
+//   - Stage 1: Preprocess a path A-B-C, then make A-B heavier and add a bypass.
+//   - Stage 2: Refreshed tables keep the landmarks and answer the new distance.
+//   - Stage 3: Assert option, input, and lookup sentinels.
+func TestLandmarks_RefreshAndValidation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	edgeID, _ := graph.AddEdge("A", "B", 1)
+	_, _ = graph.AddEdge("B", "C", 1)
+
+	landmarks, err := alt.Preprocess(graph, alt.WithLandmarkCount(10))
+	if err != nil {
+		t.Fatalf("Preprocess failed: %v", err)
+	}
+	if len(landmarks.IDs()) != 3 {
+		t.Fatalf("landmark count not capped at V: %v", landmarks.IDs())
+	}
+
+	_ = graph.RemoveEdge(edgeID)
+	_, _ = graph.AddEdge("A", "B", 10)
+	_, _ = graph.AddEdge("A", "D", 2)
+	_, _ = graph.AddEdge("D", "C", 2)
+	refreshed, err := landmarks.Refresh(graph)
+	if err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if fmt.Sprint(refreshed.IDs()) != fmt.Sprint(landmarks.IDs()) {
+		t.Fatalf("Refresh changed landmarks: %v -> %v", landmarks.IDs(), refreshed.IDs())
+	}
+	path, distance, err := refreshed.ShortestPathTo(graph, "A", "C", dijkstra.WithHeuristicCheck())
+	if err != nil || distance != 4 || fmt.Sprint(path) != "[A D C]" {
+		t.Fatalf("refreshed query: path=%v distance=%v err=%v", path, distance, err)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	unweighted, _ := core.NewGraph()
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{"nil graph", alt.ErrNilGraph, func() error { _, e := alt.Preprocess(nil); return e }},
+		{"unweighted", alt.ErrUnweightedGraph, func() error { _, e := alt.Preprocess(unweighted); return e }},
+		{"nil option", alt.ErrNilOption, func() error { _, e := alt.Preprocess(graph, nil); return e }},
+		{"bad count", alt.ErrBadLandmarkCount, func() error { _, e := alt.Preprocess(graph, alt.WithLandmarkCount(0)); return e }},
+		{"bad strategy", alt.ErrUnsupportedStrategy, func() error {
+			_, e := alt.Preprocess(graph, alt.WithStrategy("nearest"))
+			return e
+		}},
+		{"planar without coordinates", alt.ErrMissingCoordinates, func() error {
+			_, e := alt.Preprocess(graph, alt.WithStrategy(alt.StrategyPlanar))
+			return e
+		}},
+		{"nil coordinates", alt.ErrNilCoordinates, func() error { _, e := alt.Preprocess(graph, alt.WithCoordinates(nil)); return e }},
+		{"invalid coordinate", alt.ErrInvalidCoordinate, func() error {
+			_, e := alt.Preprocess(graph, alt.WithStrategy(alt.StrategyPlanar),
+				alt.WithCoordinates(func(string) (float64, float64) { return math.NaN(), 0 }))
+			return e
+		}},
+		{"cancelled", context.Canceled, func() error { _, e := alt.Preprocess(graph, alt.WithContext(cancelled)); return e }},
+		{"unknown target", alt.ErrVertexNotFound, func() error { _, e := refreshed.Heuristic("Q"); return e }},
+		{"empty vertex", alt.ErrEmptyVertexID, func() error { _, e := refreshed.LowerBound("", "A"); return e }},
+		{"unknown source", dijkstra.ErrSourceNotFound, func() error {
+			_, _, e := refreshed.ShortestPathTo(graph, "Q", "A")
+			return e
+		}},
+		{"refresh cancelled", context.Canceled, func() error { _, e := refreshed.Refresh(graph, alt.WithContext(cancelled)); return e }},
+		{"refresh nil context", alt.ErrNilContext, func() error {
+			_, e := refreshed.Refresh(graph, alt.WithContext(nil)) //nolint:staticcheck // nil is the case under test.
+			return e
+		}},
+		{"nil landmarks", alt.ErrNilLandmarks, func() error { _, e := (*alt.Landmarks)(nil).Refresh(graph); return e }},
+		{"landmark removed", alt.ErrLandmarkNotFound, func() error {
+			_ = graph.RemoveVertex(refreshed.IDs()[0])
+			_, e := refreshed.Refresh(graph)
+			return e
+		}},
+	}
+	for _, tc := range cases {
+		if err = tc.run(); !errors.Is(err, tc.err) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.err)
+		}
+	}
+}
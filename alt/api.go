@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package alt
+
+import "github.com/katalvlaran/lvlath/core"
+
+// Preprocess selects landmarks on g and computes their forward and backward
+// distance tables.
+//
+// Implementation:
+//   - Stage 1: Validate the graph and assemble options.
+//   - Stage 2: Select min(Count, V) landmarks with the configured strategy.
+//   - Stage 3: Run dijkstra.Distances from every landmark on g and, when g has
+//     directed edges, on its reverse.
+//
+// Behavior highlights:
+//   - Directed edges are traversed From->To; undirected edges both ways.
+//   - Graphs without directed edges need only k runs; tables are shared.
+//
+// Inputs:
+//   - g: a weighted graph with finite non-negative weights.
+//   - opts: WithLandmarkCount, WithStrategy, WithSeed, WithCoordinates, WithContext.
+//
+// Returns:
+//   - *Landmarks: immutable tables; query through ShortestPathTo or Heuristic.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph.
+//   - ErrNilOption, ErrBadLandmarkCount, ErrUnsupportedStrategy, ErrNilCoordinates,
+//     ErrMissingCoordinates, ErrNilContext, ErrInvalidCoordinate.
+//   - Any error returned by dijkstra.Distances (e.g. dijkstra.ErrNegativeWeight).
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Equal graphs and options give equal landmarks and tables.
+//
+// Complexity:
+//   - Time O(k (V + E) log V), Space O(k V + E).
+//
+// AI-Hints:
+//   - 8-16 landmarks is the usual sweet spot; bounds tighten slowly beyond that
+//     while memory grows linearly.
+//   - For static graphs with very high query volume, ch answers faster.
+func Preprocess(g *core.Graph, opts ...Option) (*Landmarks, error) {
+	if err := validateGraph(g); err != nil {
+		return nil, err
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runPreprocess(g, config)
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package alt implements ALT (A*, Landmarks, Triangle inequality) goal-directed
+// shortest-path search over core.Graph.
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - Preprocess(g, opts...) selects landmarks and stores, for each landmark L,
+//     d(L, v) and d(v, L) for every vertex v.
+//   - Landmarks.ShortestPathTo runs dijkstra.AStar with the landmark heuristic.
+//   - Landmarks.Heuristic / LowerBound expose the bound for custom searches.
+//   - Landmarks.Refresh recomputes the tables for the same landmarks after the
+//     graph's weights change; WithContext makes it cancellable.
+//
+// -----------------------------------------------------------------------------
+// -- WHY ----------------------------------------------------------------------
+//
+// Contraction hierarchies answer queries fastest but must be rebuilt after every
+// weight change. ALT preprocessing is just 2k Dijkstra runs, so it suits graphs
+// whose weights change daily while still steering A* strongly towards the target.
+//
+// -----------------------------------------------------------------------------
+// -- HOW ----------------------------------------------------------------------
+//
+//   - Triangle inequality: d(v, t) >= d(L, t) - d(L, v) and
+//     d(v, t) >= d(v, L) - d(t, L). The maximum over landmarks (and 0) is a
+//     consistent A* heuristic.
+//   - Forward tables come from dijkstra.Distances on g, backward tables from
+//     dijkstra.Distances on g with directed edges flipped; without directed
+//     edges the tables coincide.
+//   - A +Inf bound proves the target unreachable; ShortestPathTo prunes such
+//     vertices with dijkstra.WithVertexFilter.
+//
+// Strategies:
+//
+//   - StrategyFarthest (default), StrategyRandom (seeded), StrategyPlanar
+//     (angular sectors, requires WithCoordinates).
+//
+// Options:
+//
+//   - WithLandmarkCount(count), WithStrategy(strategy), WithSeed(seed),
+//     WithCoordinates(fn), WithContext(ctx)
+//
+// Errors:
+//
+//   - ErrNilGraph, ErrUnweightedGraph
+//   - ErrNilOption, ErrBadLandmarkCount, ErrUnsupportedStrategy, ErrNilCoordinates,
+//     ErrMissingCoordinates, ErrInvalidCoordinate, ErrNilContext
+//   - ErrNilLandmarks, ErrEmptyVertexID, ErrVertexNotFound, ErrLandmarkNotFound
+//   - dijkstra errors from preprocessing and queries (e.g. dijkstra.ErrNoPath)
+//
+// Complexity:
+//
+//   - Preprocess / Refresh: O(k (V + E) log V) time, O(k V) space.
+//   - Query: A* with O(k) per heuristic evaluation.
+//
+// AI-Hints:
+//   - Refresh after every weight change; stale tables may overestimate.
+//   - For static graphs with very high query volume, prefer ch.
+package alt
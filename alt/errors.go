@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package alt
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	//
+	// AI-Hints:
+	//   - This is an input-contract failure detected before any allocation.
+	ErrNilGraph = errors.New("alt: graph is nil")
+
+	// ErrUnweightedGraph reports that the graph does not expose weighted edges.
+	ErrUnweightedGraph = errors.New("alt: graph must be weighted")
+
+	// ErrNilOption reports that a nil Option was passed.
+	ErrNilOption = errors.New("alt: option is nil")
+
+	// ErrBadLandmarkCount reports a landmark count below one.
+	ErrBadLandmarkCount = errors.New("alt: landmark count must be >= 1")
+
+	// ErrUnsupportedStrategy reports an unknown selection strategy.
+	ErrUnsupportedStrategy = errors.New("alt: unsupported landmark strategy")
+
+	// ErrNilCoordinates reports that WithCoordinates received a nil function.
+	ErrNilCoordinates = errors.New("alt: coordinate function is nil")
+
+	// ErrMissingCoordinates reports StrategyPlanar without WithCoordinates.
+	//
+	// AI-Hints:
+	//   - Planar selection partitions the plane into sectors; it needs positions.
+	ErrMissingCoordinates = errors.New("alt: planar strategy requires coordinates")
+
+	// ErrInvalidCoordinate reports a NaN or infinite vertex coordinate.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending vertex ID.
+	ErrInvalidCoordinate = errors.New("alt: coordinate is NaN or Inf")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	ErrNilContext = errors.New("alt: context is nil")
+
+	// ErrNilLandmarks reports a method call on a nil *Landmarks.
+	ErrNilLandmarks = errors.New("alt: landmarks are nil")
+
+	// ErrEmptyVertexID reports that the caller passed an empty vertex ID.
+	ErrEmptyVertexID = errors.New("alt: vertex id is empty")
+
+	// ErrVertexNotFound reports a vertex that was not part of the graph when the
+	// landmark tables were computed.
+	//
+	// AI-Hints:
+	//   - Call Refresh after adding vertices.
+	ErrVertexNotFound = errors.New("alt: vertex not in landmark tables")
+
+	// ErrLandmarkNotFound reports that Refresh received a graph without one of
+	// the selected landmarks.
+	//
+	// AI-Hints:
+	//   - Run Preprocess again to select a new landmark set.
+	ErrLandmarkNotFound = errors.New("alt: landmark vertex not found")
+)
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package alt_test
+
+import (
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/alt"
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// ExamplePreprocess guides a route with landmarks, then refreshes the tables
+// after the daily weight update closes the fast road.
+func ExamplePreprocess() {
+	roads, _ := core.NewGraph(core.WithWeighted())
+	fastID, _ := roads.AddEdge("Depot", "Ring", 2)
+	_, _ = roads.AddEdge("Ring", "Harbor", 2)
+	_, _ = roads.AddEdge("Depot", "Old Town", 3)
+	_, _ = roads.AddEdge("Old Town", "Harbor", 3)
+
+	landmarks, _ := alt.Preprocess(roads, alt.WithLandmarkCount(2))
+	path, distance, _ := landmarks.ShortestPathTo(roads, "Depot", "Harbor")
+	fmt.Println(path, distance)
+
+	_ = roads.RemoveEdge(fastID)
+	_, _ = roads.AddEdge("Depot", "Ring", 9)
+	landmarks, _ = landmarks.Refresh(roads)
+	path, distance, _ = landmarks.ShortestPathTo(roads, "Depot", "Harbor")
+	fmt.Println(path, distance)
+
+	// Output:
+	// [Depot Ring Harbor] 4
+	// [Depot Old Town Harbor] 6
+}
@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package alt
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// validateGraph checks the graph contract shared by Preprocess and Refresh.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph.
+func validateGraph(g *core.Graph) error {
+	if g == nil {
+		return ErrNilGraph
+	}
+	if !g.Weighted() {
+		return ErrUnweightedGraph
+	}
+
+	return nil
+}
+
+// runPreprocess selects landmarks and computes their tables.
+//
+// Implementation:
+//   - Stage 1: Select min(Count, V) landmarks with the configured strategy,
+//     keeping any forward rows the strategy already computed.
+//   - Stage 2: Fill the missing forward rows and every backward row.
+//
+// Errors:
+//   - ErrInvalidCoordinate (planar), ctx.Err(), dijkstra.Distances errors.
+//
+// Complexity:
+//   - Time O(k (V + E) log V), Space O(k V).
+func runPreprocess(g *core.Graph, config Options) (*Landmarks, error) {
+	vertexIDs := g.Vertices()
+	count := min(config.Count, len(vertexIDs))
+	forward := make(map[string][]float64)
+
+	var ids []string
+	var err error
+	switch config.Strategy {
+	case StrategyRandom:
+		rng := rand.New(rand.NewSource(config.Seed))
+		for _, position := range rng.Perm(len(vertexIDs))[:count] {
+			ids = append(ids, vertexIDs[position])
+		}
+	case StrategyPlanar:
+		ids, err = selectPlanar(g, vertexIDs, count, config)
+	default:
+		ids, err = selectFarthest(g, vertexIDs, count, forward, config)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buildTables(g, ids, forward, config)
+}
+
+// selectFarthest picks each landmark as the vertex farthest from the set chosen
+// so far, starting from the vertex farthest from g.Vertices()[0].
+//
+// Behavior highlights:
+//   - Distance to the set is min over chosen landmarks of d(L, v); vertices no
+//     landmark reaches score +Inf and are preferred, so every weak island of a
+//     disconnected graph gets a landmark when count allows.
+//   - Ties go to the smallest vertex ID.
+//
+// Complexity:
+//   - Time O(k (V + E) log V), Space O(V) plus the cached rows.
+func selectFarthest(
+	g *core.Graph,
+	vertexIDs []string,
+	count int,
+	forward map[string][]float64,
+	config Options,
+) ([]string, error) {
+	if count == 0 {
+		return nil, nil
+	}
+
+	score := make([]float64, len(vertexIDs))
+	start, err := distanceRow(g, vertexIDs[0], vertexIDs, config)
+	if err != nil {
+		return nil, err
+	}
+	copy(score, start)
+
+	chosen := make(map[string]bool, count)
+	var ids []string
+	for len(ids) < count {
+		next := argmax(vertexIDs, score, chosen)
+		ids = append(ids, vertexIDs[next])
+		chosen[vertexIDs[next]] = true
+		if len(ids) == count {
+			break
+		}
+
+		row, err := distanceRow(g, vertexIDs[next], vertexIDs, config)
+		if err != nil {
+			return nil, err
+		}
+		forward[vertexIDs[next]] = row
+		if len(ids) == 1 {
+			copy(score, row)
+			continue
+		}
+		for vertex, distance := range row {
+			score[vertex] = math.Min(score[vertex], distance)
+		}
+	}
+
+	return ids, nil
+}
+
+// selectPlanar splits the plane around a central vertex into count equal
+// angular sectors and takes, per sector, the vertex farthest from the center by
+// graph distance. Empty sectors are filled with the farthest remaining vertices.
+//
+// Implementation:
+//   - Stage 1: Read and validate every coordinate; the center is the vertex
+//     nearest to the centroid.
+//   - Stage 2: One forward Dijkstra from the center scores every vertex.
+//   - Stage 3: Pick the best vertex per sector, then fill up to count.
+//
+// Errors:
+//   - ErrInvalidCoordinate wrapped with the vertex ID; dijkstra.Distances errors.
+//
+// Complexity:
+//   - Time O(V + (V + E) log V), Space O(V).
+func selectPlanar(
+	g *core.Graph,
+	vertexIDs []string,
+	count int,
+	config Options,
+) ([]string, error) {
+	if count == 0 {
+		return nil, nil
+	}
+
+	xs, ys := make([]float64, len(vertexIDs)), make([]float64, len(vertexIDs))
+	centroidX, centroidY := 0.0, 0.0
+	for vertex, vertexID := range vertexIDs {
+		x, y := config.Coordinates(vertexID)
+		if math.IsNaN(x) || math.IsInf(x, 0) || math.IsNaN(y) || math.IsInf(y, 0) {
+			return nil, fmt.Errorf("%w: vertex=%q", ErrInvalidCoordinate, vertexID)
+		}
+		xs[vertex], ys[vertex] = x, y
+		centroidX += x / float64(len(vertexIDs))
+		centroidY += y / float64(len(vertexIDs))
+	}
+
+	center, nearest := 0, math.Inf(1)
+	for vertex := range vertexIDs {
+		if distance := math.Hypot(xs[vertex]-centroidX, ys[vertex]-centroidY); distance < nearest {
+			center, nearest = vertex, distance
+		}
+	}
+	score, err := distanceRow(g, vertexIDs[center], vertexIDs, config)
+	if err != nil {
+		return nil, err
+	}
+
+	best := make([]int, count)
+	for sector := range best {
+		best[sector] = -1
+	}
+	for vertex := range vertexIDs {
+		if vertex == center {
+			continue
+		}
+		angle := math.Atan2(ys[vertex]-ys[center], xs[vertex]-xs[center])
+		sector := min(int((angle+math.Pi)/(2*math.Pi)*float64(count)), count-1)
+		if best[sector] < 0 || score[vertex] > score[best[sector]] {
+			best[sector] = vertex
+		}
+	}
+
+	chosen := make(map[string]bool, count)
+	var ids []string
+	for _, vertex := range best {
+		if vertex >= 0 {
+			ids = append(ids, vertexIDs[vertex])
+			chosen[vertexIDs[vertex]] = true
+		}
+	}
+	for len(ids) < count {
+		next := argmax(vertexIDs, score, chosen)
+		ids = append(ids, vertexIDs[next])
+		chosen[vertexIDs[next]] = true
+	}
+
+	return ids, nil
+}
+
+// argmax returns the unchosen vertex with the largest score, smallest index on ties.
+func argmax(vertexIDs []string, score []float64, chosen map[string]bool) int {
+	best := -1
+	for vertex, vertexID := range vertexIDs {
+		if chosen[vertexID] {
+			continue
+		}
+		if best < 0 || score[vertex] > score[best] {
+			best = vertex
+		}
+	}
+
+	return best
+}
+
+// buildTables computes forward and backward distance rows for every landmark.
+//
+// Implementation:
+//   - Stage 1: Reuse cached forward rows; compute the rest with dijkstra.Distances.
+//   - Stage 2: Without directed edges, backward rows alias forward rows.
+//     Otherwise run dijkstra.Distances on the reversed graph.
+//
+// Errors:
+//   - ctx.Err() between runs; any error returned by dijkstra.Distances.
+//
+// Complexity:
+//   - Time O(k (V + E) log V), Space O(k V + E).
+func buildTables(g *core.Graph, ids []string, forward map[string][]float64, config Options) (*Landmarks, error) {
+	vertexIDs := g.Vertices()
+	index := make(map[string]int, len(vertexIDs))
+	for position, vertexID := range vertexIDs {
+		index[vertexID] = position
+	}
+
+	result := &Landmarks{
+		ids:       ids,
+		vertexIDs: vertexIDs,
+		index:     index,
+		from:      make([][]float64, len(ids)),
+		to:        make([][]float64, len(ids)),
+	}
+	for landmark, landmarkID := range ids {
+		row, ok := forward[landmarkID]
+		if !ok {
+			var err error
+			if row, err = distanceRow(g, landmarkID, vertexIDs, config); err != nil {
+				return nil, err
+			}
+		}
+		result.from[landmark] = row
+	}
+
+	if !g.Directed() && !g.HasDirectedEdges() {
+		copy(result.to, result.from)
+		return result, nil
+	}
+
+	reversed, err := reverseGraph(g)
+	if err != nil {
+		return nil, err
+	}
+	for landmark, landmarkID := range ids {
+		if result.to[landmark], err = distanceRow(reversed, landmarkID, vertexIDs, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// distanceRow runs dijkstra.Distances from sourceID and lays the result out in
+// vertexIDs order.
+//
+// Errors:
+//   - ctx.Err() before the run; any error returned by dijkstra.Distances.
+func distanceRow(g *core.Graph, sourceID string, vertexIDs []string, config Options) ([]float64, error) {
+	if err := config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	distances, err := dijkstra.Distances(g, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	row := make([]float64, len(vertexIDs))
+	for vertex, vertexID := range vertexIDs {
+		row[vertex] = distances[vertexID]
+	}
+
+	return row, nil
+}
+
+// reverseGraph returns a copy of g with every directed edge flipped; undirected
+// edges and edge IDs are kept.
+//
+// Complexity:
+//   - Time O(V + E log E), Space O(V + E).
+func reverseGraph(g *core.Graph) (*core.Graph, error) {
+	reversed := g.CloneEmpty()
+	mixed := g.MixedEdges()
+	for _, edge := range g.Edges() {
+		from, to := edge.From, edge.To
+		if edge.Directed {
+			from, to = to, from
+		}
+		// Per-edge direction overrides are legal only on mixed-mode graphs;
+		// elsewhere the cloned graph default already matches every edge.
+		edgeOpts := []core.EdgeOption{core.WithID(edge.ID)}
+		if mixed {
+			edgeOpts = append(edgeOpts, core.WithEdgeDirected(edge.Directed))
+		}
+		if _, err := reversed.AddEdge(from, to, edge.Weight, edgeOpts...); err != nil {
+			return nil, err
+		}
+	}
+
+	return reversed, nil
+}
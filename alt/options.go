@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package alt
+
+import "context"
+
+// Strategy identifies how landmarks are selected.
+//
+// Behavior highlights:
+//   - StrategyFarthest is the default: each new landmark is the vertex farthest
+//     from the landmarks chosen so far; unreachable vertices come first.
+//   - StrategyRandom draws distinct vertices with a seeded generator.
+//   - StrategyPlanar splits the plane around a central vertex into equal
+//     angular sectors and takes the vertex farthest by graph distance in each.
+//
+// AI-Hints:
+//   - Farthest is a robust default; Planar wins on road maps with coordinates.
+type Strategy string
+
+const (
+	// StrategyFarthest selects landmarks by repeated farthest-vertex search.
+	StrategyFarthest Strategy = "farthest"
+
+	// StrategyRandom selects landmarks uniformly at random.
+	StrategyRandom Strategy = "random"
+
+	// StrategyPlanar selects one landmark per angular sector around the center.
+	StrategyPlanar Strategy = "planar"
+)
+
+// DefaultLandmarkCount is the number of landmarks selected when WithLandmarkCount
+// is not given.
+const DefaultLandmarkCount = 16
+
+// Options holds the effective preprocessing policy.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value is not valid.
+type Options struct {
+	// Count is the requested number of landmarks, capped at the vertex count.
+	Count int
+
+	// Strategy selects landmarks.
+	Strategy Strategy
+
+	// Seed drives StrategyRandom.
+	Seed int64
+
+	// Coordinates returns the planar position of a vertex (StrategyPlanar only).
+	Coordinates func(vertexID string) (x, y float64)
+
+	// ctx allows cancellation between single-source runs.
+	ctx context.Context
+}
+
+// Option configures preprocessing through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns the canonical policy: DefaultLandmarkCount landmarks,
+// StrategyFarthest, Seed 1, no coordinates, and context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{
+		Count:    DefaultLandmarkCount,
+		Strategy: StrategyFarthest,
+		Seed:     1,
+		ctx:      context.Background(),
+	}
+}
+
+// WithLandmarkCount sets the number of landmarks.
+//
+// Behavior highlights:
+//   - More landmarks give tighter bounds at 2 * count distances per vertex.
+//
+// Errors:
+//   - ErrBadLandmarkCount if count < 1.
+func WithLandmarkCount(count int) Option {
+	return func(o *Options) error {
+		if count < 1 {
+			return ErrBadLandmarkCount
+		}
+		o.Count = count
+		return nil
+	}
+}
+
+// WithStrategy selects the landmark selection strategy.
+//
+// Errors:
+//   - ErrUnsupportedStrategy for values other than the Strategy constants.
+func WithStrategy(strategy Strategy) Option {
+	return func(o *Options) error {
+		switch strategy {
+		case StrategyFarthest, StrategyRandom, StrategyPlanar:
+			o.Strategy = strategy
+			return nil
+		default:
+			return ErrUnsupportedStrategy
+		}
+	}
+}
+
+// WithSeed sets the seed used by StrategyRandom.
+func WithSeed(seed int64) Option {
+	return func(o *Options) error {
+		o.Seed = seed
+		return nil
+	}
+}
+
+// WithCoordinates supplies vertex positions for StrategyPlanar.
+//
+// Errors:
+//   - ErrNilCoordinates if coordinates is nil.
+//
+// AI-Hints:
+//   - Coordinates only steer selection; bounds always come from graph distances.
+func WithCoordinates(coordinates func(vertexID string) (x, y float64)) Option {
+	return func(o *Options) error {
+		if coordinates == nil {
+			return ErrNilCoordinates
+		}
+		o.Coordinates = coordinates
+		return nil
+	}
+}
+
+// WithContext sets a cancellation context checked between single-source runs.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//   - ErrMissingCoordinates for StrategyPlanar without coordinates.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+	if config.Strategy == StrategyPlanar && config.Coordinates == nil {
+		return Options{}, ErrMissingCoordinates
+	}
+
+	return config, nil
+}
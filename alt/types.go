@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package alt
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// Landmarks holds the selected landmark vertices and their distance tables.
+//
+// Behavior highlights:
+//   - from[i][v] = d(landmark i, v) and to[i][v] = d(v, landmark i); on graphs
+//     without directed edges both tables share storage.
+//   - By the triangle inequality, d(v, t) >= d(L, t) - d(L, v) and
+//     d(v, t) >= d(v, L) - d(t, L) for every landmark L; the bound is the
+//     maximum over all landmarks and 0.
+//
+// Inputs:
+//   - Constructed through Preprocess or Refresh only.
+//
+// Determinism:
+//   - Equal graphs and options give equal landmarks and tables.
+//
+// Complexity:
+//   - Space O(k * V) for k landmarks.
+//
+// Notes:
+//   - Tables describe the graph at preprocessing time. After weight changes,
+//     call Refresh; stale tables can overestimate and break optimality.
+//   - Immutable after construction, so concurrent queries are safe.
+//
+// AI-Hints:
+//   - Refresh is 2k Dijkstra runs: far cheaper than rebuilding a hierarchy,
+//     which makes ALT the right fit for graphs whose weights change daily.
+type Landmarks struct {
+	ids       []string
+	vertexIDs []string
+	index     map[string]int
+	from      [][]float64
+	to        [][]float64
+}
+
+// IDs returns the landmark vertex IDs in selection order.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func (l *Landmarks) IDs() []string {
+	if l == nil {
+		return nil
+	}
+
+	return append([]string(nil), l.ids...)
+}
+
+// LowerBound returns the landmark lower bound on d(vertexID, targetID).
+//
+// Behavior highlights:
+//   - +Inf is returned only when the tables prove targetID unreachable from
+//     vertexID.
+//
+// Errors:
+//   - ErrNilLandmarks, ErrEmptyVertexID, ErrVertexNotFound.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func (l *Landmarks) LowerBound(vertexID, targetID string) (float64, error) {
+	if l == nil {
+		return 0, ErrNilLandmarks
+	}
+	vertex, err := l.lookup(vertexID)
+	if err != nil {
+		return 0, err
+	}
+	target, err := l.lookup(targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	return l.bound(vertex, target), nil
+}
+
+// Heuristic returns a consistent dijkstra.Heuristic towards targetID.
+//
+// Behavior highlights:
+//   - Vertices proven unable to reach targetID are estimated 0, as are vertices
+//     absent from the tables. Such vertices never lie on a path to targetID,
+//     so A* results are unaffected.
+//
+// Errors:
+//   - ErrNilLandmarks, ErrEmptyVertexID, ErrVertexNotFound for targetID.
+//
+// Complexity:
+//   - Time O(1) to build; each call is O(k).
+//
+// AI-Hints:
+//   - ShortestPathTo additionally prunes proven dead ends; prefer it unless you
+//     need to pass the heuristic to dijkstra.AStar yourself.
+func (l *Landmarks) Heuristic(targetID string) (dijkstra.Heuristic, error) {
+	if l == nil {
+		return nil, ErrNilLandmarks
+	}
+	target, err := l.lookup(targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(vertexID string) float64 {
+		vertex, ok := l.index[vertexID]
+		if !ok {
+			return 0
+		}
+		if estimate := l.bound(vertex, target); !math.IsInf(estimate, 1) {
+			return estimate
+		}
+		return 0
+	}, nil
+}
+
+// ShortestPathTo runs dijkstra.AStar from sourceID to targetID guided by the
+// landmark heuristic.
+//
+// Implementation:
+//   - Stage 1: Build the heuristic for targetID.
+//   - Stage 2: Reject a source proven unable to reach targetID.
+//   - Stage 3: Prune proven dead ends with a vertex filter and delegate to AStar.
+//
+// Behavior highlights:
+//   - The distance equals dijkstra.ShortestPathTo on g, provided the tables are
+//     current (see Refresh).
+//   - opts are appended after the internal vertex filter, so a caller
+//     WithVertexFilter replaces the dead-end pruning.
+//
+// Returns:
+//   - []string: one shortest-path witness.
+//   - float64: the shortest-path distance.
+//
+// Errors:
+//   - ErrNilLandmarks; ErrEmptyVertexID, ErrVertexNotFound for targetID.
+//   - dijkstra.ErrNoPath for an unreachable target.
+//   - Any error returned by dijkstra.AStar.
+//
+// Complexity:
+//   - Worst case that of AStar; typically a small fraction of the vertices
+//     Dijkstra would settle.
+func (l *Landmarks) ShortestPathTo(
+	g *core.Graph,
+	sourceID, targetID string,
+	opts ...dijkstra.Option,
+) ([]string, float64, error) {
+	heuristic, err := l.Heuristic(targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	target := l.index[targetID]
+
+	if source, ok := l.index[sourceID]; ok && math.IsInf(l.bound(source, target), 1) {
+		return nil, 0, dijkstra.ErrNoPath
+	}
+
+	prune := dijkstra.WithVertexFilter(func(vertexID string) bool {
+		vertex, ok := l.index[vertexID]
+		return !ok || !math.IsInf(l.bound(vertex, target), 1)
+	})
+
+	return dijkstra.AStar(g, sourceID, targetID, heuristic, append([]dijkstra.Option{prune}, opts...)...)
+}
+
+// Refresh recomputes the distance tables on g for the same landmark set.
+//
+// Behavior highlights:
+//   - Landmark selection is kept; only the 2k single-source runs are repeated.
+//   - Vertices added since preprocessing enter the tables.
+//
+// Inputs:
+//   - g: the updated graph.
+//   - opts: WithContext cancels between runs; selection options are validated
+//     but have no effect because the landmark set is kept.
+//
+// Errors:
+//   - ErrNilLandmarks, ErrNilGraph, ErrUnweightedGraph.
+//   - Option errors, as for Preprocess.
+//   - ErrLandmarkNotFound, wrapped with the landmark ID.
+//   - Any error returned by dijkstra.Distances.
+//   - ctx.Err() on cancellation.
+//
+// Complexity:
+//   - Time O(k (V + E) log V), Space O(k V).
+func (l *Landmarks) Refresh(g *core.Graph, opts ...Option) (*Landmarks, error) {
+	if l == nil {
+		return nil, ErrNilLandmarks
+	}
+	if err := validateGraph(g); err != nil {
+		return nil, err
+	}
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, landmarkID := range l.ids {
+		if !g.HasVertex(landmarkID) {
+			return nil, fmt.Errorf("%w: %q", ErrLandmarkNotFound, landmarkID)
+		}
+	}
+
+	return buildTables(g, l.ids, nil, config)
+}
+
+// lookup maps a vertex ID to its table column.
+func (l *Landmarks) lookup(vertexID string) (int, error) {
+	if vertexID == "" {
+		return 0, ErrEmptyVertexID
+	}
+	vertex, ok := l.index[vertexID]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrVertexNotFound, vertexID)
+	}
+
+	return vertex, nil
+}
+
+// bound is the maximum landmark lower bound on d(vertex, target), at least 0.
+// NaN terms (both distances infinite) carry no information and are skipped.
+func (l *Landmarks) bound(vertex, target int) float64 {
+	best := 0.0
+	for landmark := range l.ids {
+		if estimate := l.from[landmark][target] - l.from[landmark][vertex]; estimate > best {
+			best = estimate
+		}
+		if estimate := l.to[landmark][vertex] - l.to[landmark][target]; estimate > best {
+			best = estimate
+		}
+	}
+
+	return best
+}
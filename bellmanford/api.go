@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package bellmanford
+
+import "github.com/katalvlaran/lvlath/core"
+
+// BellmanFord computes single-source shortest paths on a weighted graph whose
+// edges may carry negative weights.
+//
+// Implementation:
+//   - Stage 1: Validate the graph and source; assemble options.
+//   - Stage 2: Snapshot the graph into dense arcs, rejecting NaN/Inf weights.
+//   - Stage 3: Relax with the configured schedule (SPFA by default).
+//   - Stage 4: Publish distances and the shortest-path tree, or the negative
+//     cycle witness.
+//
+// Behavior highlights:
+//   - Directed edges are traversed From->To; undirected edges both ways, so an
+//     undirected negative edge reachable from the source is a negative cycle.
+//   - Only cycles reachable from the source are reported; negative cycles in
+//     unreachable regions do not affect the result.
+//   - Unreachable vertices keep +Inf.
+//
+// Inputs:
+//   - g: a weighted graph.
+//   - sourceID: an existing source vertex.
+//   - opts: WithAlgorithm, WithContext.
+//
+// Returns:
+//   - *Result: distances, Prev, and PrevEdge on success.
+//   - On ErrNegativeCycle: a Result whose NegativeCycle holds the witness and
+//     whose maps are nil.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrEmptySourceID, ErrSourceNotFound.
+//   - ErrNilOption, ErrUnsupportedAlgorithm, ErrNilContext.
+//   - ErrInvalidWeight, ErrDistanceOverflow.
+//   - ErrNegativeCycle wrapped with the witness summary.
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Arc order, queue order, and witness rotation are fixed, so equal inputs
+//     yield equal results and witnesses.
+//
+// Complexity:
+//   - Time O(VE) worst case, Space O(V + E).
+//
+// AI-Hints:
+//   - Use dijkstra when all weights are non-negative; it is asymptotically faster.
+//   - Read the witness with errors.Is(err, ErrNegativeCycle) and result.NegativeCycle.
+func BellmanFord(g *core.Graph, sourceID string, opts ...Option) (*Result, error) {
+	if err := validateInputs(g, sourceID); err != nil {
+		return nil, err
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := newKernel(g, config)
+	if err != nil {
+		return nil, err
+	}
+
+	source := 0
+	for position, vertexID := range k.ids {
+		if vertexID == sourceID {
+			source = position
+			break
+		}
+	}
+
+	cycle, err := k.run([]int{source})
+	if err != nil {
+		return nil, err
+	}
+	if cycle != nil {
+		return &Result{SourceID: sourceID, NegativeCycle: cycle}, negativeCycleError(cycle)
+	}
+
+	return k.publish(sourceID, true), nil
+}
+
+// Potentials computes h(v) = min over all vertices u of dist(u, v), the
+// distances from a virtual source joined to every vertex by a zero-weight edge.
+//
+// Implementation:
+//   - Stage 1: Validate the graph; assemble options.
+//   - Stage 2: Seed every vertex at distance 0 and run the configured schedule.
+//   - Stage 3: Publish the potentials, or the negative cycle witness.
+//
+// Behavior highlights:
+//   - Every potential is finite and <= 0.
+//   - For every traversable arc u->v of weight w: w + h(u) - h(v) >= 0, which is
+//     the reweighting invariant used by Johnson's algorithm.
+//   - Any negative cycle in the graph is reported, reachable or not.
+//
+// Inputs:
+//   - g: a weighted graph.
+//   - opts: WithAlgorithm, WithContext.
+//
+// Returns:
+//   - *Result: SourceID is empty and Prev/PrevEdge are nil; Distances holds h.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, option errors, ErrInvalidWeight,
+//     ErrDistanceOverflow, ctx.Err().
+//   - ErrNegativeCycle with the witness in Result.NegativeCycle.
+//
+// Determinism:
+//   - Deterministic for the same graph and options.
+//
+// Complexity:
+//   - Time O(VE) worst case, Space O(V + E).
+func Potentials(g *core.Graph, opts ...Option) (*Result, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if !g.Weighted() {
+		return nil, ErrUnweightedGraph
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := newKernel(g, config)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := make([]int, len(k.ids))
+	for position := range seeds {
+		seeds[position] = position
+	}
+
+	cycle, err := k.run(seeds)
+	if err != nil {
+		return nil, err
+	}
+	if cycle != nil {
+		return &Result{NegativeCycle: cycle}, negativeCycleError(cycle)
+	}
+
+	return k.publish("", false), nil
+}
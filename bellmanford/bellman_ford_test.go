@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package bellmanford_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/bellmanford"
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+	"github.com/katalvlaran/lvlath/internal/testutil"
+)
+
+// AI-HINTS (file):
+//   - Non-negative fixtures are cross-checked against dijkstra exactly (integer weights).
+//   - Cycle witnesses are validated by walking real edges, not by fixed expectations,
+//     except where the fixture admits exactly one negative cycle.
+//   - Both schedules run through every law via the algorithms table.
+
+var algorithms = []bellmanford.Algorithm{bellmanford.AlgorithmSPFA, bellmanford.AlgorithmClassic}
+
+// mustAddEdge adds one edge and fails the test on error.
+func mustAddEdge(t *testing.T, graph *core.Graph, from, to string, weight float64, opts ...core.EdgeOption) string {
+	t.Helper()
+
+	edgeID, err := graph.AddEdge(from, to, weight, opts...)
+	if err != nil {
+		t.Fatalf("AddEdge(%q, %q) failed: %v", from, to, err)
+	}
+
+	return edgeID
+}
+
+// buildRandomGraph constructs a reproducible random weighted graph with integer
+// weights in [low, low+9], directed or mixed per the flag.
+func buildRandomGraph(t *testing.T, seed int64, vertexCount, edgeCount int, low int, mixed bool) *core.Graph {
+	t.Helper()
+
+	mode := testutil.Directed
+	if mixed {
+		mode = testutil.Mixed
+	}
+
+	return testutil.RandomGraph(t, rand.New(rand.NewSource(seed)), testutil.Spec{
+		Vertices: vertexCount,
+		Edges:    edgeCount,
+		Mode:     mode,
+		Weight: func(rng *rand.Rand, _, _ string, _ bool) float64 {
+			return float64(low + rng.Intn(10))
+		},
+	})
+}
+
+// assertCycleWitness checks that cycle is a closed negative walk over real edges.
+func assertCycleWitness(t *testing.T, graph *core.Graph, cycle *bellmanford.Cycle) {
+	t.Helper()
+
+	if cycle == nil || len(cycle.VertexIDs) == 0 || len(cycle.VertexIDs) != len(cycle.EdgeIDs) {
+		t.Fatalf("malformed cycle witness: %+v", cycle)
+	}
+
+	edges := make(map[string]*core.Edge)
+	for _, edge := range graph.Edges() {
+		edges[edge.ID] = edge
+	}
+
+	total := 0.0
+	for index, edgeID := range cycle.EdgeIDs {
+		from := cycle.VertexIDs[index]
+		to := cycle.VertexIDs[(index+1)%len(cycle.VertexIDs)]
+		edge, ok := edges[edgeID]
+		if !ok {
+			t.Fatalf("cycle edge %q does not exist", edgeID)
+		}
+		forward := edge.From == from && edge.To == to
+		backward := !edge.Directed && edge.From == to && edge.To == from
+		if !forward && !backward {
+			t.Fatalf("cycle edge %q does not lead %q->%q", edgeID, from, to)
+		}
+		total += edge.Weight
+	}
+
+	if total != cycle.Weight || total >= 0 {
+		t.Fatalf("cycle weight: got=%v summed=%v, want negative and equal", cycle.Weight, total)
+	}
+	for _, vertexID := range cycle.VertexIDs[1:] {
+		if vertexID < cycle.VertexIDs[0] {
+			t.Fatalf("cycle not rotated to smallest vertex: %v", cycle.VertexIDs)
+		}
+	}
+}
+
+// TestBellmanFord_MatchesDijkstra verifies exact agreement with dijkstra on
+// random non-negative graphs for both schedules, plus predecessor consistency.
+//
+// Implementation:
+//   - Stage 1: Build random directed and mixed graphs with weights in [0, 9].
+//   - Stage 2: Compare distances from every source.
+//   - Stage 3: Check that every PrevEdge realizes dist(prev) + w == dist(v).
+//
+// AI-Hints:
+//   - Integer weights keep float comparisons exact.
+func TestBellmanFord_MatchesDijkstra(t *testing.T) {
+	for _, algorithm := range algorithms {
+		for seed := int64(1); seed <= 4; seed++ {
+			graph := buildRandomGraph(t, seed, 12, 30, 0, seed%2 == 0)
+			edges := make(map[string]*core.Edge)
+			for _, edge := range graph.Edges() {
+				edges[edge.ID] = edge
+			}
+
+			for _, sourceID := range graph.Vertices() {
+				want, err := dijkstra.Distances(graph, sourceID)
+				if err != nil {
+					t.Fatalf("dijkstra.Distances failed: %v", err)
+				}
+				got, err := bellmanford.BellmanFord(graph, sourceID, bellmanford.WithAlgorithm(algorithm))
+				if err != nil {
+					t.Fatalf("%s/%d: BellmanFord(%s) failed: %v", algorithm, seed, sourceID, err)
+				}
+
+				for vertexID, distance := range want {
+					if got.Distances[vertexID] != distance {
+						t.Fatalf("%s/%d %s->%s: got=%v want=%v", algorithm, seed, sourceID, vertexID, got.Distances[vertexID], distance)
+					}
+				}
+				for vertexID, edgeID := range got.PrevEdge {
+					edge := edges[edgeID]
+					if got.Distances[got.Prev[vertexID]]+edge.Weight != got.Distances[vertexID] {
+						t.Fatalf("%s/%d: PrevEdge %q does not realize dist(%s)", algorithm, seed, edgeID, vertexID)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestBellmanFord_NegativeWeights verifies distances and witnesses with negative
+// edges and no negative cycle.
+//
+// Implementation:
+//   - Stage 1: Build a DAG where the cheapest route uses a negative edge.
+//   - Stage 2: Assert distances, PathTo, PrevEdge, and +Inf for unreachable vertices.
+func TestBellmanFord_NegativeWeights(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	mustAddEdge(t, graph, "S", "A", 4)
+	mustAddEdge(t, graph, "S", "B", 5)
+	edgeBA := mustAddEdge(t, graph, "B", "A", -3)
+	mustAddEdge(t, graph, "A", "T", 2)
+	if err := graph.AddVertex("Z"); err != nil {
+		t.Fatalf("AddVertex failed: %v", err)
+	}
+
+	for _, algorithm := range algorithms {
+		result, err := bellmanford.BellmanFord(graph, "S", bellmanford.WithAlgorithm(algorithm))
+		if err != nil {
+			t.Fatalf("%s: BellmanFord failed: %v", algorithm, err)
+		}
+
+		distance, _ := result.DistanceTo("T")
+		if distance != 4 {
+			t.Fatalf("%s: DistanceTo(T): got=%v want=4", algorithm, distance)
+		}
+		path, err := result.PathTo("T")
+		if err != nil || fmt.Sprint(path) != "[S B A T]" {
+			t.Fatalf("%s: PathTo(T): got=%v err=%v", algorithm, path, err)
+		}
+		if result.PrevEdge["A"] != edgeBA {
+			t.Fatalf("%s: PrevEdge[A]: got=%q want=%q", algorithm, result.PrevEdge["A"], edgeBA)
+		}
+
+		unreachable, _ := result.DistanceTo("Z")
+		if !math.IsInf(unreachable, 1) {
+			t.Fatalf("%s: DistanceTo(Z): got=%v want=+Inf", algorithm, unreachable)
+		}
+		if _, err = result.PathTo("Z"); !errors.Is(err, bellmanford.ErrNoPath) {
+			t.Fatalf("%s: PathTo(Z): got=%v want ErrNoPath", algorithm, err)
+		}
+	}
+}
+
+// TestBellmanFord_NegativeCycleWitness verifies the sentinel and an exact witness
+// on a graph with a single reachable negative cycle.
+//
+// Implementation:
+//   - Stage 1: Build S->A and the cycle A->B->C->A of weight -1.
+//   - Stage 2: Assert ErrNegativeCycle, the rotated witness, and nil distance maps.
+//   - Stage 3: Add a parallel cheaper edge and assert PrevEdge-level precision.
+func TestBellmanFord_NegativeCycleWitness(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true), core.WithMultiEdges())
+	mustAddEdge(t, graph, "S", "A", 1)
+	edgeAB := mustAddEdge(t, graph, "A", "B", 1)
+	edgeBC := mustAddEdge(t, graph, "B", "C", -4)
+	edgeCA := mustAddEdge(t, graph, "C", "A", 2)
+	mustAddEdge(t, graph, "C", "T", 1)
+
+	for _, algorithm := range algorithms {
+		result, err := bellmanford.BellmanFord(graph, "S", bellmanford.WithAlgorithm(algorithm))
+		if !errors.Is(err, bellmanford.ErrNegativeCycle) {
+			t.Fatalf("%s: got err=%v want ErrNegativeCycle", algorithm, err)
+		}
+		if result == nil || result.Distances != nil || result.Prev != nil {
+			t.Fatalf("%s: want witness-only result, got %+v", algorithm, result)
+		}
+
+		cycle := result.NegativeCycle
+		assertCycleWitness(t, graph, cycle)
+		if fmt.Sprint(cycle.VertexIDs) != "[A B C]" || fmt.Sprint(cycle.EdgeIDs) != fmt.Sprint([]string{edgeAB, edgeBC, edgeCA}) {
+			t.Fatalf("%s: witness got=%v %v", algorithm, cycle.VertexIDs, cycle.EdgeIDs)
+		}
+		if cycle.Weight != -1 {
+			t.Fatalf("%s: weight got=%v want=-1", algorithm, cycle.Weight)
+		}
+	}
+
+	cheaper := mustAddEdge(t, graph, "C", "A", 1)
+	result, err := bellmanford.BellmanFord(graph, "S")
+	if !errors.Is(err, bellmanford.ErrNegativeCycle) {
+		t.Fatalf("got err=%v want ErrNegativeCycle", err)
+	}
+	if result.NegativeCycle.EdgeIDs[2] != cheaper || result.NegativeCycle.Weight != -2 {
+		t.Fatalf("parallel edge: got=%v weight=%v", result.NegativeCycle.EdgeIDs, result.NegativeCycle.Weight)
+	}
+}
+
+// TestBellmanFord_CycleReachability verifies that only reachable cycles matter,
+// that undirected negative edges and negative loops are cycles, and that random
+// negative fixtures always produce valid witnesses.
+//
+// Implementation:
+//   - Stage 1: Unreachable cycle: BellmanFord succeeds, Potentials reports it.
+//   - Stage 2: Undirected negative edge: two-vertex witness over one edge ID.
+//   - Stage 3: Negative self-loop: one-vertex witness.
+//   - Stage 4: Random mixed graphs with weights in [-3, 6]: both schedules agree
+//     on detection and publish valid witnesses.
+func TestBellmanFord_CycleReachability(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true), core.WithMixedEdges(), core.WithLoops())
+	mustAddEdge(t, graph, "S", "A", 1)
+	mustAddEdge(t, graph, "X", "Y", -2)
+	mustAddEdge(t, graph, "Y", "X", 1)
+
+	if _, err := bellmanford.BellmanFord(graph, "S"); err != nil {
+		t.Fatalf("unreachable cycle: unexpected error %v", err)
+	}
+	result, err := bellmanford.Potentials(graph)
+	if !errors.Is(err, bellmanford.ErrNegativeCycle) {
+		t.Fatalf("Potentials: got err=%v want ErrNegativeCycle", err)
+	}
+	assertCycleWitness(t, graph, result.NegativeCycle)
+
+	undirectedID := mustAddEdge(t, graph, "A", "B", -1, core.WithEdgeDirected(false))
+	result, err = bellmanford.BellmanFord(graph, "S")
+	if !errors.Is(err, bellmanford.ErrNegativeCycle) {
+		t.Fatalf("undirected: got err=%v want ErrNegativeCycle", err)
+	}
+	assertCycleWitness(t, graph, result.NegativeCycle)
+	if fmt.Sprint(result.NegativeCycle.EdgeIDs) != fmt.Sprint([]string{undirectedID, undirectedID}) {
+		t.Fatalf("undirected witness: got=%v", result.NegativeCycle.EdgeIDs)
+	}
+
+	loops, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true), core.WithLoops())
+	loopID := mustAddEdge(t, loops, "S", "S", -1)
+	result, err = bellmanford.BellmanFord(loops, "S", bellmanford.WithAlgorithm(bellmanford.AlgorithmClassic))
+	if !errors.Is(err, bellmanford.ErrNegativeCycle) || fmt.Sprint(result.NegativeCycle.EdgeIDs) != fmt.Sprint([]string{loopID}) {
+		t.Fatalf("loop: got err=%v result=%+v", err, result)
+	}
+
+	for seed := int64(1); seed <= 12; seed++ {
+		random := buildRandomGraph(t, seed, 10, 22, -3, seed%3 == 0)
+		var detected []bool
+		for _, algorithm := range algorithms {
+			result, err = bellmanford.BellmanFord(random, "v00", bellmanford.WithAlgorithm(algorithm))
+			if err != nil && !errors.Is(err, bellmanford.ErrNegativeCycle) {
+				t.Fatalf("%s/%d: unexpected error %v", algorithm, seed, err)
+			}
+			if err != nil {
+				assertCycleWitness(t, random, result.NegativeCycle)
+			}
+			detected = append(detected, err != nil)
+		}
+		if detected[0] != detected[1] {
+			t.Fatalf("seed %d: schedules disagree on detection: %v", seed, detected)
+		}
+	}
+}
+
+// TestPotentials_ReweightingInvariant verifies w + h(u) - h(v) >= 0 on every arc.
+//
+// Implementation:
+//   - Stage 1: Build a graph with negative edges and no negative cycle.
+//   - Stage 2: Assert non-positive potentials and the reweighting invariant.
+//
+// AI-Hints:
+//   - This is the exact contract Johnson's algorithm depends on.
+func TestPotentials_ReweightingInvariant(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	mustAddEdge(t, graph, "A", "B", -2)
+	mustAddEdge(t, graph, "B", "C", 3)
+	mustAddEdge(t, graph, "C", "D", -4)
+	mustAddEdge(t, graph, "A", "D", 1)
+	mustAddEdge(t, graph, "D", "B", 5)
+
+	result, err := bellmanford.Potentials(graph)
+	if err != nil {
+		t.Fatalf("Potentials failed: %v", err)
+	}
+	if result.SourceID != "" || result.Prev != nil {
+		t.Fatalf("Potentials must publish no source and no predecessors: %+v", result)
+	}
+	if _, err = result.PathTo("A"); !errors.Is(err, bellmanford.ErrPathTrackingDisabled) {
+		t.Fatalf("PathTo: got err=%v want ErrPathTrackingDisabled", err)
+	}
+
+	for _, edge := range graph.Edges() {
+		if result.Distances[edge.From] > 0 {
+			t.Fatalf("potential of %s is positive: %v", edge.From, result.Distances[edge.From])
+		}
+		if edge.Weight+result.Distances[edge.From]-result.Distances[edge.To] < 0 {
+			t.Fatalf("edge %s violates reweighting invariant", edge.ID)
+		}
+	}
+}
+
+// TestBellmanFord_Validation verifies input, option, result, and context sentinels.
+func TestBellmanFord_Validation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	mustAddEdge(t, graph, "A", "B", 1)
+
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{"nil graph", bellmanford.ErrNilGraph, func() error { _, err := bellmanford.BellmanFord(nil, "A"); return err }},
+		{"empty source", bellmanford.ErrEmptySourceID, func() error { _, err := bellmanford.BellmanFord(graph, ""); return err }},
+		{"missing source", bellmanford.ErrSourceNotFound, func() error { _, err := bellmanford.BellmanFord(graph, "Q"); return err }},
+		{"nil option", bellmanford.ErrNilOption, func() error { _, err := bellmanford.BellmanFord(graph, "A", nil); return err }},
+		{"bad algorithm", bellmanford.ErrUnsupportedAlgorithm, func() error {
+			_, err := bellmanford.BellmanFord(graph, "A", bellmanford.WithAlgorithm("dial"))
+			return err
+		}},
+		{"nil context", bellmanford.ErrNilContext, func() error {
+			_, err := bellmanford.BellmanFord(graph, "A", bellmanford.WithContext(nil)) //nolint:staticcheck // nil is the case under test
+			return err
+		}},
+		{"potentials nil graph", bellmanford.ErrNilGraph, func() error { _, err := bellmanford.Potentials(nil); return err }},
+	}
+	for _, tc := range cases {
+		if err := tc.run(); !errors.Is(err, tc.err) {
+			t.Fatalf("%s: got err=%v want %v", tc.name, err, tc.err)
+		}
+	}
+
+	unweighted, _ := core.NewGraph()
+	_ = unweighted.AddVertex("A")
+	if _, err := bellmanford.BellmanFord(unweighted, "A"); !errors.Is(err, bellmanford.ErrUnweightedGraph) {
+		t.Fatalf("unweighted: got err=%v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := bellmanford.BellmanFord(graph, "A", bellmanford.WithContext(ctx)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled: got err=%v", err)
+	}
+
+	var nilResult *bellmanford.Result
+	if _, err := nilResult.DistanceTo("A"); !errors.Is(err, bellmanford.ErrNilResult) {
+		t.Fatalf("nil result: got err=%v", err)
+	}
+	result, _ := bellmanford.BellmanFord(graph, "A")
+	if _, err := result.DistanceTo(""); !errors.Is(err, bellmanford.ErrEmptyTargetID) {
+		t.Fatalf("empty target: got err=%v", err)
+	}
+	if _, err := result.PathTo("Q"); !errors.Is(err, bellmanford.ErrTargetNotFound) {
+		t.Fatalf("missing target: got err=%v", err)
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package bellmanford implements deterministic single-source shortest paths over
+// weighted core.Graph instances whose edge weights may be negative.
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - BellmanFord(g, sourceID, opts...)
+//     Single-source shortest paths returning distances, predecessor vertices,
+//     and predecessor edge IDs.
+//
+//   - Potentials(g, opts...)
+//     Distances from a virtual source joined to every vertex by zero-weight
+//     edges; the reweighting potential used by Johnson's algorithm.
+//
+// When a negative cycle is reachable, both functions return a Result whose
+// NegativeCycle field carries the cycle's vertex IDs, edge IDs, and total weight,
+// together with an error wrapping ErrNegativeCycle.
+//
+// -----------------------------------------------------------------------------
+// -- WHY ----------------------------------------------------------------------
+//
+// Dijkstra rejects negative weights because greedy finalization is unsound for
+// them. Negative costs appear legitimately in arbitrage detection (log-rates),
+// difference constraints, and potential-based reweighting. Bellman-Ford trades
+// speed for that generality and certifies infeasibility with a cycle witness.
+//
+// -----------------------------------------------------------------------------
+// -- HOW ----------------------------------------------------------------------
+//
+//   - The graph is snapshot once into dense arcs grouped by origin; directed edges
+//     give one arc, undirected edges two (a loop gives one).
+//   - AlgorithmSPFA (default) relaxes from a FIFO queue of improved vertices and
+//     detects a negative cycle once a distance is realized by a walk of V edges.
+//   - AlgorithmClassic performs up to V passes and stops at the first quiet pass.
+//   - Witnesses always come from a classic pass-V relaxation: walking V
+//     predecessors back lands on a negative cycle of the predecessor graph.
+//
+// Options:
+//
+//   - WithAlgorithm(AlgorithmSPFA | AlgorithmClassic)
+//   - WithContext(ctx)
+//
+// Errors:
+//
+//   - ErrNilGraph, ErrUnweightedGraph, ErrEmptySourceID, ErrSourceNotFound
+//   - ErrNilOption, ErrUnsupportedAlgorithm, ErrNilContext
+//   - ErrInvalidWeight, ErrDistanceOverflow, ErrNegativeCycle
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound,
+//     ErrPathTrackingDisabled, ErrNoPath (Result helpers)
+//
+// Complexity:
+//
+//   - Time O(VE) worst case for both schedules; SPFA is typically near O(E).
+//   - Space O(V + E).
+//
+// AI-Hints:
+//   - Use dijkstra for non-negative weights.
+//   - Use Result.PrevEdge to recover exact edges on multigraphs.
+package bellmanford
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package bellmanford
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	//
+	// AI-Hints:
+	//   - This is an input-contract failure detected before any allocation.
+	ErrNilGraph = errors.New("bellmanford: graph is nil")
+
+	// ErrEmptySourceID reports that the caller passed an empty source vertex ID.
+	//
+	// AI-Hints:
+	//   - Potentials does not take a source; use it for virtual-source runs.
+	ErrEmptySourceID = errors.New("bellmanford: source vertex id is empty")
+
+	// ErrSourceNotFound reports that the source vertex does not exist in the graph.
+	//
+	// AI-Hints:
+	//   - Keep source validation distinct from result-level target lookups.
+	ErrSourceNotFound = errors.New("bellmanford: source vertex not found")
+
+	// ErrEmptyTargetID reports that a result query received an empty target ID.
+	//
+	// AI-Hints:
+	//   - Result helpers validate identifiers before touching any map.
+	ErrEmptyTargetID = errors.New("bellmanford: target vertex id is empty")
+
+	// ErrTargetNotFound reports that a result query referenced a vertex outside
+	// the result domain.
+	//
+	// AI-Hints:
+	//   - Unknown and unreachable targets are different states; +Inf is data.
+	ErrTargetNotFound = errors.New("bellmanford: target vertex not found")
+
+	// ErrUnweightedGraph reports that the graph does not expose weighted edges.
+	//
+	// AI-Hints:
+	//   - Do not silently coerce unweighted graphs into unit weights.
+	ErrUnweightedGraph = errors.New("bellmanford: graph must be weighted")
+
+	// ErrInvalidWeight reports a NaN or infinite edge weight.
+	// Negative finite weights are valid; that is the point of this package.
+	//
+	// AI-Hints:
+	//   - The pre-scan covers every edge because every edge may be relaxed.
+	ErrInvalidWeight = errors.New("bellmanford: edge weight is NaN or Inf")
+
+	// ErrDistanceOverflow reports that a tentative distance left the finite float64 range.
+	//
+	// AI-Hints:
+	//   - Overflow is never reported as an unreachable +Inf distance.
+	ErrDistanceOverflow = errors.New("bellmanford: distance overflow")
+
+	// ErrNegativeCycle reports that a negative-weight cycle is reachable from the
+	// source (or, for Potentials, exists anywhere in the graph).
+	// Shortest-path distances are undefined in that case; the returned Result
+	// carries the cycle witness in NegativeCycle.
+	//
+	// AI-Hints:
+	//   - Classify with errors.Is and read Result.NegativeCycle for vertex and edge IDs.
+	//   - An undirected edge with negative weight is itself a negative cycle
+	//     (traversed forth and back).
+	ErrNegativeCycle = errors.New("bellmanford: negative cycle detected")
+
+	// ErrNilOption reports that a nil functional option was supplied.
+	//
+	// AI-Hints:
+	//   - Never replace this with panic-based configuration handling.
+	ErrNilOption = errors.New("bellmanford: nil option")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	//
+	// AI-Hints:
+	//   - Use context.Background() instead of nil.
+	ErrNilContext = errors.New("bellmanford: context is nil")
+
+	// ErrUnsupportedAlgorithm reports an unknown Algorithm value.
+	//
+	// AI-Hints:
+	//   - Use the exported Algorithm constants.
+	ErrUnsupportedAlgorithm = errors.New("bellmanford: unsupported algorithm")
+
+	// ErrNilResult reports that a Result method was called on a nil receiver.
+	//
+	// AI-Hints:
+	//   - Result helpers return this sentinel instead of panicking.
+	ErrNilResult = errors.New("bellmanford: nil result")
+
+	// ErrPathTrackingDisabled reports that PathTo was called on a Result without
+	// predecessor state, such as the one returned by Potentials.
+	//
+	// AI-Hints:
+	//   - Keep this distinct from ErrNoPath.
+	ErrPathTrackingDisabled = errors.New("bellmanford: path tracking disabled")
+
+	// ErrNoPath reports that the target is known but unreachable, or that the
+	// stored predecessor chain is malformed.
+	//
+	// AI-Hints:
+	//   - DistanceTo publishes +Inf for unreachable targets; PathTo errors instead.
+	ErrNoPath = errors.New("bellmanford: no path")
+)
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package bellmanford_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/bellmanford"
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// ExampleBellmanFord shows shortest paths through a negative edge and the
+// witness returned once a negative cycle becomes reachable.
+func ExampleBellmanFord() {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	_, _ = graph.AddEdge("USD", "EUR", 2)
+	_, _ = graph.AddEdge("EUR", "GBP", -1)
+	_, _ = graph.AddEdge("USD", "GBP", 3)
+
+	result, _ := bellmanford.BellmanFord(graph, "USD")
+	path, _ := result.PathTo("GBP")
+	fmt.Println(path, result.Distances["GBP"])
+
+	_, _ = graph.AddEdge("GBP", "USD", -2)
+	result, err := bellmanford.BellmanFord(graph, "USD")
+	fmt.Println(errors.Is(err, bellmanford.ErrNegativeCycle), result.NegativeCycle.VertexIDs, result.NegativeCycle.Weight)
+
+	// Output:
+	// [USD EUR GBP] 1
+	// true [EUR GBP USD] -1
+}
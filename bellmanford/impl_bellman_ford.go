@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package bellmanford
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// noVertex marks an absent predecessor in the dense kernel arrays.
+const noVertex = -1
+
+// arc is one traversal direction of a graph edge in the dense kernel.
+// Directed edges contribute one arc; undirected edges contribute two (one for loops).
+type arc struct {
+	edgeID string
+	from   int
+	to     int
+	weight float64
+}
+
+// kernel holds the dense, index-based state of one Bellman-Ford run.
+//
+// Implementation:
+//   - Stage 1: Vertices are indexed in g.Vertices() order.
+//   - Stage 2: Arcs are grouped by origin; arcs[start[u]:start[u+1]] is the
+//     outgoing relation of u in Edge.ID order.
+//   - Stage 3: dist, prev, and prevArc hold the tentative shortest-path tree.
+//
+// AI-Hints:
+//   - Index order equals lexicographic vertex order; witness rotation relies on it.
+type kernel struct {
+	ids     []string
+	arcs    []arc
+	start   []int
+	config  Options
+	dist    []float64
+	prev    []int
+	prevArc []int
+}
+
+// validateInputs checks graph and source preconditions shared by the public API.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrEmptySourceID, ErrSourceNotFound.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func validateInputs(g *core.Graph, sourceID string) error {
+	if g == nil {
+		return ErrNilGraph
+	}
+	if !g.Weighted() {
+		return ErrUnweightedGraph
+	}
+	if sourceID == "" {
+		return ErrEmptySourceID
+	}
+	if !g.HasVertex(sourceID) {
+		return ErrSourceNotFound
+	}
+
+	return nil
+}
+
+// newKernel snapshots g into the dense arc layout, validating every edge weight.
+//
+// Implementation:
+//   - Stage 1: Index vertices in sorted order.
+//   - Stage 2: Reject NaN and infinite weights.
+//   - Stage 3: Bucket arcs by origin preserving core.Edges() order, then flatten.
+//
+// Errors:
+//   - ErrInvalidWeight wrapped with edge context.
+//
+// Determinism:
+//   - Arc order is fixed by vertex order and Edge.ID order.
+//
+// Complexity:
+//   - Time O(V + E log E), Space O(V + E).
+func newKernel(g *core.Graph, config Options) (*kernel, error) {
+	ids := g.Vertices()
+	index := make(map[string]int, len(ids))
+	for position, vertexID := range ids {
+		index[vertexID] = position
+	}
+
+	buckets := make([][]arc, len(ids))
+	arcCount := 0
+	for _, edge := range g.Edges() {
+		if math.IsNaN(edge.Weight) || math.IsInf(edge.Weight, 0) {
+			return nil, fmt.Errorf(
+				"%w: edge_id=%q from=%q to=%q weight=%g",
+				ErrInvalidWeight,
+				edge.ID,
+				edge.From,
+				edge.To,
+				edge.Weight,
+			)
+		}
+
+		from, to := index[edge.From], index[edge.To]
+		buckets[from] = append(buckets[from], arc{edgeID: edge.ID, from: from, to: to, weight: edge.Weight})
+		arcCount++
+		if !edge.Directed && from != to {
+			buckets[to] = append(buckets[to], arc{edgeID: edge.ID, from: to, to: from, weight: edge.Weight})
+			arcCount++
+		}
+	}
+
+	k := &kernel{
+		ids:     ids,
+		arcs:    make([]arc, 0, arcCount),
+		start:   make([]int, len(ids)+1),
+		config:  config,
+		dist:    make([]float64, len(ids)),
+		prev:    make([]int, len(ids)),
+		prevArc: make([]int, len(ids)),
+	}
+	for position, bucket := range buckets {
+		k.start[position] = len(k.arcs)
+		k.arcs = append(k.arcs, bucket...)
+	}
+	k.start[len(ids)] = len(k.arcs)
+
+	return k, nil
+}
+
+// reset initializes distances to +Inf, clears predecessors, and zeroes the seeds.
+//
+// Complexity:
+//   - Time O(V), Space O(1).
+func (k *kernel) reset(seeds []int) {
+	for position := range k.dist {
+		k.dist[position] = math.Inf(1)
+		k.prev[position] = noVertex
+		k.prevArc[position] = noVertex
+	}
+	for _, seed := range seeds {
+		k.dist[seed] = 0
+	}
+}
+
+// run executes the configured schedule from seeds and returns the negative
+// cycle witness, if any.
+//
+// Implementation:
+//   - Stage 1: Reset state and dispatch to SPFA or classic passes.
+//   - Stage 2: On SPFA detection, replay classic passes from the same seeds to
+//     obtain a relaxation in pass V, which anchors a provable witness.
+//   - Stage 3: Extract the witness from the predecessor graph.
+//
+// Returns:
+//   - *Cycle: nil when no negative cycle is reachable from the seeds.
+//   - error: nil on success.
+//
+// Errors:
+//   - ErrDistanceOverflow, ctx.Err().
+//
+// Complexity:
+//   - Time O(VE) worst case for either schedule; Space O(V + E).
+//
+// AI-Hints:
+//   - Do not extract the witness from SPFA state directly: its predecessor
+//     graph is not guaranteed to contain the cycle at the moment of detection.
+func (k *kernel) run(seeds []int) (*Cycle, error) {
+	k.reset(seeds)
+
+	if k.config.Algorithm == AlgorithmSPFA {
+		detected, err := k.spfa(seeds)
+		if err != nil || !detected {
+			return nil, err
+		}
+		k.reset(seeds)
+	}
+
+	anchor, err := k.classic()
+	if err != nil || anchor == noVertex {
+		return nil, err
+	}
+
+	return k.extractCycle(anchor)
+}
+
+// relax tries to improve the head of arcs[arcIndex] through its tail.
+//
+// Returns:
+//   - bool: true when the head distance strictly improved.
+//   - error: ErrDistanceOverflow wrapped with arc context.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (k *kernel) relax(arcIndex int) (bool, error) {
+	current := k.arcs[arcIndex]
+	candidate := k.dist[current.from] + current.weight
+	if math.IsInf(candidate, 0) {
+		return false, fmt.Errorf(
+			"%w: edge_id=%q from=%q to=%q",
+			ErrDistanceOverflow,
+			current.edgeID,
+			k.ids[current.from],
+			k.ids[current.to],
+		)
+	}
+	if candidate >= k.dist[current.to] {
+		return false, nil
+	}
+
+	k.dist[current.to] = candidate
+	k.prev[current.to] = current.from
+	k.prevArc[current.to] = arcIndex
+
+	return true, nil
+}
+
+// spfa runs the FIFO queue schedule and reports whether a negative cycle was detected.
+//
+// Implementation:
+//   - Stage 1: Enqueue seeds in index order.
+//   - Stage 2: Pop, relax outgoing arcs, enqueue improved heads not already queued.
+//   - Stage 3: Track the edge count of the walk realizing each distance; reaching
+//     V edges proves a repeated vertex on a strictly improving walk, which can
+//     only close a negative cycle.
+//
+// Returns:
+//   - bool: true on negative-cycle detection.
+//
+// Errors:
+//   - ErrDistanceOverflow, ctx.Err().
+//
+// Complexity:
+//   - Time O(VE) worst case, typically near O(E); Space O(V).
+func (k *kernel) spfa(seeds []int) (bool, error) {
+	vertexCount := len(k.ids)
+	hops := make([]int, vertexCount)
+	queued := make([]bool, vertexCount)
+	queue := make([]int, 0, vertexCount)
+	for _, seed := range seeds {
+		queue = append(queue, seed)
+		queued[seed] = true
+	}
+
+	for len(queue) > 0 {
+		if err := k.config.ctx.Err(); err != nil {
+			return false, err
+		}
+
+		u := queue[0]
+		queue = queue[1:]
+		queued[u] = false
+
+		for arcIndex := k.start[u]; arcIndex < k.start[u+1]; arcIndex++ {
+			improved, err := k.relax(arcIndex)
+			if err != nil {
+				return false, err
+			}
+			if !improved {
+				continue
+			}
+
+			head := k.arcs[arcIndex].to
+			hops[head] = hops[u] + 1
+			if hops[head] >= vertexCount {
+				return true, nil
+			}
+			if !queued[head] {
+				queue = append(queue, head)
+				queued[head] = true
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// classic runs up to V passes over all arcs and returns the last vertex improved
+// in pass V, or noVertex when the distances converged earlier.
+//
+// Errors:
+//   - ErrDistanceOverflow, ctx.Err().
+//
+// Complexity:
+//   - Time O(VE), Space O(1).
+func (k *kernel) classic() (int, error) {
+	vertexCount := len(k.ids)
+
+	for pass := 1; pass <= vertexCount; pass++ {
+		if err := k.config.ctx.Err(); err != nil {
+			return noVertex, err
+		}
+
+		lastImproved := noVertex
+		for u := 0; u < vertexCount; u++ {
+			if math.IsInf(k.dist[u], 1) {
+				continue
+			}
+			for arcIndex := k.start[u]; arcIndex < k.start[u+1]; arcIndex++ {
+				improved, err := k.relax(arcIndex)
+				if err != nil {
+					return noVertex, err
+				}
+				if improved {
+					lastImproved = k.arcs[arcIndex].to
+				}
+			}
+		}
+
+		if lastImproved == noVertex {
+			return noVertex, nil
+		}
+		if pass == vertexCount {
+			return lastImproved, nil
+		}
+	}
+
+	return noVertex, nil
+}
+
+// extractCycle walks V predecessors back from anchor, which lands on a cycle of
+// the predecessor graph, and publishes that cycle in forward order.
+//
+// Implementation:
+//   - Stage 1: Step back V times from anchor.
+//   - Stage 2: Collect the cycle backward until the start repeats.
+//   - Stage 3: Reverse to traversal order, rotate to the smallest vertex, sum weights.
+//
+// Errors:
+//   - ErrNegativeCycle if the predecessor chain breaks (defensive).
+//
+// Complexity:
+//   - Time O(V), Space O(V).
+func (k *kernel) extractCycle(anchor int) (*Cycle, error) {
+	current := anchor
+	for step := 0; step < len(k.ids); step++ {
+		current = k.prev[current]
+		if current == noVertex {
+			return nil, fmt.Errorf("%w: predecessor chain from %q is not closed", ErrNegativeCycle, k.ids[anchor])
+		}
+	}
+
+	backward := []int{current}
+	for next := k.prev[current]; next != current; next = k.prev[next] {
+		backward = append(backward, next)
+	}
+
+	cycleLength := len(backward)
+	forward := make([]int, cycleLength)
+	smallest := 0
+	for position := range backward {
+		forward[position] = backward[cycleLength-1-position]
+		if forward[position] < forward[smallest] {
+			smallest = position
+		}
+	}
+
+	cycle := &Cycle{
+		VertexIDs: make([]string, 0, cycleLength),
+		EdgeIDs:   make([]string, 0, cycleLength),
+	}
+	for offset := 0; offset < cycleLength; offset++ {
+		vertex := forward[(smallest+offset)%cycleLength]
+		head := forward[(smallest+offset+1)%cycleLength]
+		entering := k.arcs[k.prevArc[head]]
+
+		cycle.VertexIDs = append(cycle.VertexIDs, k.ids[vertex])
+		cycle.EdgeIDs = append(cycle.EdgeIDs, entering.edgeID)
+		cycle.Weight += entering.weight
+	}
+
+	return cycle, nil
+}
+
+// publish converts the converged kernel state into a detached Result.
+//
+// Inputs:
+//   - sourceID: the source vertex, or "" for Potentials.
+//   - tracking: whether Prev and PrevEdge are published.
+//
+// Complexity:
+//   - Time O(V), Space O(V).
+func (k *kernel) publish(sourceID string, tracking bool) *Result {
+	result := &Result{
+		SourceID:  sourceID,
+		Distances: make(map[string]float64, len(k.ids)),
+	}
+	if tracking {
+		result.Prev = make(map[string]string)
+		result.PrevEdge = make(map[string]string)
+	}
+
+	for position, vertexID := range k.ids {
+		result.Distances[vertexID] = k.dist[position]
+		if tracking && k.prev[position] != noVertex {
+			result.Prev[vertexID] = k.ids[k.prev[position]]
+			result.PrevEdge[vertexID] = k.arcs[k.prevArc[position]].edgeID
+		}
+	}
+
+	return result
+}
+
+// negativeCycleError wraps ErrNegativeCycle with the witness summary.
+func negativeCycleError(cycle *Cycle) error {
+	return fmt.Errorf(
+		"%w: vertices=%v edges=%v weight=%g",
+		ErrNegativeCycle,
+		cycle.VertexIDs,
+		cycle.EdgeIDs,
+		cycle.Weight,
+	)
+}
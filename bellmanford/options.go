@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package bellmanford
+
+import "context"
+
+// Algorithm identifies the relaxation schedule used by the kernel.
+//
+// Behavior highlights:
+//   - AlgorithmSPFA is the default: a FIFO queue re-relaxes only vertices whose
+//     distance improved, which is usually far cheaper than full passes.
+//   - AlgorithmClassic performs full V-1 passes over every arc and stops early
+//     once a pass makes no improvement.
+//   - Both schedules publish identical distances; witnesses may differ on ties.
+//
+// AI-Hints:
+//   - Prefer AlgorithmClassic when a predictable O(VE) bound matters more than
+//     average speed (SPFA has the same worst case but a larger constant).
+type Algorithm string
+
+const (
+	// AlgorithmSPFA selects the queue-based Shortest Path Faster Algorithm.
+	AlgorithmSPFA Algorithm = "spfa"
+
+	// AlgorithmClassic selects pass-based Bellman-Ford.
+	AlgorithmClassic Algorithm = "classic"
+)
+
+// Options holds the effective policy of one Bellman-Ford run.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value is not a valid policy.
+type Options struct {
+	// Algorithm selects the relaxation schedule.
+	Algorithm Algorithm
+
+	// ctx allows cancellation between passes (classic) or queue pops (SPFA).
+	ctx context.Context
+}
+
+// Option configures a Bellman-Ford run through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns the canonical policy: AlgorithmSPFA and context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{
+		Algorithm: AlgorithmSPFA,
+		ctx:       context.Background(),
+	}
+}
+
+// WithAlgorithm selects the relaxation schedule.
+//
+// Errors:
+//   - ErrUnsupportedAlgorithm for values other than AlgorithmSPFA and AlgorithmClassic.
+//
+// AI-Hints:
+//   - Last writer wins for repeated WithAlgorithm options.
+func WithAlgorithm(algorithm Algorithm) Option {
+	return func(o *Options) error {
+		switch algorithm {
+		case AlgorithmSPFA, AlgorithmClassic:
+			o.Algorithm = algorithm
+			return nil
+		default:
+			return ErrUnsupportedAlgorithm
+		}
+	}
+}
+
+// WithContext sets the context used for cancellation.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+//
+// AI-Hints:
+//   - Cancellation surfaces as ctx.Err() with no partial Result.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+
+	return config, nil
+}
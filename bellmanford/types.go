@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package bellmanford
+
+import "math"
+
+// Result stores the detached outcome of one Bellman-Ford run.
+//
+// Behavior highlights:
+//   - On success, Distances covers every graph vertex; +Inf marks unreachable vertices.
+//   - Prev and PrevEdge hold the predecessor vertex and the predecessor edge ID of
+//     every reached non-source vertex; they describe one shortest-path tree.
+//   - On ErrNegativeCycle, Distances, Prev, and PrevEdge are nil and
+//     NegativeCycle holds the witness.
+//
+// Notes:
+//   - Potentials returns a Result with empty SourceID and nil Prev/PrevEdge,
+//     because its virtual source has no vertex of its own.
+//
+// AI-Hints:
+//   - Distances may be negative; do not treat negative values as errors.
+//   - PrevEdge is needed to tell parallel edges apart on multigraphs.
+type Result struct {
+	// SourceID is the source vertex of the run; empty for Potentials.
+	SourceID string
+
+	// Distances maps every vertex to its shortest-path distance (+Inf if unreachable).
+	Distances map[string]float64
+
+	// Prev maps a reached vertex to its predecessor on the shortest-path tree.
+	Prev map[string]string
+
+	// PrevEdge maps a reached vertex to the ID of the edge entering it on the tree.
+	PrevEdge map[string]string
+
+	// NegativeCycle is the witness published together with ErrNegativeCycle.
+	NegativeCycle *Cycle
+}
+
+// Cycle is a closed walk witness.
+//
+// Behavior highlights:
+//   - EdgeIDs[i] leads from VertexIDs[i] to VertexIDs[(i+1) % len(VertexIDs)].
+//   - The witness is rotated so VertexIDs[0] is its lexicographically smallest vertex.
+//   - An undirected edge of negative weight yields a two-vertex cycle listing the
+//     same edge ID twice; a negative self-loop yields a one-vertex cycle.
+//
+// AI-Hints:
+//   - Weight is the sum of the edge weights in walk order and is always negative
+//     for NegativeCycle.
+type Cycle struct {
+	// VertexIDs lists the cycle vertices in traversal order, without repeating the first.
+	VertexIDs []string
+
+	// EdgeIDs lists the traversed edge IDs, aligned with VertexIDs.
+	EdgeIDs []string
+
+	// Weight is the total weight of the cycle.
+	Weight float64
+}
+
+// DistanceTo returns the stored distance to vertexID; +Inf means unreachable.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (r *Result) DistanceTo(vertexID string) (float64, error) {
+	if r == nil {
+		return 0, ErrNilResult
+	}
+	if vertexID == "" {
+		return 0, ErrEmptyTargetID
+	}
+
+	distance, ok := r.Distances[vertexID]
+	if !ok {
+		return 0, ErrTargetNotFound
+	}
+
+	return distance, nil
+}
+
+// PathTo reconstructs the shortest-path witness from SourceID to vertexID.
+//
+// Implementation:
+//   - Stage 1: Validate receiver, target, tracking state, and reachability.
+//   - Stage 2: Follow Prev back to SourceID, rejecting repeats and gaps.
+//   - Stage 3: Reverse the chain and publish it.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrPathTrackingDisabled if Prev is nil.
+//   - ErrNoPath if the target is unreachable or the chain is malformed.
+//
+// Complexity:
+//   - Time O(k), Space O(k), where k is the path length.
+//
+// AI-Hints:
+//   - The repeat check guards against caller-mutated Prev state.
+func (r *Result) PathTo(vertexID string) ([]string, error) {
+	distance, err := r.DistanceTo(vertexID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Prev == nil {
+		return nil, ErrPathTrackingDisabled
+	}
+	if math.IsInf(distance, 1) {
+		return nil, ErrNoPath
+	}
+
+	path := make([]string, 0)
+	seen := make(map[string]struct{})
+	currentID := vertexID
+	for {
+		if _, repeated := seen[currentID]; repeated {
+			return nil, ErrNoPath
+		}
+		seen[currentID] = struct{}{}
+		path = append(path, currentID)
+
+		if currentID == r.SourceID {
+			break
+		}
+
+		parentID, ok := r.Prev[currentID]
+		if !ok || parentID == "" {
+			return nil, ErrNoPath
+		}
+		currentID = parentID
+	}
+
+	for left, right := 0, len(path)-1; left < right; left, right = left+1, right-1 {
+		path[left], path[right] = path[right], path[left]
+	}
+
+	return path, nil
+}
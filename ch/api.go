@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch
+
+import (
+	"io"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// Build preprocesses g into a contraction hierarchy.
+//
+// Implementation:
+//   - Stage 1: Validate the graph and assemble options.
+//   - Stage 2: Contract vertices in lazy edge-difference order, adding a
+//     shortcut whenever a bounded witness search cannot prove it redundant.
+//   - Stage 3: Freeze upward and downward arcs into CSR tables.
+//
+// Behavior highlights:
+//   - Directed edges are traversed From->To; undirected edges both ways,
+//     exactly as in dijkstra.
+//   - Of several parallel edges only the lightest is kept (smallest ID on ties);
+//     self-loops are ignored.
+//
+// Inputs:
+//   - g: a weighted graph with finite non-negative weights.
+//   - opts: WithWitnessLimit, WithContext.
+//
+// Returns:
+//   - *Hierarchy: an immutable hierarchy; query it through NewQuery.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrNilOption, ErrBadWitnessLimit, ErrNilContext.
+//   - ErrInvalidWeight, ErrNegativeWeight (wrapped with the edge ID), ErrOverflow.
+//   - ctx.Err() on cancellation; no partial Hierarchy is returned.
+//
+// Determinism:
+//   - Equal graphs and options give identical hierarchies.
+//
+// Complexity:
+//   - Superlinear in V; road-like graphs contract in roughly O(V * W log W) for
+//     witness limit W. Space O(V + E + S) for S shortcuts.
+//
+// AI-Hints:
+//   - Preprocessing pays off only for many queries on a static graph; for a
+//     handful of queries use dijkstra.NewBidirectional.
+//   - Persist the result with WriteTo and restore it with Load.
+func Build(g *core.Graph, opts ...Option) (*Hierarchy, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if !g.Weighted() {
+		return nil, ErrUnweightedGraph
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runBuild(g, config)
+}
+
+// Load reads a hierarchy written by Hierarchy.WriteTo.
+//
+// Implementation:
+//   - Stage 1: Check the format magic and version.
+//   - Stage 2: Decode vertices, edges, ranks, and arc tables.
+//   - Stage 3: Validate every invariant queries rely on.
+//
+// Behavior highlights:
+//   - Corrupted input is rejected with ErrBadFormat rather than producing a
+//     hierarchy that could panic or loop during queries.
+//
+// Inputs:
+//   - r: a reader positioned at the start of a serialized hierarchy; Load may
+//     buffer past its end.
+//
+// Returns:
+//   - *Hierarchy: equivalent to the one that was written.
+//
+// Errors:
+//   - ErrBadFormat, ErrUnsupportedVersion; any non-EOF error returned by r.
+//
+// Complexity:
+//   - Time O(V + (E + S) log d), Space O(V + E + S).
+func Load(r io.Reader) (*Hierarchy, error) {
+	return decodeHierarchy(r)
+}
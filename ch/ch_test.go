@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/ch"
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+	"github.com/katalvlaran/lvlath/internal/testutil"
+)
+
+// AI-HINTS (file):
+//   - dijkstra.Distances is the oracle; every ordered pair must match exactly.
+//   - Integer weights keep sums exact, so witnesses are compared with ==.
+//   - WitnessLimit(1) forces redundant shortcuts and must not change any answer.
+
+// buildRandomGraph constructs a reproducible weighted multigraph with loops.
+// mode selects "directed", "undirected", or "mixed" edges.
+func buildRandomGraph(t *testing.T, seed int64, vertexCount, edgeCount int, mode string) *core.Graph {
+	t.Helper()
+
+	return testutil.RandomGraph(t, rand.New(rand.NewSource(seed)), testutil.Spec{
+		Vertices: vertexCount,
+		Edges:    edgeCount,
+		Mode:     testutil.Mode(mode),
+		Loops:    true,
+	})
+}
+
+// checkWitness verifies that vertexIDs and edgeIDs describe a traversable path
+// of the given distance in graph.
+func checkWitness(t *testing.T, graph *core.Graph, vertexIDs, edgeIDs []string, distance float64) {
+	t.Helper()
+
+	edges := make(map[string]core.Edge)
+	for _, edge := range graph.Edges() {
+		edges[edge.ID] = *edge
+	}
+	if len(vertexIDs) != len(edgeIDs)+1 {
+		t.Fatalf("path has %d vertices and %d edges", len(vertexIDs), len(edgeIDs))
+	}
+
+	total := 0.0
+	for index, edgeID := range edgeIDs {
+		edge, ok := edges[edgeID]
+		from, to := vertexIDs[index], vertexIDs[index+1]
+		forward := edge.From == from && edge.To == to
+		backward := !edge.Directed && edge.From == to && edge.To == from
+		if !ok || !(forward || backward) {
+			t.Fatalf("edge %q does not lead %s -> %s", edgeID, from, to)
+		}
+		total += edge.Weight
+	}
+	if total != distance {
+		t.Fatalf("witness weight %v, distance %v", total, distance)
+	}
+}
+
+// TestHierarchy_MatchesDijkstra verifies every pairwise distance and witness
+// against dijkstra on random graphs.
+//
+// Implementation:
+//   - Stage 1: Build random directed, undirected, and mixed multigraphs.
+//   - Stage 2: Contract with the default and with a minimal witness limit.
+//   - Stage 3: Compare all ordered pairs and validate each unpacked path.
+func TestHierarchy_MatchesDijkstra(t *testing.T) {
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 4; seed++ {
+			graph := buildRandomGraph(t, seed, 24, 60, mode)
+			for _, limit := range []int{ch.DefaultWitnessLimit, 1} {
+				hierarchy, err := ch.Build(graph, ch.WithWitnessLimit(limit))
+				if err != nil {
+					t.Fatalf("%s/%d Build failed: %v", mode, seed, err)
+				}
+				query := hierarchy.NewQuery()
+
+				for _, sourceID := range graph.Vertices() {
+					want, err := dijkstra.Distances(graph, sourceID)
+					if err != nil {
+						t.Fatalf("Distances(%q) failed: %v", sourceID, err)
+					}
+					for _, targetID := range graph.Vertices() {
+						got, err := query.DistanceTo(sourceID, targetID)
+						if err != nil || got != want[targetID] {
+							t.Fatalf("%s/%d limit=%d %s->%s: got=%v err=%v want=%v",
+								mode, seed, limit, sourceID, targetID, got, err, want[targetID])
+						}
+
+						vertexIDs, distance, err := query.ShortestPathTo(sourceID, targetID)
+						if math.IsInf(want[targetID], 1) {
+							if !errors.Is(err, ch.ErrNoPath) {
+								t.Fatalf("%s->%s: err=%v want ErrNoPath", sourceID, targetID, err)
+							}
+							continue
+						}
+						if err != nil || distance != want[targetID] {
+							t.Fatalf("%s->%s: path distance=%v err=%v", sourceID, targetID, distance, err)
+						}
+						edgeIDs, _, err := query.EdgePathTo(sourceID, targetID)
+						if err != nil {
+							t.Fatalf("EdgePathTo failed: %v", err)
+						}
+						checkWitness(t, graph, vertexIDs, edgeIDs, distance)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestBuild_Validation verifies the preprocessing and query sentinel contract.
+//
+// Implementation:
+//   - Stage 1: Reject nil, unweighted, and negative-weight graphs.
+//   - Stage 2: Reject bad options and a cancelled context.
+//   - Stage 3: Reject empty and unknown endpoints and nil receivers.
+func TestBuild_Validation(t *testing.T) {
+	unweighted, _ := core.NewGraph()
+	negative, _ := core.NewGraph(core.WithWeighted())
+	_, _ = negative.AddEdge("A", "B", -1)
+	graph, _ := core.NewGraph(core.WithWeighted())
+	_, _ = graph.AddEdge("A", "B", 1)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	buildCases := []struct {
+		name  string
+		graph *core.Graph
+		opts  []ch.Option
+		err   error
+	}{
+		{"nil graph", nil, nil, ch.ErrNilGraph},
+		{"unweighted", unweighted, nil, ch.ErrUnweightedGraph},
+		{"negative weight", negative, nil, ch.ErrNegativeWeight},
+		{"nil option", graph, []ch.Option{nil}, ch.ErrNilOption},
+		{"bad witness limit", graph, []ch.Option{ch.WithWitnessLimit(0)}, ch.ErrBadWitnessLimit},
+		{"nil context", graph, []ch.Option{ch.WithContext(nil)}, ch.ErrNilContext}, //nolint:staticcheck // nil context is the case under test
+		{"cancelled", graph, []ch.Option{ch.WithContext(cancelled)}, context.Canceled},
+	}
+	for _, tc := range buildCases {
+		if _, err := ch.Build(tc.graph, tc.opts...); !errors.Is(err, tc.err) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.err)
+		}
+	}
+
+	hierarchy, err := ch.Build(graph)
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	query := hierarchy.NewQuery()
+	queryCases := []struct {
+		name             string
+		sourceID, target string
+		err              error
+	}{
+		{"empty source", "", "B", ch.ErrEmptySourceID},
+		{"unknown source", "Q", "B", ch.ErrSourceNotFound},
+		{"empty target", "A", "", ch.ErrEmptyTargetID},
+		{"unknown target", "A", "Q", ch.ErrTargetNotFound},
+	}
+	for _, tc := range queryCases {
+		if _, err = query.DistanceTo(tc.sourceID, tc.target); !errors.Is(err, tc.err) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.err)
+		}
+	}
+
+	path, distance, err := query.ShortestPathTo("B", "B")
+	if err != nil || distance != 0 || fmt.Sprint(path) != "[B]" {
+		t.Fatalf("self query: path=%v distance=%v err=%v", path, distance, err)
+	}
+
+	var nilHierarchy *ch.Hierarchy
+	if _, err = nilHierarchy.NewQuery().DistanceTo("A", "B"); !errors.Is(err, ch.ErrNilHierarchy) {
+		t.Fatalf("nil hierarchy: err=%v", err)
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/ch"
+)
+
+// AI-HINTS (file):
+//   - Serialization must be byte-for-byte stable and answer-preserving.
+//   - Corrupted input must fail with a sentinel or load into a hierarchy whose
+//     queries still terminate without panicking.
+
+// TestHierarchy_RoundTrip verifies that Load(WriteTo(h)) answers exactly like h
+// and re-serializes to identical bytes.
+//
+// Implementation:
+//   - Stage 1: Build twice and compare serialized bytes (determinism).
+//   - Stage 2: Load and compare every pairwise distance and path.
+//   - Stage 3: Re-serialize the loaded hierarchy.
+func TestHierarchy_RoundTrip(t *testing.T) {
+	graph := buildRandomGraph(t, 7, 30, 80, "mixed")
+
+	first, err := ch.Build(graph)
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	second, _ := ch.Build(graph)
+	if first.ShortcutCount() == 0 {
+		t.Fatalf("fixture produced no shortcuts; unpacking is not exercised")
+	}
+
+	var written, again bytes.Buffer
+	n, err := first.WriteTo(&written)
+	if err != nil || n != int64(written.Len()) {
+		t.Fatalf("WriteTo: n=%d len=%d err=%v", n, written.Len(), err)
+	}
+	_, _ = second.WriteTo(&again)
+	if !bytes.Equal(written.Bytes(), again.Bytes()) {
+		t.Fatalf("two builds serialized differently")
+	}
+
+	loaded, err := ch.Load(bytes.NewReader(written.Bytes()))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.VertexCount() != first.VertexCount() || loaded.ShortcutCount() != first.ShortcutCount() {
+		t.Fatalf("loaded shape differs")
+	}
+
+	original, restored := first.NewQuery(), loaded.NewQuery()
+	for _, sourceID := range graph.Vertices() {
+		for _, targetID := range graph.Vertices() {
+			wantPath, wantDistance, wantErr := original.EdgePathTo(sourceID, targetID)
+			gotPath, gotDistance, gotErr := restored.EdgePathTo(sourceID, targetID)
+			if !errors.Is(gotErr, wantErr) || gotDistance != wantDistance || !slices.Equal(gotPath, wantPath) {
+				t.Fatalf("%s->%s: got (%v,%v,%v) want (%v,%v,%v)",
+					sourceID, targetID, gotPath, gotDistance, gotErr, wantPath, wantDistance, wantErr)
+			}
+		}
+	}
+
+	again.Reset()
+	_, _ = loaded.WriteTo(&again)
+	if !bytes.Equal(written.Bytes(), again.Bytes()) {
+		t.Fatalf("loaded hierarchy serialized differently")
+	}
+}
+
+// TestLoad_RejectsCorruption verifies the decoder on malformed input.
+//
+// Implementation:
+//   - Stage 1: Every strict prefix is ErrBadFormat.
+//   - Stage 2: Bad magic is ErrBadFormat; another version is ErrUnsupportedVersion.
+//   - Stage 3: Random byte flips either fail or load into a query-safe hierarchy.
+func TestLoad_RejectsCorruption(t *testing.T) {
+	graph := buildRandomGraph(t, 3, 12, 30, "mixed")
+	hierarchy, err := ch.Build(graph)
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	var buffer bytes.Buffer
+	_, _ = hierarchy.WriteTo(&buffer)
+	data := buffer.Bytes()
+
+	for length := 0; length < len(data); length++ {
+		if _, err = ch.Load(bytes.NewReader(data[:length])); !errors.Is(err, ch.ErrBadFormat) {
+			t.Fatalf("prefix %d: err=%v want ErrBadFormat", length, err)
+		}
+	}
+
+	corrupt := append([]byte(nil), data...)
+	corrupt[0] = 'X'
+	if _, err = ch.Load(bytes.NewReader(corrupt)); !errors.Is(err, ch.ErrBadFormat) {
+		t.Fatalf("bad magic: err=%v", err)
+	}
+	corrupt = append([]byte(nil), data...)
+	binary.LittleEndian.PutUint32(corrupt[4:], 99)
+	if _, err = ch.Load(bytes.NewReader(corrupt)); !errors.Is(err, ch.ErrUnsupportedVersion) {
+		t.Fatalf("bad version: err=%v", err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	vertices := graph.Vertices()
+	for trial := 0; trial < 500; trial++ {
+		corrupt = append(corrupt[:0], data...)
+		corrupt[8+rng.Intn(len(corrupt)-8)] ^= byte(1 + rng.Intn(255))
+		loaded, err := ch.Load(bytes.NewReader(corrupt))
+		if err != nil {
+			continue
+		}
+		query := loaded.NewQuery()
+		for _, sourceID := range vertices {
+			for _, targetID := range vertices {
+				_, _, _ = query.ShortestPathTo(sourceID, targetID)
+			}
+		}
+	}
+}
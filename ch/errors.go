@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	//
+	// AI-Hints:
+	//   - This is an input-contract failure detected before any allocation.
+	ErrNilGraph = errors.New("ch: graph is nil")
+
+	// ErrUnweightedGraph reports that the graph does not expose weighted edges.
+	//
+	// AI-Hints:
+	//   - Do not silently coerce unweighted graphs into unit costs.
+	ErrUnweightedGraph = errors.New("ch: graph must be weighted")
+
+	// ErrInvalidWeight reports a NaN or infinite edge weight.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending edge ID.
+	ErrInvalidWeight = errors.New("ch: edge weight is NaN or Inf")
+
+	// ErrNegativeWeight reports a negative edge weight. Contraction relies on
+	// the same greedy finalization as Dijkstra.
+	ErrNegativeWeight = errors.New("ch: negative edge weight")
+
+	// ErrOverflow reports a shortcut weight that overflows float64.
+	ErrOverflow = errors.New("ch: path weight overflows float64")
+
+	// ErrNilOption reports that a nil Option was passed.
+	ErrNilOption = errors.New("ch: option is nil")
+
+	// ErrBadWitnessLimit reports a witness-search settle limit below one.
+	ErrBadWitnessLimit = errors.New("ch: witness limit must be >= 1")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	ErrNilContext = errors.New("ch: context is nil")
+
+	// ErrNilHierarchy reports a method call on a nil Hierarchy or Query.
+	ErrNilHierarchy = errors.New("ch: hierarchy is nil")
+
+	// ErrEmptySourceID reports that the caller passed an empty source vertex ID.
+	ErrEmptySourceID = errors.New("ch: source vertex id is empty")
+
+	// ErrSourceNotFound reports that the source vertex is not part of the hierarchy.
+	ErrSourceNotFound = errors.New("ch: source vertex not found")
+
+	// ErrEmptyTargetID reports that the caller passed an empty target vertex ID.
+	ErrEmptyTargetID = errors.New("ch: target vertex id is empty")
+
+	// ErrTargetNotFound reports that the target vertex is not part of the hierarchy.
+	ErrTargetNotFound = errors.New("ch: target vertex not found")
+
+	// ErrNoPath reports that the target is unreachable from the source.
+	//
+	// AI-Hints:
+	//   - DistanceTo translates this into +Inf with a nil error.
+	ErrNoPath = errors.New("ch: no path")
+
+	// ErrBadFormat reports a serialized hierarchy that is truncated, corrupted,
+	// or structurally inconsistent.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the first violated invariant.
+	ErrBadFormat = errors.New("ch: malformed serialized hierarchy")
+
+	// ErrUnsupportedVersion reports a serialized hierarchy written by an
+	// incompatible format version.
+	ErrUnsupportedVersion = errors.New("ch: unsupported serialization version")
+)
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/ch"
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// ExampleBuild preprocesses a small road graph, persists it, and answers a
+// query against the restored copy.
+func ExampleBuild() {
+	roads, _ := core.NewGraph(core.WithWeighted())
+	_, _ = roads.AddEdge("Depot", "Market", 4)
+	_, _ = roads.AddEdge("Market", "Bridge", 3)
+	_, _ = roads.AddEdge("Bridge", "Harbor", 2)
+	_, _ = roads.AddEdge("Depot", "Hill", 6)
+	_, _ = roads.AddEdge("Hill", "Harbor", 5)
+
+	hierarchy, _ := ch.Build(roads)
+
+	var saved bytes.Buffer
+	_, _ = hierarchy.WriteTo(&saved)
+	restored, _ := ch.Load(&saved)
+
+	query := restored.NewQuery()
+	path, distance, _ := query.ShortestPathTo("Depot", "Harbor")
+	fmt.Println(path, distance)
+
+	// Output:
+	// [Depot Market Bridge Harbor] 9
+}
@@ -0,0 +1,355 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// arc is one arc of the remaining graph during contraction.
+type arc struct {
+	weight float64
+	edge   int32
+	middle int32
+}
+
+// ownedArc is an arc frozen into the hierarchy when its owner is contracted.
+type ownedArc struct {
+	head int32
+	arc
+}
+
+// shortcut is a candidate arc from -> to bridging the vertex being contracted.
+type shortcut struct {
+	from   int32
+	to     int32
+	weight float64
+}
+
+// priorityItem is one vertex keyed by its contraction priority.
+type priorityItem struct {
+	priority int
+	vertex   int32
+}
+
+// priorityQueue is a min-heap ordered by (priority, vertex).
+type priorityQueue []priorityItem
+
+func (q priorityQueue) Len() int { return len(q) }
+
+func (q priorityQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority < q[j].priority
+	}
+
+	return q[i].vertex < q[j].vertex
+}
+
+func (q priorityQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *priorityQueue) Push(x any) { *q = append(*q, x.(priorityItem)) }
+
+func (q *priorityQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return item
+}
+
+// contractor is the mutable state of one preprocessing run.
+//
+// AI-Hints:
+//   - outgoing/incoming describe the remaining (uncontracted) graph only; a
+//     contracted vertex's arcs move into up/down and never change again.
+type contractor struct {
+	outgoing []map[int32]arc
+	incoming []map[int32]arc
+	deleted  []int
+	up       [][]ownedArc
+	down     [][]ownedArc
+	witness  *searchSide
+	stamp    uint32
+	config   Options
+}
+
+// runBuild contracts every vertex of g and freezes the result.
+//
+// Implementation:
+//   - Stage 1: Index vertices and edges in g.Vertices() / g.Edges() order and
+//     keep the lightest arc per ordered vertex pair.
+//   - Stage 2: Queue every vertex by its priority: shortcuts added minus arcs
+//     removed, plus already-contracted neighbors.
+//   - Stage 3: Pop the minimum, recompute its priority lazily, and contract it
+//     if it is still minimal; otherwise requeue it.
+//   - Stage 4: Freeze owned arcs into CSR tables.
+//
+// Errors:
+//   - ErrInvalidWeight, ErrNegativeWeight wrapped with the edge ID.
+//   - ErrOverflow if a shortcut weight is not finite.
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Every ordering decision breaks ties by vertex index, so equal inputs give
+//     identical hierarchies.
+//
+// Complexity:
+//   - Time roughly O(V * W log W) for witness limit W on road-like graphs,
+//     Space O(V + E + S).
+func runBuild(g *core.Graph, config Options) (*Hierarchy, error) {
+	ids := g.Vertices()
+	index := make(map[string]int32, len(ids))
+	for position, vertexID := range ids {
+		index[vertexID] = int32(position)
+	}
+
+	c := &contractor{
+		outgoing: make([]map[int32]arc, len(ids)),
+		incoming: make([]map[int32]arc, len(ids)),
+		deleted:  make([]int, len(ids)),
+		up:       make([][]ownedArc, len(ids)),
+		down:     make([][]ownedArc, len(ids)),
+		witness:  newSearchSide(len(ids)),
+		config:   config,
+	}
+	for vertex := range ids {
+		c.outgoing[vertex] = make(map[int32]arc)
+		c.incoming[vertex] = make(map[int32]arc)
+	}
+
+	edges := g.Edges()
+	edgeIDs := make([]string, len(edges))
+	for position, edge := range edges {
+		edgeIDs[position] = edge.ID
+		if math.IsNaN(edge.Weight) || math.IsInf(edge.Weight, 0) {
+			return nil, fmt.Errorf("%w: edge=%q", ErrInvalidWeight, edge.ID)
+		}
+		if edge.Weight < 0 {
+			return nil, fmt.Errorf("%w: edge=%q", ErrNegativeWeight, edge.ID)
+		}
+		if edge.From == edge.To {
+			continue
+		}
+		from, to := index[edge.From], index[edge.To]
+		original := arc{weight: edge.Weight, edge: int32(position), middle: -1}
+		c.addArc(from, to, original)
+		if !edge.Directed {
+			c.addArc(to, from, original)
+		}
+	}
+
+	queue := make(priorityQueue, 0, len(ids))
+	for vertex := range ids {
+		shortcuts, err := c.shortcuts(int32(vertex))
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, priorityItem{priority: c.priority(int32(vertex), shortcuts), vertex: int32(vertex)})
+	}
+	heap.Init(&queue)
+
+	rank := make([]int32, len(ids))
+	for order := int32(0); len(queue) > 0; {
+		if err := config.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		item := heap.Pop(&queue).(priorityItem)
+		shortcuts, err := c.shortcuts(item.vertex)
+		if err != nil {
+			return nil, err
+		}
+		priority := c.priority(item.vertex, shortcuts)
+		if len(queue) > 0 && priority > queue[0].priority {
+			heap.Push(&queue, priorityItem{priority: priority, vertex: item.vertex})
+			continue
+		}
+
+		c.contract(item.vertex, shortcuts)
+		rank[item.vertex] = order
+		order++
+	}
+
+	return &Hierarchy{
+		ids:     ids,
+		index:   index,
+		rank:    rank,
+		edgeIDs: edgeIDs,
+		up:      freeze(c.up),
+		down:    freeze(c.down),
+	}, nil
+}
+
+// addArc inserts from -> to into the remaining graph unless an arc at most as
+// heavy is already present.
+func (c *contractor) addArc(from, to int32, candidate arc) {
+	if existing, ok := c.outgoing[from][to]; ok && existing.weight <= candidate.weight {
+		return
+	}
+	c.outgoing[from][to] = candidate
+	c.incoming[to][from] = candidate
+}
+
+// priority is the edge difference of contracting vertex plus its count of
+// already-contracted neighbors, which spreads contraction evenly.
+func (c *contractor) priority(vertex int32, shortcuts []shortcut) int {
+	return len(shortcuts) - len(c.outgoing[vertex]) - len(c.incoming[vertex]) + c.deleted[vertex]
+}
+
+// shortcuts returns the arcs required to preserve every shortest path through
+// vertex once it is removed.
+//
+// Implementation:
+//   - Stage 1: For each in-neighbor u (ascending), bound the search by the
+//     heaviest u -> vertex -> w detour.
+//   - Stage 2: Run a witness search from u that avoids vertex.
+//   - Stage 3: Keep u -> w when no witness of length <= the detour was found.
+//
+// Behavior highlights:
+//   - Tentative witness distances are real path lengths, so a search stopped by
+//     WitnessLimit can only add redundant shortcuts, never drop needed ones.
+//
+// Errors:
+//   - ErrOverflow if a detour weight is not finite.
+func (c *contractor) shortcuts(vertex int32) ([]shortcut, error) {
+	sources := sortedHeads(c.incoming[vertex])
+	targets := sortedHeads(c.outgoing[vertex])
+
+	var result []shortcut
+	for _, source := range sources {
+		inWeight := c.incoming[vertex][source].weight
+		bound := math.Inf(-1)
+		for _, target := range targets {
+			if target != source {
+				bound = math.Max(bound, inWeight+c.outgoing[vertex][target].weight)
+			}
+		}
+		if math.IsInf(bound, -1) {
+			continue
+		}
+		if math.IsInf(bound, 1) {
+			return nil, fmt.Errorf("%w: via=%d", ErrOverflow, vertex)
+		}
+
+		stamp := c.witnessSearch(source, vertex, bound)
+		for _, target := range targets {
+			if target == source {
+				continue
+			}
+			detour := inWeight + c.outgoing[vertex][target].weight
+			if c.witness.stamp[target] == stamp && c.witness.distance[target] <= detour {
+				continue
+			}
+			result = append(result, shortcut{from: source, to: target, weight: detour})
+		}
+	}
+
+	return result, nil
+}
+
+// witnessSearch runs a Dijkstra from source in the remaining graph that skips
+// excluded, ignores paths longer than bound, and settles at most WitnessLimit
+// vertices. It returns the stamp that marks reached vertices.
+//
+// Complexity:
+//   - Time O(W d log(W d)) for limit W and degree d, Space O(W d).
+func (c *contractor) witnessSearch(source, excluded int32, bound float64) uint32 {
+	c.stamp++
+	if c.stamp == 0 {
+		clear(c.witness.stamp)
+		c.stamp = 1
+	}
+	side := c.witness
+	side.queue = side.queue[:0]
+	side.reach(source, 0, -1, -1, c.stamp)
+
+	for settled := 0; len(side.queue) > 0 && settled < c.config.WitnessLimit; {
+		item := heap.Pop(&side.queue).(queueItem)
+		if item.distance > side.distance[item.vertex] {
+			continue
+		}
+		if item.distance > bound {
+			break
+		}
+		settled++
+
+		for next, current := range c.outgoing[item.vertex] {
+			if next == excluded {
+				continue
+			}
+			candidate := item.distance + current.weight
+			if candidate > bound {
+				continue
+			}
+			if side.stamp[next] != c.stamp || candidate < side.distance[next] {
+				side.reach(next, candidate, -1, item.vertex, c.stamp)
+			}
+		}
+	}
+
+	return c.stamp
+}
+
+// contract removes vertex from the remaining graph, freezes its arcs, and
+// inserts shortcuts that bridge it.
+//
+// Complexity:
+//   - Time O(d log d + s) for degree d and s shortcuts, Space O(d).
+func (c *contractor) contract(vertex int32, shortcuts []shortcut) {
+	neighbors := make(map[int32]struct{}, len(c.outgoing[vertex])+len(c.incoming[vertex]))
+	for head, current := range c.outgoing[vertex] {
+		c.up[vertex] = append(c.up[vertex], ownedArc{head: head, arc: current})
+		delete(c.incoming[head], vertex)
+		neighbors[head] = struct{}{}
+	}
+	for head, current := range c.incoming[vertex] {
+		c.down[vertex] = append(c.down[vertex], ownedArc{head: head, arc: current})
+		delete(c.outgoing[head], vertex)
+		neighbors[head] = struct{}{}
+	}
+	for neighbor := range neighbors {
+		c.deleted[neighbor]++
+	}
+	c.outgoing[vertex], c.incoming[vertex] = nil, nil
+
+	for _, bridge := range shortcuts {
+		c.addArc(bridge.from, bridge.to, arc{weight: bridge.weight, edge: -1, middle: vertex})
+	}
+}
+
+// sortedHeads returns the keys of arcs in ascending order.
+func sortedHeads(arcs map[int32]arc) []int32 {
+	heads := make([]int32, 0, len(arcs))
+	for head := range arcs {
+		heads = append(heads, head)
+	}
+	slices.Sort(heads)
+
+	return heads
+}
+
+// freeze converts per-owner arc lists into a CSR table sorted by head.
+//
+// Complexity:
+//   - Time O(A log d), Space O(V + A) for A arcs.
+func freeze(owned [][]ownedArc) arcTable {
+	table := arcTable{start: make([]int32, len(owned)+1)}
+	for owner, arcs := range owned {
+		slices.SortFunc(arcs, func(a, b ownedArc) int { return int(a.head - b.head) })
+		for _, current := range arcs {
+			table.head = append(table.head, current.head)
+			table.weight = append(table.weight, current.weight)
+			table.edge = append(table.edge, current.edge)
+			table.middle = append(table.middle, current.middle)
+		}
+		table.start[owner+1] = int32(len(table.head))
+	}
+
+	return table
+}
@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Serialized layout (little-endian):
+//
+//	magic "LVCH" | version uint32
+//	vertex count uint32 | vertex IDs (uint32 length + bytes)... | rank []int32
+//	edge count uint32   | edge IDs (uint32 length + bytes)...
+//	up table, then down table, each as:
+//	  start []int32 (vertex count + 1) | head []int32 | weight []float64 |
+//	  edge []int32 | middle []int32   (arc count = start[vertex count])
+const (
+	formatMagic   = "LVCH"
+	formatVersion = uint32(1)
+
+	// readChunk caps each allocation while decoding, so a corrupted count fails
+	// on EOF instead of reserving gigabytes up front.
+	readChunk = 1 << 16
+)
+
+// countingWriter counts bytes that reach the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+// encodeHierarchy writes h in the serialized layout.
+//
+// Complexity:
+//   - Time O(V + E + S), Space O(1) beyond the bufio buffer.
+func encodeHierarchy(w io.Writer, h *Hierarchy) (int64, error) {
+	counter := &countingWriter{w: w}
+	buffered := bufio.NewWriter(counter)
+
+	write := func(data any) error { return binary.Write(buffered, binary.LittleEndian, data) }
+	writeStrings := func(values []string) error {
+		if err := write(uint32(len(values))); err != nil {
+			return err
+		}
+		for _, value := range values {
+			if err := write(uint32(len(value))); err != nil {
+				return err
+			}
+			if _, err := buffered.WriteString(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	writeTable := func(table *arcTable) error {
+		for _, data := range []any{table.start, table.head, table.weight, table.edge, table.middle} {
+			if err := write(data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	steps := []func() error{
+		func() error { _, err := buffered.WriteString(formatMagic); return err },
+		func() error { return write(formatVersion) },
+		func() error { return writeStrings(h.ids) },
+		func() error { return write(h.rank) },
+		func() error { return writeStrings(h.edgeIDs) },
+		func() error { return writeTable(&h.up) },
+		func() error { return writeTable(&h.down) },
+		buffered.Flush,
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return counter.n, err
+		}
+	}
+
+	return counter.n, nil
+}
+
+// decodeHierarchy reads and validates a hierarchy written by encodeHierarchy.
+//
+// Implementation:
+//   - Stage 1: Check magic and version.
+//   - Stage 2: Read vertices, ranks, edges, and both tables in bounded chunks.
+//   - Stage 3: Validate every structural invariant the query relies on.
+//
+// Errors:
+//   - ErrBadFormat (wrapped with detail) for truncated or inconsistent input.
+//   - ErrUnsupportedVersion for other format versions.
+//   - Any non-EOF error returned by r.
+//
+// Complexity:
+//   - Time O(V + (E + S) log d), Space O(V + E + S).
+func decodeHierarchy(r io.Reader) (*Hierarchy, error) {
+	buffered := bufio.NewReader(r)
+
+	magic, err := readSlice[byte](buffered, len(formatMagic))
+	if err != nil {
+		return nil, formatError(err)
+	}
+	if string(magic) != formatMagic {
+		return nil, fmt.Errorf("%w: bad magic %q", ErrBadFormat, magic)
+	}
+	var version uint32
+	if err = binary.Read(buffered, binary.LittleEndian, &version); err != nil {
+		return nil, formatError(err)
+	}
+	if version != formatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+
+	h := &Hierarchy{}
+	if h.ids, err = readStrings(buffered); err != nil {
+		return nil, formatError(err)
+	}
+	if h.rank, err = readSlice[int32](buffered, len(h.ids)); err != nil {
+		return nil, formatError(err)
+	}
+	if h.edgeIDs, err = readStrings(buffered); err != nil {
+		return nil, formatError(err)
+	}
+	if h.up, err = readTable(buffered, len(h.ids)); err != nil {
+		return nil, formatError(err)
+	}
+	if h.down, err = readTable(buffered, len(h.ids)); err != nil {
+		return nil, formatError(err)
+	}
+
+	if err = h.validate(); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// formatError classifies truncated input as ErrBadFormat and passes other errors through.
+func formatError(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: truncated input", ErrBadFormat)
+	}
+
+	return err
+}
+
+// readSlice reads count fixed-size values in chunks of at most readChunk.
+func readSlice[T byte | int32 | float64](r io.Reader, count int) ([]T, error) {
+	values := make([]T, 0, min(count, readChunk))
+	for len(values) < count {
+		chunk := make([]T, min(count-len(values), readChunk))
+		if err := binary.Read(r, binary.LittleEndian, chunk); err != nil {
+			return nil, err
+		}
+		values = append(values, chunk...)
+	}
+
+	return values, nil
+}
+
+// readStrings reads a uint32 count followed by length-prefixed strings.
+func readStrings(r io.Reader) ([]string, error) {
+	var count uint32
+	if err := binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, min(int(count), readChunk))
+	for len(values) < int(count) {
+		var length uint32
+		if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+			return nil, err
+		}
+		data, err := readSlice[byte](r, int(length))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, string(data))
+	}
+
+	return values, nil
+}
+
+// readTable reads one CSR table for n owners.
+//
+// Errors:
+//   - ErrBadFormat if start offsets are not a non-decreasing sequence from 0.
+func readTable(r io.Reader, n int) (arcTable, error) {
+	var table arcTable
+	var err error
+	if table.start, err = readSlice[int32](r, n+1); err != nil {
+		return arcTable{}, err
+	}
+	if table.start[0] != 0 {
+		return arcTable{}, fmt.Errorf("%w: table offsets must start at 0", ErrBadFormat)
+	}
+	for owner := 0; owner < n; owner++ {
+		if table.start[owner+1] < table.start[owner] {
+			return arcTable{}, fmt.Errorf("%w: table offsets decrease at vertex %d", ErrBadFormat, owner)
+		}
+	}
+
+	arcs := int(table.start[n])
+	if table.head, err = readSlice[int32](r, arcs); err != nil {
+		return arcTable{}, err
+	}
+	if table.weight, err = readSlice[float64](r, arcs); err != nil {
+		return arcTable{}, err
+	}
+	if table.edge, err = readSlice[int32](r, arcs); err != nil {
+		return arcTable{}, err
+	}
+	if table.middle, err = readSlice[int32](r, arcs); err != nil {
+		return arcTable{}, err
+	}
+
+	return table, nil
+}
+
+// validate checks every invariant the query and unpacking rely on, so a
+// decoded hierarchy can never index out of range or recurse forever.
+//
+// Errors:
+//   - ErrBadFormat wrapped with the first violated invariant.
+//
+// Complexity:
+//   - Time O(V + A log d) for A arcs, Space O(V).
+func (h *Hierarchy) validate() error {
+	n := int32(len(h.ids))
+
+	h.index = make(map[string]int32, len(h.ids))
+	for position, vertexID := range h.ids {
+		if vertexID == "" {
+			return fmt.Errorf("%w: empty vertex id", ErrBadFormat)
+		}
+		if _, ok := h.index[vertexID]; ok {
+			return fmt.Errorf("%w: duplicate vertex id %q", ErrBadFormat, vertexID)
+		}
+		h.index[vertexID] = int32(position)
+	}
+
+	seen := make([]bool, n)
+	for _, order := range h.rank {
+		if order < 0 || order >= n || seen[order] {
+			return fmt.Errorf("%w: rank is not a permutation", ErrBadFormat)
+		}
+		seen[order] = true
+	}
+
+	for _, table := range []*arcTable{&h.up, &h.down} {
+		for owner := int32(0); owner < n; owner++ {
+			for arc := table.start[owner]; arc < table.start[owner+1]; arc++ {
+				if err := h.validateArc(table, owner, arc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateArc checks one arc owned by owner.
+func (h *Hierarchy) validateArc(table *arcTable, owner, arc int32) error {
+	n := int32(len(h.ids))
+	head, weight, edge, middle := table.head[arc], table.weight[arc], table.edge[arc], table.middle[arc]
+
+	if head < 0 || head >= n || h.rank[head] <= h.rank[owner] {
+		return fmt.Errorf("%w: arc %d of vertex %q does not climb in rank", ErrBadFormat, arc, h.ids[owner])
+	}
+	if arc > table.start[owner] && table.head[arc-1] >= head {
+		return fmt.Errorf("%w: arcs of vertex %q are not sorted", ErrBadFormat, h.ids[owner])
+	}
+	if math.IsNaN(weight) || math.IsInf(weight, 0) || weight < 0 {
+		return fmt.Errorf("%w: arc %d of vertex %q has weight %v", ErrBadFormat, arc, h.ids[owner], weight)
+	}
+
+	switch {
+	case middle == -1:
+		if edge < 0 || int(edge) >= len(h.edgeIDs) {
+			return fmt.Errorf("%w: arc %d of vertex %q has no edge", ErrBadFormat, arc, h.ids[owner])
+		}
+	case edge != -1 || middle < 0 || middle >= n || h.rank[middle] >= h.rank[owner]:
+		return fmt.Errorf("%w: shortcut %d of vertex %q has a bad middle", ErrBadFormat, arc, h.ids[owner])
+	default:
+		tail, end := owner, head
+		if table == &h.down {
+			tail, end = head, owner
+		}
+		if h.down.find(middle, tail) < 0 || h.up.find(middle, end) < 0 {
+			return fmt.Errorf("%w: shortcut %d of vertex %q cannot be unpacked", ErrBadFormat, arc, h.ids[owner])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+)
+
+// queueItem is one tentative distance of a vertex.
+type queueItem struct {
+	distance float64
+	vertex   int32
+}
+
+// vertexQueue is a min-heap ordered by (distance, vertex).
+//
+// AI-Hints:
+//   - The vertex tie-break makes pop order, and therefore every search, deterministic.
+type vertexQueue []queueItem
+
+func (q vertexQueue) Len() int { return len(q) }
+
+func (q vertexQueue) Less(i, j int) bool {
+	if q[i].distance != q[j].distance {
+		return q[i].distance < q[j].distance
+	}
+
+	return q[i].vertex < q[j].vertex
+}
+
+func (q vertexQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *vertexQueue) Push(x any) { *q = append(*q, x.(queueItem)) }
+
+func (q *vertexQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return item
+}
+
+// searchSide is the reusable state of one search direction.
+//
+// Behavior highlights:
+//   - A vertex is reached in the current query iff stamp[v] equals the query
+//     stamp, so buffers are never cleared between queries.
+type searchSide struct {
+	distance []float64
+	stamp    []uint32
+	parent   []int32
+	from     []int32
+	queue    vertexQueue
+}
+
+// newSearchSide allocates the buffers of one direction for n vertices.
+func newSearchSide(n int) *searchSide {
+	return &searchSide{
+		distance: make([]float64, n),
+		stamp:    make([]uint32, n),
+		parent:   make([]int32, n),
+		from:     make([]int32, n),
+	}
+}
+
+// top returns the smallest queued distance, or +Inf when the queue is empty.
+func (s *searchSide) top() float64 {
+	if len(s.queue) == 0 {
+		return math.Inf(1)
+	}
+
+	return s.queue[0].distance
+}
+
+// reach records a tentative distance and queues the vertex.
+func (s *searchSide) reach(vertex int32, distance float64, parent, from int32, stamp uint32) {
+	s.distance[vertex] = distance
+	s.stamp[vertex] = stamp
+	s.parent[vertex] = parent
+	s.from[vertex] = from
+	heap.Push(&s.queue, queueItem{distance: distance, vertex: vertex})
+}
+
+// nextStamp advances the query stamp, clearing stamps once on wrap-around.
+func (q *Query) nextStamp() uint32 {
+	q.stamp++
+	if q.stamp == 0 {
+		clear(q.forward.stamp)
+		clear(q.backward.stamp)
+		q.stamp = 1
+	}
+
+	return q.stamp
+}
+
+// search runs the bidirectional upward search from source to target.
+//
+// Implementation:
+//   - Stage 1: Seed both directions at distance 0.
+//   - Stage 2: Always settle from the side with the smaller queue top.
+//   - Stage 3: On every settle, combine with the opposite side's distance to
+//     improve the best meeting vertex.
+//   - Stage 4: Stop once neither queue can beat the best meeting distance.
+//
+// Behavior highlights:
+//   - The highest-ranked vertex of a shortest up-down path is settled by both
+//     sides with exact distances before either side stops, so the result is exact.
+//
+// Returns:
+//   - int32: the meeting vertex, or -1 if target is unreachable.
+//   - float64: the shortest distance.
+//
+// Complexity:
+//   - Time O(k log k) for k vertices in both upward search spaces, Space O(k).
+func (q *Query) search(source, target int32) (int32, float64) {
+	stamp := q.nextStamp()
+	forward, backward := q.forward, q.backward
+	forward.queue = forward.queue[:0]
+	backward.queue = backward.queue[:0]
+
+	forward.reach(source, 0, -1, -1, stamp)
+	backward.reach(target, 0, -1, -1, stamp)
+
+	best, meet := math.Inf(1), int32(-1)
+	for {
+		forwardTop, backwardTop := forward.top(), backward.top()
+		if forwardTop >= best && backwardTop >= best {
+			break
+		}
+
+		side, other, table := forward, backward, &q.hierarchy.up
+		if backwardTop < forwardTop {
+			side, other, table = backward, forward, &q.hierarchy.down
+		}
+
+		item := heap.Pop(&side.queue).(queueItem)
+		if item.distance > side.distance[item.vertex] {
+			continue
+		}
+		if other.stamp[item.vertex] == stamp {
+			if total := item.distance + other.distance[item.vertex]; total < best {
+				best, meet = total, item.vertex
+			}
+		}
+
+		for arc := table.start[item.vertex]; arc < table.start[item.vertex+1]; arc++ {
+			head := table.head[arc]
+			candidate := item.distance + table.weight[arc]
+			if side.stamp[head] != stamp || candidate < side.distance[head] {
+				side.reach(head, candidate, arc, item.vertex, stamp)
+			}
+		}
+	}
+
+	return meet, best
+}
+
+// unpack expands the meeting path of the last search into original vertex
+// and edge IDs.
+//
+// Implementation:
+//   - Stage 1: Walk forward parents from meet back to source, then reverse.
+//   - Stage 2: Walk backward parents from meet to target.
+//   - Stage 3: Expand every arc, recursing through shortcut middles.
+//
+// Complexity:
+//   - Time O(p log d) for an unpacked path of p edges, Space O(p).
+func (q *Query) unpack(source, target, meet int32) ([]string, []string) {
+	h := q.hierarchy
+	vertexIDs := []string{h.ids[source]}
+	var edgeIDs []string
+
+	var climb []int32
+	for vertex := meet; vertex != source; vertex = q.forward.from[vertex] {
+		climb = append(climb, vertex)
+	}
+	slices.Reverse(climb)
+	for _, vertex := range climb {
+		arc := q.forward.parent[vertex]
+		h.expand(&h.up, arc, q.forward.from[vertex], vertex, &vertexIDs, &edgeIDs)
+	}
+
+	for vertex := meet; vertex != target; vertex = q.backward.from[vertex] {
+		arc := q.backward.parent[vertex]
+		h.expand(&h.down, arc, vertex, q.backward.from[vertex], &vertexIDs, &edgeIDs)
+	}
+
+	return vertexIDs, edgeIDs
+}
+
+// expand appends the original edges of arc (leading tail -> head) to the path.
+//
+// Behavior highlights:
+//   - A shortcut tail -> head via m splits into down[m] (tail -> m) and
+//     up[m] (m -> head); both exist by construction and by Load validation.
+//   - Recursion depth is bounded by the rank of tail, since every middle ranks
+//     strictly lower than the arc it bridges.
+func (h *Hierarchy) expand(table *arcTable, arc, tail, head int32, vertexIDs, edgeIDs *[]string) {
+	middle := table.middle[arc]
+	if middle < 0 {
+		*edgeIDs = append(*edgeIDs, h.edgeIDs[table.edge[arc]])
+		*vertexIDs = append(*vertexIDs, h.ids[head])
+		return
+	}
+
+	h.expand(&h.down, h.down.find(middle, tail), tail, middle, vertexIDs, edgeIDs)
+	h.expand(&h.up, h.up.find(middle, head), middle, head, vertexIDs, edgeIDs)
+}
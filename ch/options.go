@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch
+
+import "context"
+
+// DefaultWitnessLimit is the default number of vertices a witness search may
+// settle before it gives up and keeps the shortcut.
+const DefaultWitnessLimit = 256
+
+// Options holds the effective preprocessing policy.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value is not valid.
+type Options struct {
+	// WitnessLimit bounds each witness search. Lower values build faster but
+	// add redundant shortcuts; query answers are exact either way.
+	WitnessLimit int
+
+	// ctx allows cancellation between vertex contractions.
+	ctx context.Context
+}
+
+// Option configures preprocessing through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns the canonical policy: WitnessLimit = DefaultWitnessLimit
+// and context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{
+		WitnessLimit: DefaultWitnessLimit,
+		ctx:          context.Background(),
+	}
+}
+
+// WithWitnessLimit sets the number of vertices each witness search may settle.
+//
+// Behavior highlights:
+//   - A search that hits the limit keeps the shortcut; correctness never depends
+//     on the limit, only hierarchy size and build time do.
+//
+// Errors:
+//   - ErrBadWitnessLimit if limit < 1.
+func WithWitnessLimit(limit int) Option {
+	return func(o *Options) error {
+		if limit < 1 {
+			return ErrBadWitnessLimit
+		}
+		o.WitnessLimit = limit
+		return nil
+	}
+}
+
+// WithContext sets a cancellation context checked between contractions.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+//
+// Notes:
+//   - Cancellation surfaces as ctx.Err() with no partial Hierarchy.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+
+	return config, nil
+}
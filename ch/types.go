@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package ch
+
+import (
+	"io"
+	"math"
+)
+
+// arcTable is one adjacency in compressed sparse row form.
+//
+// Behavior highlights:
+//   - Arcs owned by vertex v occupy [start[v], start[v+1]) and are sorted by head.
+//   - An original arc has edge >= 0 and middle == -1; a shortcut has edge == -1
+//     and middle set to the contracted vertex it bridges.
+//
+// AI-Hints:
+//   - Every owner has a strictly lower rank than each of its heads.
+type arcTable struct {
+	start  []int32
+	head   []int32
+	weight []float64
+	edge   []int32
+	middle []int32
+}
+
+// find returns the index of the arc owned by owner with the given head, or -1.
+//
+// Complexity:
+//   - Time O(log d) for owner degree d, Space O(1).
+func (t *arcTable) find(owner, head int32) int32 {
+	low, high := t.start[owner], t.start[owner+1]
+	for low < high {
+		mid := low + (high-low)/2
+		switch {
+		case t.head[mid] < head:
+			low = mid + 1
+		case t.head[mid] > head:
+			high = mid
+		default:
+			return mid
+		}
+	}
+
+	return -1
+}
+
+// Hierarchy is a preprocessed contraction hierarchy over a weighted graph.
+//
+// Behavior highlights:
+//   - up holds arcs v -> w with rank(w) > rank(v); down holds, at v, the arcs
+//     w -> v with rank(w) > rank(v). A query only ever climbs in rank.
+//   - Vertex and edge IDs are those of the source graph; shortcuts never leak
+//     into query results.
+//
+// Inputs:
+//   - Constructed through Build or Load only.
+//
+// Determinism:
+//   - Build is deterministic for the same graph and options, so WriteTo output is
+//     byte-for-byte reproducible.
+//
+// Complexity:
+//   - Space O(V + E + S) for S shortcuts.
+//
+// Notes:
+//   - The hierarchy does not observe later graph mutation; rebuild it after edits.
+//   - The hierarchy is immutable, so any number of Query values may share it.
+//
+// AI-Hints:
+//   - Build once (or Load a saved hierarchy) and give each goroutine its own Query.
+type Hierarchy struct {
+	ids     []string
+	index   map[string]int32
+	rank    []int32
+	edgeIDs []string
+	up      arcTable
+	down    arcTable
+}
+
+// VertexCount returns the number of vertices in the hierarchy.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (h *Hierarchy) VertexCount() int {
+	if h == nil {
+		return 0
+	}
+
+	return len(h.ids)
+}
+
+// ShortcutCount returns the number of shortcut arcs added during contraction.
+//
+// Complexity:
+//   - Time O(V + E + S), Space O(1).
+//
+// AI-Hints:
+//   - Useful for tuning WithWitnessLimit: fewer shortcuts mean faster queries.
+func (h *Hierarchy) ShortcutCount() int {
+	if h == nil {
+		return 0
+	}
+
+	count := 0
+	for _, table := range []*arcTable{&h.up, &h.down} {
+		for _, middle := range table.middle {
+			if middle >= 0 {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// NewQuery returns a query engine with its own search buffers.
+//
+// Behavior highlights:
+//   - Buffers are sized once and reused, so steady-state queries do not allocate
+//     per-vertex state.
+//
+// Returns:
+//   - *Query: not safe for concurrent use; the Hierarchy is.
+//
+// Complexity:
+//   - Time O(V), Space O(V).
+//
+// AI-Hints:
+//   - Keep one Query per goroutine (or in a sync.Pool) for high query rates.
+func (h *Hierarchy) NewQuery() *Query {
+	if h == nil {
+		return nil
+	}
+
+	return &Query{
+		hierarchy: h,
+		forward:   newSearchSide(len(h.ids)),
+		backward:  newSearchSide(len(h.ids)),
+	}
+}
+
+// WriteTo serializes the hierarchy to w in the versioned binary format read by Load.
+//
+// Behavior highlights:
+//   - Implements io.WriterTo; output is little-endian and independent of the host.
+//
+// Returns:
+//   - int64: the number of bytes written.
+//
+// Errors:
+//   - ErrNilHierarchy if the receiver is nil; any error returned by w.
+//
+// Complexity:
+//   - Time O(V + E + S), Space O(1) beyond buffering.
+func (h *Hierarchy) WriteTo(w io.Writer) (int64, error) {
+	if h == nil {
+		return 0, ErrNilHierarchy
+	}
+
+	return encodeHierarchy(w, h)
+}
+
+// Query answers point-to-point queries against a Hierarchy.
+//
+// Behavior highlights:
+//   - Each query is a bidirectional upward search: forward over up arcs from
+//     the source, backward over down arcs from the target.
+//   - Shortcuts on the winning path are unpacked recursively to original
+//     vertex and edge IDs.
+//
+// Inputs:
+//   - Constructed through Hierarchy.NewQuery only.
+//
+// Notes:
+//   - Not safe for concurrent use; create one Query per goroutine.
+type Query struct {
+	hierarchy *Hierarchy
+	forward   *searchSide
+	backward  *searchSide
+	stamp     uint32
+}
+
+// ShortestPathTo returns one shortest path from sourceID to targetID as
+// original vertex IDs, together with its distance.
+//
+// Implementation:
+//   - Stage 1: Validate the receiver and endpoints.
+//   - Stage 2: Run the bidirectional upward search.
+//   - Stage 3: Unpack shortcuts on the meeting path.
+//
+// Behavior highlights:
+//   - The distance equals dijkstra.ShortestPathTo on the source graph.
+//   - sourceID == targetID yields the single-vertex path with distance 0.
+//
+// Returns:
+//   - []string: vertex IDs from sourceID to targetID.
+//   - float64: the shortest-path distance.
+//
+// Errors:
+//   - ErrNilHierarchy, ErrEmptySourceID, ErrSourceNotFound, ErrEmptyTargetID,
+//     ErrTargetNotFound.
+//   - ErrNoPath if targetID is unreachable.
+//
+// Determinism:
+//   - Deterministic for the same hierarchy and endpoints; among several shortest
+//     paths the witness may differ from the one dijkstra returns.
+//
+// Complexity:
+//   - Typically O(k log k) for the k vertices in the two upward search spaces,
+//     plus the unpacked path length.
+func (q *Query) ShortestPathTo(sourceID, targetID string) ([]string, float64, error) {
+	vertexIDs, _, distance, err := q.path(sourceID, targetID)
+
+	return vertexIDs, distance, err
+}
+
+// EdgePathTo returns the edge IDs of one shortest path from sourceID to
+// targetID, together with its distance.
+//
+// Behavior highlights:
+//   - EdgeIDs[i] leads from the i-th to the (i+1)-th vertex of ShortestPathTo;
+//     for multi-edges it is the lightest parallel edge (smallest ID on ties).
+//
+// Errors:
+//   - Same as ShortestPathTo.
+//
+// Complexity:
+//   - Same as ShortestPathTo.
+func (q *Query) EdgePathTo(sourceID, targetID string) ([]string, float64, error) {
+	_, edgeIDs, distance, err := q.path(sourceID, targetID)
+
+	return edgeIDs, distance, err
+}
+
+// DistanceTo returns the shortest-path distance from sourceID to targetID.
+//
+// Behavior highlights:
+//   - Unreachable is +Inf with a nil error, mirroring dijkstra.DistanceTo.
+//   - No shortcut is unpacked.
+//
+// Errors:
+//   - Any error of ShortestPathTo except ErrNoPath.
+//
+// Complexity:
+//   - Same as the search phase of ShortestPathTo.
+func (q *Query) DistanceTo(sourceID, targetID string) (float64, error) {
+	source, target, err := q.endpoints(sourceID, targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	meet, distance := q.search(source, target)
+	if meet < 0 {
+		return math.Inf(1), nil
+	}
+
+	return distance, nil
+}
+
+// path resolves endpoints, searches, and unpacks the meeting path.
+func (q *Query) path(sourceID, targetID string) ([]string, []string, float64, error) {
+	source, target, err := q.endpoints(sourceID, targetID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	meet, distance := q.search(source, target)
+	if meet < 0 {
+		return nil, nil, 0, ErrNoPath
+	}
+	vertexIDs, edgeIDs := q.unpack(source, target, meet)
+
+	return vertexIDs, edgeIDs, distance, nil
+}
+
+// endpoints validates the receiver and maps both IDs to dense indices.
+//
+// Errors:
+//   - ErrNilHierarchy, ErrEmptySourceID, ErrSourceNotFound, ErrEmptyTargetID,
+//     ErrTargetNotFound.
+func (q *Query) endpoints(sourceID, targetID string) (int32, int32, error) {
+	if q == nil || q.hierarchy == nil {
+		return 0, 0, ErrNilHierarchy
+	}
+	if sourceID == "" {
+		return 0, 0, ErrEmptySourceID
+	}
+	source, ok := q.hierarchy.index[sourceID]
+	if !ok {
+		return 0, 0, ErrSourceNotFound
+	}
+	if targetID == "" {
+		return 0, 0, ErrEmptyTargetID
+	}
+	target, ok := q.hierarchy.index[targetID]
+	if !ok {
+		return 0, 0, ErrTargetNotFound
+	}
+
+	return source, target, nil
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// AI-HINTS (file):
+//   - The oracle enumerates simple paths by DFS; bottleneck optima over walks
+//     equal optima over simple paths, so the comparison is exact.
+//   - Witnesses are checked by recomputing their bottleneck from real edges.
+
+// bottleneckOracle returns the minimax and widest values from sourceID to every
+// vertex by exhaustive simple-path enumeration.
+func bottleneckOracle(t *testing.T, graph *core.Graph, sourceID string) (map[string]float64, map[string]float64) {
+	t.Helper()
+
+	minimax := map[string]float64{sourceID: 0}
+	widest := map[string]float64{sourceID: math.Inf(1)}
+	for _, vertexID := range graph.Vertices() {
+		if vertexID != sourceID {
+			minimax[vertexID] = math.Inf(1)
+			widest[vertexID] = math.Inf(-1)
+		}
+	}
+
+	onPath := map[string]bool{sourceID: true}
+	var walk func(currentID string, heaviest, narrowest float64)
+	walk = func(currentID string, heaviest, narrowest float64) {
+		minimax[currentID] = math.Min(minimax[currentID], heaviest)
+		widest[currentID] = math.Max(widest[currentID], narrowest)
+
+		edges, err := graph.Neighbors(currentID)
+		if err != nil {
+			t.Fatalf("Neighbors(%q) failed: %v", currentID, err)
+		}
+		for _, edge := range edges {
+			if edge.Directed && edge.From != currentID {
+				continue
+			}
+			nextID := edge.To
+			if edge.To == currentID {
+				nextID = edge.From
+			}
+			if onPath[nextID] {
+				continue
+			}
+			onPath[nextID] = true
+			walk(nextID, math.Max(heaviest, edge.Weight), math.Min(narrowest, edge.Weight))
+			onPath[nextID] = false
+		}
+	}
+	walk(sourceID, 0, math.Inf(1))
+
+	return minimax, widest
+}
+
+// TestPathAlgebra_MatchesEnumeration verifies Minimax and Widest values and
+// witnesses against exhaustive enumeration on random multigraphs.
+//
+// Implementation:
+//   - Stage 1: Build random directed, undirected, and mixed graphs.
+//   - Stage 2: Compare every vertex value with the oracle.
+//   - Stage 3: Recompute each witness bottleneck through EdgePathTo / PrevEdge.
+func TestPathAlgebra_MatchesEnumeration(t *testing.T) {
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 3; seed++ {
+			graph := buildRandomMixedGraph(t, seed, 8, 18, mode)
+			weights := make(map[string]float64)
+			for _, edge := range graph.Edges() {
+				weights[edge.ID] = edge.Weight
+			}
+			sourceID := graph.Vertices()[0]
+			wantMinimax, wantWidest := bottleneckOracle(t, graph, sourceID)
+
+			minimax, err := dijkstra.Minimax(graph, sourceID, dijkstra.WithPathTracking())
+			if err != nil {
+				t.Fatalf("%s/%d Minimax failed: %v", mode, seed, err)
+			}
+			widest, err := dijkstra.Widest(graph, sourceID, dijkstra.WithPathTracking())
+			if err != nil {
+				t.Fatalf("%s/%d Widest failed: %v", mode, seed, err)
+			}
+
+			for vertexID, want := range wantMinimax {
+				mustEqualFloat64(t, minimax.Distances[vertexID], want,
+					"%s/%d minimax %q: got=%v want=%v", mode, seed, vertexID, minimax.Distances[vertexID], want)
+				if vertexID == sourceID || math.IsInf(want, 1) {
+					continue
+				}
+				edgeIDs, _, err := minimax.EdgePathTo(vertexID)
+				if err != nil {
+					t.Fatalf("%s/%d minimax EdgePathTo(%q) failed: %v", mode, seed, vertexID, err)
+				}
+				heaviest := 0.0
+				for _, edgeID := range edgeIDs {
+					heaviest = math.Max(heaviest, weights[edgeID])
+				}
+				mustEqualFloat64(t, heaviest, want, "%s/%d minimax witness %q: got=%v want=%v", mode, seed, vertexID, heaviest, want)
+			}
+
+			for vertexID, want := range wantWidest {
+				got, err := widest.WidthTo(vertexID)
+				if err != nil {
+					t.Fatalf("WidthTo(%q) failed: %v", vertexID, err)
+				}
+				mustEqualFloat64(t, got, want, "%s/%d widest %q: got=%v want=%v", mode, seed, vertexID, got, want)
+				if vertexID == sourceID {
+					continue
+				}
+				path, err := widest.PathTo(vertexID)
+				if math.IsInf(want, -1) {
+					mustErrorIs(t, err, dijkstra.ErrNoPath)
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s/%d widest PathTo(%q) failed: %v", mode, seed, vertexID, err)
+				}
+				narrowest := math.Inf(1)
+				for _, hopID := range path[1:] {
+					narrowest = math.Min(narrowest, weights[widest.PrevEdge[hopID]])
+				}
+				mustEqualFloat64(t, narrowest, want, "%s/%d widest witness %q: got=%v want=%v", mode, seed, vertexID, narrowest, want)
+			}
+		}
+	}
+}
+
+// TestPathAlgebra_Policy verifies option interplay and validation.
+//
+// Implementation:
+//   - Stage 1: On S->A (5), A->T (1), S->T (3), minimax picks S->T and widest
+//     picks S->T as well (width 3 beats min(5, 1)).
+//   - Stage 2: WithMaxDistance(2) is a risk ceiling for Minimax.
+//   - Stage 3: Widest rejects a finite MaxDistance, a finite InfEdgeThreshold,
+//     and unknown sources.
+func TestPathAlgebra_Policy(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	mustAddEdge(t, graph, "S", "A", 5)
+	mustAddEdge(t, graph, "A", "T", 1)
+	mustAddEdge(t, graph, "S", "T", 3)
+
+	minimax, err := dijkstra.Minimax(graph, "S", dijkstra.WithPathTracking())
+	if err != nil {
+		t.Fatalf("Minimax failed: %v", err)
+	}
+	path, err := minimax.PathTo("T")
+	if err != nil {
+		t.Fatalf("Minimax PathTo failed: %v", err)
+	}
+	assertPathEqual(t, path, []string{"S", "T"})
+
+	widest, err := dijkstra.Widest(graph, "S")
+	if err != nil {
+		t.Fatalf("Widest failed: %v", err)
+	}
+	mustEqualFloat64(t, widest.Widths["T"], 3, "widest T: got=%v want=3", widest.Widths["T"])
+	mustEqualBool(t, math.IsInf(widest.Widths["S"], 1), true, "source width is +Inf")
+	_, err = widest.PathTo("T")
+	mustErrorIs(t, err, dijkstra.ErrPathTrackingDisabled)
+
+	capped, err := dijkstra.Minimax(graph, "S", dijkstra.WithMaxDistance(2))
+	if err != nil {
+		t.Fatalf("Minimax(max) failed: %v", err)
+	}
+	assertInfDistance(t, capped.Distances["T"])
+
+	_, err = dijkstra.Widest(graph, "S", dijkstra.WithMaxDistance(2))
+	mustErrorIs(t, err, dijkstra.ErrBadMaxDistance)
+	_, err = dijkstra.Widest(graph, "S", dijkstra.WithInfEdgeThreshold(4))
+	mustErrorIs(t, err, dijkstra.ErrBadInfEdgeThreshold)
+	_, err = dijkstra.Widest(graph, "Q")
+	mustErrorIs(t, err, dijkstra.ErrSourceNotFound)
+	_, err = dijkstra.Minimax(nil, "S")
+	mustErrorIs(t, err, dijkstra.ErrNilGraph)
+}
@@ -3,7 +3,12 @@
 
 package dijkstra
 
-import "github.com/katalvlaran/lvlath/core"
+import (
+	"context"
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/core"
+)
 
 // Dijkstra runs deterministic single-source shortest paths over a weighted graph
 // and returns a detached result object that exposes distances and optional path
@@ -60,7 +65,7 @@ func Dijkstra(g *core.Graph, sourceID string, opts ...Option) (*Result, error) {
 		return nil, err
 	}
 
-	return runDijkstra(g, sourceID, config)
+	return runDijkstra(g, sourceID, config, algebraSum)
 }
 
 // Distances runs Dijkstra and returns a deep copy of the finalized distance map.
@@ -255,3 +260,606 @@ func ShortestPathTo(g *core.Graph, sourceID, targetID string, opts ...Option) ([
 
 	return path, distance, nil
 }
+
+// AStar runs a target-directed A* search guided by a caller heuristic and returns
+// one deterministic shortest-path witness together with its distance.
+// It is the point-query alternative to ShortestPathTo when a lower bound on the
+// remaining distance is known, for example straight-line distance on road maps.
+//
+// Implementation:
+//   - Stage 1: Validate facade-level inputs.
+//   - Stage 2: Assemble the finalized runtime policy through applyOptions.
+//   - Stage 3: Delegate to the shared kernel in A* mode.
+//
+// Behavior highlights:
+//   - The search stops as soon as targetID is finalized.
+//   - Only edges relaxed by the search are inspected and classified.
+//   - MaxDistance and InfEdgeThreshold apply exactly as in Dijkstra.
+//   - WithHeuristicCheck enables runtime verification of the heuristic contract.
+//   - Path tracking is always on; WithPathTracking is accepted and has no effect.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - sourceID: the source vertex identifier.
+//   - targetID: the target vertex identifier.
+//   - heuristic: a consistent estimate of remaining distance to targetID.
+//   - opts: zero or more functional runtime options.
+//
+// Returns:
+//   - []string: one deterministic shortest-path witness from source to target.
+//   - float64: the shortest-path distance to the target.
+//
+// Errors:
+//   - ErrNilGraph, ErrEmptySourceID, ErrEmptyTargetID, ErrNilHeuristic.
+//   - ErrUnweightedGraph, ErrSourceNotFound, ErrTargetNotFound.
+//   - ErrNilOption or any option-validation error returned by applyOptions.
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrDistanceOverflow from relaxation.
+//   - ErrInvalidHeuristic for malformed estimates.
+//   - ErrInadmissibleHeuristic when WithHeuristicCheck detects a violation.
+//   - ErrNoPath if targetID is unreachable under the effective policy.
+//
+// Determinism:
+//   - Deterministic for the same graph state, inputs, pure heuristic, and options.
+//
+// Complexity:
+//   - Worst case O((V + E) log V); typically far fewer vertices are settled
+//     than by ShortestPathTo when the heuristic is informative.
+//   - Space O(V) for traversal state.
+//
+// Notes:
+//   - With a consistent heuristic the distance equals ShortestPathTo's distance.
+//   - The witness may differ from ShortestPathTo's when several shortest paths exist.
+//
+// AI-Hints:
+//   - Use a zero heuristic to obtain target-stopped Dijkstra without a full scan.
+//   - Do not pass inadmissible heuristics to trade accuracy for speed; the result
+//     contract promises shortest distances.
+func AStar(
+	g *core.Graph,
+	sourceID, targetID string,
+	heuristic Heuristic,
+	opts ...Option,
+) ([]string, float64, error) {
+	if g == nil {
+		return nil, 0, ErrNilGraph
+	}
+	if sourceID == "" {
+		return nil, 0, ErrEmptySourceID
+	}
+	if targetID == "" {
+		return nil, 0, ErrEmptyTargetID
+	}
+	if heuristic == nil {
+		return nil, 0, ErrNilHeuristic
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return runAStar(g, sourceID, targetID, heuristic, config)
+}
+
+// NewBidirectional validates g and builds a reusable bidirectional point-to-point
+// query engine over frozen relation snapshots.
+//
+// Implementation:
+//   - Stage 1: Validate facade-level inputs.
+//   - Stage 2: Assemble the finalized runtime policy through applyOptions.
+//   - Stage 3: Require a weighted graph and pre-scan edge weights.
+//   - Stage 4: Snapshot vertices plus outgoing and incoming relations.
+//
+// Behavior highlights:
+//   - Options are bound to the engine and apply to every query.
+//   - WithPathTracking is accepted and has no effect; queries always track paths.
+//
+// Inputs:
+//   - g: the weighted graph to index.
+//   - opts: zero or more functional runtime options.
+//
+// Returns:
+//   - *Bidirectional: the query engine.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph.
+//   - ErrNilOption or any option-validation error returned by applyOptions.
+//   - ErrInvalidWeight or ErrNegativeWeight, wrapped with edge context.
+//
+// Determinism:
+//   - Snapshots follow core.Vertices() and core.Edges() order.
+//
+// Complexity:
+//   - Time O(V log V + E log E), Space O(V + E).
+//
+// Notes:
+//   - Rebuild the engine after mutating g.
+//
+// AI-Hints:
+//   - Build once, query many times; that is where bidirectional search pays off.
+func NewBidirectional(g *core.Graph, opts ...Option) (*Bidirectional, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !g.Weighted() {
+		return nil, ErrUnweightedGraph
+	}
+	if err = validateEdgeWeights(g); err != nil {
+		return nil, err
+	}
+
+	vertexIDs := g.Vertices()
+	vertices := make(map[string]struct{}, len(vertexIDs))
+	for _, vertexID := range vertexIDs {
+		vertices[vertexID] = struct{}{}
+	}
+
+	outgoing, incoming := buildRelationSnapshots(g)
+
+	return &Bidirectional{
+		options:  config,
+		vertices: vertices,
+		outgoing: outgoing,
+		incoming: incoming,
+	}, nil
+}
+
+// BidirectionalShortestPathTo is the one-shot form of NewBidirectional followed by
+// Bidirectional.ShortestPathTo.
+//
+// Implementation:
+//   - Stage 1: Build a Bidirectional engine.
+//   - Stage 2: Answer one query.
+//
+// Behavior highlights:
+//   - Returns the same distance and witness as ShortestPathTo under the same options.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - sourceID: the source vertex identifier.
+//   - targetID: the target vertex identifier.
+//   - opts: zero or more functional runtime options.
+//
+// Returns:
+//   - []string: one deterministic shortest-path witness.
+//   - float64: the shortest-path distance.
+//
+// Errors:
+//   - Any error returned by NewBidirectional or Bidirectional.ShortestPathTo.
+//
+// Determinism:
+//   - Deterministic for the same graph state, endpoints, and options.
+//
+// Complexity:
+//   - O(E log E) snapshot cost plus the query cost.
+//
+// AI-Hints:
+//   - Prefer a long-lived Bidirectional engine for repeated queries on one graph.
+func BidirectionalShortestPathTo(g *core.Graph, sourceID, targetID string, opts ...Option) ([]string, float64, error) {
+	if g == nil {
+		return nil, 0, ErrNilGraph
+	}
+	if sourceID == "" {
+		return nil, 0, ErrEmptySourceID
+	}
+	if targetID == "" {
+		return nil, 0, ErrEmptyTargetID
+	}
+
+	engine, err := NewBidirectional(g, opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return engine.ShortestPathTo(sourceID, targetID)
+}
+
+// KShortestPaths returns up to k shortest loopless paths from sourceID to
+// targetID using Yen's algorithm.
+//
+// Implementation:
+//   - Stage 1: Delegate to KShortestPathsContext with context.Background().
+//
+// Behavior highlights:
+//   - Paths are simple (no repeated vertex) and sorted by non-decreasing cost.
+//   - Parallel edges produce distinct paths, told apart by Path.EdgeIDs.
+//   - Fewer than k paths are returned when fewer exist under the policy.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - sourceID, targetID: the query endpoints.
+//   - k: the maximum number of paths; must be >= 1.
+//   - opts: WithMaxDistance bounds the total path cost; WithInfEdgeThreshold walls edges.
+//
+// Returns:
+//   - []Path: at least one path on success.
+//
+// Errors:
+//   - See KShortestPathsContext.
+//
+// Determinism:
+//   - Equal-cost paths are ordered by hop count, then vertex IDs, then edge IDs.
+//
+// Complexity:
+//   - Time O(k * L * (V + E) log V), where L bounds the path length.
+//
+// AI-Hints:
+//   - Use KShortestPathsContext to bound wall time on large k.
+func KShortestPaths(g *core.Graph, sourceID, targetID string, k int, opts ...Option) ([]Path, error) {
+	return KShortestPathsContext(context.Background(), g, sourceID, targetID, k, opts...)
+}
+
+// KShortestPathsContext is KShortestPaths with cancellation.
+//
+// Implementation:
+//   - Stage 1: Reject a nil context and assemble options.
+//   - Stage 2: Run Yen's algorithm, checking ctx before every spur search.
+//
+// Inputs:
+//   - ctx: cancellation context.
+//   - g, sourceID, targetID, k, opts: as in KShortestPaths.
+//
+// Returns:
+//   - []Path: between 1 and k paths.
+//
+// Errors:
+//   - ErrNilContext, ErrBadK, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrNilGraph, ErrEmptySourceID, ErrUnweightedGraph, ErrSourceNotFound.
+//   - ErrNilOption, ErrBadMaxDistance, ErrBadInfEdgeThreshold from option assembly.
+//   - ErrInvalidWeight, ErrNegativeWeight from the weight pre-scan.
+//   - ErrNoPath if the target is unreachable under the effective policy.
+//   - ctx.Err() on cancellation; no partial list is returned.
+//
+// Determinism:
+//   - Deterministic for the same graph state, endpoints, k, and options.
+//
+// Complexity:
+//   - See KShortestPaths.
+//
+// AI-Hints:
+//   - Eppstein-style non-simple enumeration is out of scope; all paths are loopless.
+func KShortestPathsContext(
+	ctx context.Context,
+	g *core.Graph,
+	sourceID, targetID string,
+	k int,
+	opts ...Option,
+) ([]Path, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runYen(ctx, g, sourceID, targetID, k, config)
+}
+
+// MultiSource runs one Dijkstra search seeded at every source with offset 0 and
+// returns, per vertex, the distance to the nearest source, that source, and the
+// predecessor on the shortest path from it.
+//
+// Implementation:
+//   - Stage 1: Assign offset 0 to every source ID.
+//   - Stage 2: Delegate to MultiSourceWithOffsets.
+//
+// Behavior highlights:
+//   - Duplicate source IDs are harmless.
+//   - Grouping by Nearest yields the graph Voronoi partition of the sources.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - sourceIDs: the non-empty set of source vertices.
+//   - opts: WithMaxDistance bounds the published region; WithInfEdgeThreshold walls edges.
+//
+// Returns:
+//   - *MultiSourceResult: distances, nearest sources, and predecessors.
+//
+// Errors:
+//   - See MultiSourceWithOffsets.
+//
+// Determinism:
+//   - Deterministic for the same graph state, source set, and options.
+//
+// Complexity:
+//   - Time O((V + E) log V), independent of the number of sources.
+//
+// AI-Hints:
+//   - Use this for nearest-facility queries instead of one Dijkstra per facility.
+func MultiSource(g *core.Graph, sourceIDs []string, opts ...Option) (*MultiSourceResult, error) {
+	offsets := make(map[string]float64, len(sourceIDs))
+	for _, sourceID := range sourceIDs {
+		offsets[sourceID] = 0
+	}
+
+	return MultiSourceWithOffsets(g, offsets, opts...)
+}
+
+// MultiSourceWithOffsets is MultiSource with a per-source initial distance, so
+// Distances[v] = min over sources s of offsets[s] + dist(s, v).
+//
+// Implementation:
+//   - Stage 1: Assemble options.
+//   - Stage 2: Seed every source at its offset and run the shared kernel once.
+//   - Stage 3: Resolve the nearest source of each reached vertex.
+//
+// Behavior highlights:
+//   - A source whose offset exceeds MaxDistance is not seeded.
+//   - Offsets model head starts such as facility opening costs or queue delays.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - offsets: source vertex -> finite non-negative initial distance.
+//   - opts: as in MultiSource.
+//
+// Returns:
+//   - *MultiSourceResult: distances, nearest sources, and predecessors.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrNoSources.
+//   - ErrEmptySourceID, ErrSourceNotFound, ErrBadSourceOffset.
+//   - ErrNilOption, ErrBadMaxDistance, ErrBadInfEdgeThreshold from option assembly.
+//   - ErrInvalidWeight, ErrNegativeWeight from the weight pre-scan.
+//   - ErrDistanceOverflow from relaxation.
+//
+// Determinism:
+//   - Deterministic for the same graph state, offsets, and options.
+//
+// Complexity:
+//   - Time O((V + E) log V + S log S), Space O(V).
+//
+// AI-Hints:
+//   - WithPathTracking is implied; MultiSourceResult always carries Prev.
+func MultiSourceWithOffsets(g *core.Graph, offsets map[string]float64, opts ...Option) (*MultiSourceResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runMultiSource(g, offsets, config)
+}
+
+// DistanceTable computes the shortest distance from every origin in rowIDs to
+// every destination in colIDs and returns it as a matrix.Dense.
+//
+// Implementation:
+//   - Stage 1: Delegate to DistanceTableContext with context.Background().
+//
+// Behavior highlights:
+//   - Rows are computed in parallel; WithWorkers bounds the goroutine count.
+//   - Each row search stops once all destinations are finalized.
+//   - Passing the same list twice yields a square table for tsp.SolveMatrix.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - rowIDs: non-empty origins; colIDs: non-empty destinations.
+//   - opts: WithWorkers, WithMaxDistance, WithInfEdgeThreshold.
+//
+// Returns:
+//   - *Table: RowIDs, ColIDs, and the len(rowIDs) x len(colIDs) Distances matrix.
+//
+// Errors:
+//   - See DistanceTableContext.
+//
+// Determinism:
+//   - The table is identical for every worker count.
+//
+// Complexity:
+//   - Time O(R * (V + E) log V) total work, Space O(R * C + Workers * V + E).
+//
+// AI-Hints:
+//   - Prefer this over looping Distances per origin and copying into a matrix.
+func DistanceTable(g *core.Graph, rowIDs, colIDs []string, opts ...Option) (*Table, error) {
+	return DistanceTableContext(context.Background(), g, rowIDs, colIDs, opts...)
+}
+
+// DistanceTableContext is DistanceTable with cancellation.
+//
+// Implementation:
+//   - Stage 1: Reject a nil context and assemble options.
+//   - Stage 2: Run the row worker pool, checking ctx before dispatching each row.
+//
+// Inputs:
+//   - ctx: cancellation context.
+//   - g, rowIDs, colIDs, opts: as in DistanceTable.
+//
+// Returns:
+//   - *Table: the completed table; no partial table is returned on error.
+//
+// Errors:
+//   - ErrNilContext, ErrNilGraph, ErrUnweightedGraph, ErrNoSources, ErrNoTargets.
+//   - ErrEmptySourceID, ErrSourceNotFound, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrNilOption, ErrBadMaxDistance, ErrBadInfEdgeThreshold, ErrBadWorkers.
+//   - ErrInvalidWeight, ErrNegativeWeight from the weight pre-scan.
+//   - ErrDistanceOverflow from relaxation; ctx.Err() on cancellation.
+//
+// Determinism:
+//   - See DistanceTable.
+//
+// Complexity:
+//   - See DistanceTable.
+//
+// AI-Hints:
+//   - Rows already dispatched finish before ctx.Err() is returned.
+func DistanceTableContext(
+	ctx context.Context,
+	g *core.Graph,
+	rowIDs, colIDs []string,
+	opts ...Option,
+) (*Table, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runTable(ctx, g, rowIDs, colIDs, config)
+}
+
+// Minimax computes, for every vertex, the smallest possible value of the
+// heaviest edge over all paths from sourceID (the minimax or bottleneck-shortest
+// path), reusing the Dijkstra kernel, heap, and tie-breaking rules.
+//
+// Implementation:
+//   - Stage 1: Assemble options.
+//   - Stage 2: Run the shared kernel with the extension law max(current, w).
+//
+// Behavior highlights:
+//   - Distances[v] = min over paths P of max over edges e in P of w(e);
+//     Distances[source] = 0 and +Inf marks unreachable vertices.
+//   - The returned Result supports DistanceTo, HasPathTo, PathTo, and EdgePathTo
+//     exactly like a Dijkstra result.
+//   - WithMaxDistance(m) admits only edges with w <= m, i.e. a risk ceiling.
+//
+// Inputs:
+//   - g: the weighted graph; weights are risks, loads, or other per-edge penalties.
+//   - sourceID: the source vertex.
+//   - opts: the Dijkstra options (path tracking, cutoffs, callbacks).
+//
+// Returns:
+//   - *Result: minimax values in Distances, witnesses when tracking is enabled.
+//
+// Errors:
+//   - The same input, option, and weight sentinels as Dijkstra.
+//
+// Determinism:
+//   - Deterministic for the same graph state, sourceID, and options.
+//
+// Complexity:
+//   - Time O((V + E) log V), Space O(V).
+//
+// AI-Hints:
+//   - Minimax values are also the path maxima in a minimum spanning forest;
+//     use mst when every pair is needed.
+func Minimax(g *core.Graph, sourceID string, opts ...Option) (*Result, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runDijkstra(g, sourceID, config, algebraMinimax)
+}
+
+// Widest computes, for every vertex, the largest bottleneck width over all
+// paths from sourceID (the maximum-capacity path), reusing the Dijkstra kernel.
+//
+// Implementation:
+//   - Stage 1: Assemble options and reject a finite MaxDistance or InfEdgeThreshold.
+//   - Stage 2: Run the shared kernel on negated widths under max(current, -w).
+//   - Stage 3: Publish widths with WidestResult conventions.
+//
+// Behavior highlights:
+//   - Widths[v] = max over paths P of min over edges e in P of w(e).
+//   - Widths[source] = +Inf and -Inf marks unreachable vertices.
+//   - EdgeCost and the filters apply as in Dijkstra. InfEdgeThreshold does not:
+//     weights are capacities, so a threshold would drop the widest edges.
+//
+// Inputs:
+//   - g: the weighted graph; weights are capacities.
+//   - sourceID: the source vertex.
+//   - opts: the Dijkstra options.
+//
+// Returns:
+//   - *WidestResult: bottleneck widths and optional witnesses.
+//
+// Errors:
+//   - The same input, option, and weight sentinels as Dijkstra.
+//   - ErrBadMaxDistance if a finite MaxDistance is configured.
+//   - ErrBadInfEdgeThreshold if a finite InfEdgeThreshold is configured.
+//
+// Determinism:
+//   - Deterministic for the same graph state, sourceID, and options.
+//
+// Complexity:
+//   - Time O((V + E) log V), Space O(V).
+//
+// AI-Hints:
+//   - Use WithEdgeFilter to exclude links; there is no threshold for capacities.
+//   - Use this for bandwidth provisioning; use flow for the total deliverable amount.
+func Widest(g *core.Graph, sourceID string, opts ...Option) (*WidestResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runWidest(g, sourceID, config)
+}
+
+// NewDynamic wraps a path-tracking Dijkstra result of g so that later edge
+// mutations can be repaired incrementally through Dynamic.Apply instead of a
+// full recomputation.
+//
+// Implementation:
+//   - Stage 1: Validate the graph, the result, and the options.
+//   - Stage 2: Detach a copy of the result.
+//   - Stage 3: Snapshot every edge and the incoming relation of every vertex.
+//
+// Behavior highlights:
+//   - result must come from Dijkstra on the current g with the same opts and
+//     WithPathTracking; Minimax results are not supported.
+//   - Path tracking is forced on for all later repairs.
+//
+// Inputs:
+//   - g: the weighted graph the result was computed on; the caller keeps mutating it.
+//   - result: the tree to maintain; it is copied, not retained.
+//   - opts: the options of the original run.
+//
+// Returns:
+//   - *Dynamic: the incremental shortest-path state.
+//
+// Errors:
+//   - ErrNilGraph, ErrNilResult, ErrUnweightedGraph.
+//   - ErrPathTrackingDisabled if result.Prev or result.PrevEdge is nil.
+//   - ErrEmptySourceID, ErrSourceNotFound for the result source.
+//   - ErrVertexSetChanged if result and g disagree on the vertex count.
+//   - ErrNilOption or any option-validation error returned by applyOptions.
+//   - ErrInvalidWeight or ErrNegativeWeight, wrapped with edge context.
+//
+// Determinism:
+//   - Deterministic.
+//
+// Complexity:
+//   - Time O(V + E log E), Space O(V + E).
+//
+// AI-Hints:
+//   - Mutate g, then call Apply with the matching batch; never skip a mutation.
+func NewDynamic(g *core.Graph, result *Result, opts ...Option) (*Dynamic, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if result == nil {
+		return nil, ErrNilResult
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	config.TrackPaths = true
+
+	if result.Prev == nil || result.PrevEdge == nil {
+		return nil, ErrPathTrackingDisabled
+	}
+	if err = validateInputs(g, result.SourceID); err != nil {
+		return nil, err
+	}
+	if err = validateEdgeWeights(g); err != nil {
+		return nil, err
+	}
+	if len(result.Distances) != g.VertexCount() {
+		return nil, fmt.Errorf("%w: result has %d vertices, graph has %d",
+			ErrVertexSetChanged, len(result.Distances), g.VertexCount())
+	}
+
+	return newDynamic(g, result.Clone(), config), nil
+}
@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// AI-HINTS (file):
+//   - AStar must agree with ShortestPathTo on distance for every consistent heuristic.
+//   - Heuristic-check failures are asserted through sentinels only.
+//   - Grid fixtures use unit weights so the Manhattan heuristic is exactly consistent.
+
+// zeroHeuristic is the trivial consistent heuristic that turns AStar into
+// target-stopped Dijkstra.
+func zeroHeuristic(string) float64 {
+	return 0
+}
+
+// buildAStarGrid constructs a rows x cols undirected unit-weight grid and returns
+// the graph together with the coordinates of every vertex.
+//
+// Implementation:
+//   - Stage 1: Create a weighted undirected graph.
+//   - Stage 2: Connect each cell to its right and lower neighbors.
+//   - Stage 3: Record row/column coordinates per vertex ID.
+//
+// Behavior highlights:
+//   - Unit weights make the Manhattan heuristic exact on an unobstructed grid.
+//
+// AI-Hints:
+//   - Keep vertex IDs zero-padded so lexicographic order matches grid order.
+func buildAStarGrid(t *testing.T, rows, cols int) (*core.Graph, map[string][2]int) {
+	t.Helper()
+
+	graph, err := core.NewGraph(core.WithWeighted())
+	if err != nil {
+		t.Fatalf("NewGraph failed: %v", err)
+	}
+
+	coordinates := make(map[string][2]int, rows*cols)
+	cell := func(row, col int) string {
+		return fmt.Sprintf("r%02dc%02d", row, col)
+	}
+
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			coordinates[cell(row, col)] = [2]int{row, col}
+			if col+1 < cols {
+				mustAddEdge(t, graph, cell(row, col), cell(row, col+1), testWeightOne)
+			}
+			if row+1 < rows {
+				mustAddEdge(t, graph, cell(row, col), cell(row+1, col), testWeightOne)
+			}
+		}
+	}
+
+	return graph, coordinates
+}
+
+// manhattanTo returns the Manhattan-distance heuristic toward targetID.
+func manhattanTo(coordinates map[string][2]int, targetID string) dijkstra.Heuristic {
+	target := coordinates[targetID]
+
+	return func(vertexID string) float64 {
+		point := coordinates[vertexID]
+
+		return math.Abs(float64(point[0]-target[0])) + math.Abs(float64(point[1]-target[1]))
+	}
+}
+
+// TestAStar_InputValidation verifies the AStar admission order for malformed input.
+//
+// Implementation:
+//   - Stage 1: Call AStar with nil graph, empty IDs, and nil heuristic.
+//   - Stage 2: Call AStar with a missing target.
+//   - Stage 3: Assert each sentinel through errors.Is.
+//
+// Behavior highlights:
+//   - AStar reuses the existing dijkstra input sentinels.
+//
+// AI-Hints:
+//   - Keep ErrEmptyTargetID and ErrTargetNotFound distinct.
+func TestAStar_InputValidation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightOne)
+
+	_, _, err := dijkstra.AStar(nil, testVertexSource, testVertexMiddle, zeroHeuristic)
+	mustErrorIs(t, err, dijkstra.ErrNilGraph)
+
+	_, _, err = dijkstra.AStar(graph, "", testVertexMiddle, zeroHeuristic)
+	mustErrorIs(t, err, dijkstra.ErrEmptySourceID)
+
+	_, _, err = dijkstra.AStar(graph, testVertexSource, "", zeroHeuristic)
+	mustErrorIs(t, err, dijkstra.ErrEmptyTargetID)
+
+	_, _, err = dijkstra.AStar(graph, testVertexSource, testVertexMiddle, nil)
+	mustErrorIs(t, err, dijkstra.ErrNilHeuristic)
+
+	_, _, err = dijkstra.AStar(graph, testVertexSource, testVertexMissing, zeroHeuristic)
+	mustErrorIs(t, err, dijkstra.ErrTargetNotFound)
+
+	_, _, err = dijkstra.AStar(graph, testVertexMissing, testVertexMiddle, zeroHeuristic)
+	mustErrorIs(t, err, dijkstra.ErrSourceNotFound)
+
+	_, _, err = dijkstra.AStar(graph, testVertexSource, testVertexMiddle, zeroHeuristic, nil)
+	mustErrorIs(t, err, dijkstra.ErrNilOption)
+}
+
+// TestAStar_MatchesShortestPathTo verifies that AStar distances equal
+// ShortestPathTo distances for every target of a grid.
+//
+// Implementation:
+//   - Stage 1: Build a grid with a few heavier shortcut edges.
+//   - Stage 2: For every target, run AStar with the Manhattan heuristic and check mode.
+//   - Stage 3: Compare against ShortestPathTo and validate the witness endpoints.
+//
+// Behavior highlights:
+//   - Heavier extra edges keep Manhattan consistent while creating route choices.
+//
+// AI-Hints:
+//   - Compare distances exactly; unit and integer weights are exact in float64.
+func TestAStar_MatchesShortestPathTo(t *testing.T) {
+	graph, coordinates := buildAStarGrid(t, 6, 6)
+	mustAddEdge(t, graph, "r00c00", "r02c02", testWeightFive)
+	mustAddEdge(t, graph, "r01c04", "r05c04", testWeightFour)
+
+	for targetID := range coordinates {
+		wantPath, wantDistance, err := dijkstra.ShortestPathTo(graph, "r00c00", targetID)
+		if err != nil {
+			t.Fatalf("ShortestPathTo(%q) failed: %v", targetID, err)
+		}
+
+		gotPath, gotDistance, err := dijkstra.AStar(
+			graph,
+			"r00c00",
+			targetID,
+			manhattanTo(coordinates, targetID),
+			dijkstra.WithHeuristicCheck(),
+		)
+		if err != nil {
+			t.Fatalf("AStar(%q) failed: %v", targetID, err)
+		}
+
+		mustEqualFloat64(t, gotDistance, wantDistance, "AStar(%q): got=%v want=%v", targetID, gotDistance, wantDistance)
+		mustEqualString(t, gotPath[0], wantPath[0], "AStar(%q) path start: got=%q", targetID, gotPath[0])
+		mustEqualString(t, gotPath[len(gotPath)-1], targetID, "AStar(%q) path end: got=%q", targetID, gotPath[len(gotPath)-1])
+	}
+}
+
+// TestAStar_ZeroHeuristicDirectedWitness verifies that the zero heuristic yields
+// the same deterministic witness as ShortestPathTo on a directed graph.
+//
+// Implementation:
+//   - Stage 1: Build the directed fixture used by TestDijkstra_DirectedGraph.
+//   - Stage 2: Run AStar with zeroHeuristic.
+//   - Stage 3: Assert exact path and distance.
+//
+// AI-Hints:
+//   - Directed edges must not be traversed backward by AStar either.
+func TestAStar_ZeroHeuristicDirectedWitness(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+	mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightTwo)
+	mustAddEdge(t, graph, testVertexSource, testVertexAlternative, testWeightOne)
+	mustAddEdge(t, graph, testVertexAlternative, testVertexMiddle, testWeightFive)
+	mustAddEdge(t, graph, testVertexMiddle, testVertexTarget, testWeightThree)
+	mustAddEdge(t, graph, testVertexAlternative, testVertexTarget, testWeightTen)
+
+	path, distance, err := dijkstra.AStar(graph, testVertexSource, testVertexTarget, zeroHeuristic)
+	if err != nil {
+		t.Fatalf("AStar failed: %v", err)
+	}
+
+	mustEqualFloat64(t, distance, testWeightFive, "AStar distance: got=%v want=%v", distance, testWeightFive)
+	assertPathEqual(t, path, []string{testVertexSource, testVertexMiddle, testVertexTarget})
+
+	_, _, err = dijkstra.AStar(graph, testVertexTarget, testVertexSource, zeroHeuristic)
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+}
+
+// TestAStar_ReusesTraversalPolicy verifies that WithInfEdgeThreshold and
+// WithMaxDistance keep their Dijkstra semantics under AStar.
+//
+// Implementation:
+//   - Stage 1: Build a graph whose direct edge is a wall under threshold 10.
+//   - Stage 2: Assert the detour is used.
+//   - Stage 3: Assert a cutoff below the detour distance yields ErrNoPath.
+//
+// AI-Hints:
+//   - The cutoff is inclusive, exactly as in Dijkstra.
+func TestAStar_ReusesTraversalPolicy(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	mustAddEdge(t, graph, testVertexSource, testVertexTarget, testWeightTen)
+	mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightFive)
+	mustAddEdge(t, graph, testVertexMiddle, testVertexTarget, testWeightSix)
+
+	path, distance, err := dijkstra.AStar(
+		graph,
+		testVertexSource,
+		testVertexTarget,
+		zeroHeuristic,
+		dijkstra.WithInfEdgeThreshold(testWeightTen),
+	)
+	if err != nil {
+		t.Fatalf("AStar(wall) failed: %v", err)
+	}
+	mustEqualFloat64(t, distance, 11, "AStar(wall) distance: got=%v want=11", distance)
+	assertPathEqual(t, path, []string{testVertexSource, testVertexMiddle, testVertexTarget})
+
+	_, distance, err = dijkstra.AStar(
+		graph,
+		testVertexSource,
+		testVertexTarget,
+		zeroHeuristic,
+		dijkstra.WithInfEdgeThreshold(testWeightTen),
+		dijkstra.WithMaxDistance(11),
+	)
+	if err != nil {
+		t.Fatalf("AStar(inclusive cutoff) failed: %v", err)
+	}
+	mustEqualFloat64(t, distance, 11, "AStar(inclusive cutoff) distance: got=%v want=11", distance)
+
+	_, _, err = dijkstra.AStar(
+		graph,
+		testVertexSource,
+		testVertexTarget,
+		zeroHeuristic,
+		dijkstra.WithInfEdgeThreshold(testWeightTen),
+		dijkstra.WithMaxDistance(testWeightTen),
+	)
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+}
+
+// TestAStar_HeuristicCheck verifies the debug-mode detection of bad heuristics.
+//
+// Implementation:
+//   - Stage 1: Reject a heuristic with h(target) != 0.
+//   - Stage 2: Reject a heuristic that violates edge consistency.
+//   - Stage 3: Reject malformed NaN and negative estimates even without check mode.
+//
+// Behavior highlights:
+//   - The inconsistent heuristic is accepted silently without check mode.
+//
+// AI-Hints:
+//   - Keep ErrInvalidHeuristic (malformed value) distinct from ErrInadmissibleHeuristic.
+func TestAStar_HeuristicCheck(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightOne)
+	mustAddEdge(t, graph, testVertexMiddle, testVertexTarget, testWeightOne)
+
+	nonZeroTarget := func(string) float64 { return testWeightOne }
+	_, _, err := dijkstra.AStar(graph, testVertexSource, testVertexTarget, nonZeroTarget, dijkstra.WithHeuristicCheck())
+	mustErrorIs(t, err, dijkstra.ErrInadmissibleHeuristic)
+
+	overestimate := func(vertexID string) float64 {
+		if vertexID == testVertexSource {
+			return testWeightTen
+		}
+		return 0
+	}
+	_, _, err = dijkstra.AStar(graph, testVertexSource, testVertexTarget, overestimate, dijkstra.WithHeuristicCheck())
+	mustErrorIs(t, err, dijkstra.ErrInadmissibleHeuristic)
+
+	_, distance, err := dijkstra.AStar(graph, testVertexSource, testVertexTarget, overestimate)
+	if err != nil {
+		t.Fatalf("AStar(unchecked) failed: %v", err)
+	}
+	mustEqualFloat64(t, distance, testWeightTwo, "AStar(unchecked) distance: got=%v want=%v", distance, testWeightTwo)
+
+	notANumber := func(string) float64 { return math.NaN() }
+	_, _, err = dijkstra.AStar(graph, testVertexSource, testVertexTarget, notANumber)
+	mustErrorIs(t, err, dijkstra.ErrInvalidHeuristic)
+
+	negative := func(string) float64 { return -testWeightOne }
+	_, _, err = dijkstra.AStar(graph, testVertexSource, testVertexTarget, negative)
+	mustErrorIs(t, err, dijkstra.ErrInvalidHeuristic)
+}
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+	"github.com/katalvlaran/lvlath/internal/testutil"
+)
+
+// AI-HINTS (file):
+//   - Bidirectional distances and witnesses must equal ShortestPathTo exactly,
+//     including every equal-cost tie.
+//   - Witnesses are also walked over real edges to catch a shared defect.
+//   - Random fixtures use fixed seeds and integer weights for exact float equality.
+
+// buildRandomMixedGraph constructs a reproducible weighted multigraph with loops
+// and integer weights in [0, 9]; mode is "directed", "undirected", or "mixed".
+// Every mode accepts per-edge direction overrides so tests can mutate it later.
+func buildRandomMixedGraph(t *testing.T, seed int64, vertexCount, edgeCount int, mode string) *core.Graph {
+	t.Helper()
+
+	return testutil.RandomGraph(t, rand.New(rand.NewSource(seed)), testutil.Spec{
+		Vertices: vertexCount,
+		Edges:    edgeCount,
+		Mode:     testutil.Mode(mode),
+		Loops:    true,
+		Mixable:  true,
+	})
+}
+
+// witnessCost sums the cheapest admissible edge weight of every hop along path and
+// fails the test when a hop is not traversable.
+func witnessCost(t *testing.T, graph *core.Graph, path []string, threshold float64) float64 {
+	t.Helper()
+
+	total := 0.0
+	for index := 1; index < len(path); index++ {
+		edges, err := graph.Neighbors(path[index-1])
+		if err != nil {
+			t.Fatalf("Neighbors(%q) failed: %v", path[index-1], err)
+		}
+
+		best := math.Inf(1)
+		for _, edge := range edges {
+			if edge.Directed && edge.From != path[index-1] {
+				continue
+			}
+			other := edge.To
+			if !edge.Directed && edge.To == path[index-1] {
+				other = edge.From
+			}
+			if other == path[index] && edge.Weight < threshold && edge.Weight < best {
+				best = edge.Weight
+			}
+		}
+		if math.IsInf(best, 1) {
+			t.Fatalf("witness hop %q->%q is not traversable", path[index-1], path[index])
+		}
+
+		total += best
+	}
+
+	return total
+}
+
+// TestBidirectional_MatchesShortestPathTo verifies exact distance and witness
+// agreement with ShortestPathTo for every vertex pair of random directed,
+// undirected, and mixed graphs.
+//
+// Implementation:
+//   - Stage 1: Build one engine per random graph.
+//   - Stage 2: For every ordered pair, compare against ShortestPathTo.
+//   - Stage 3: Validate the witness endpoints and its real edge cost.
+//
+// Behavior highlights:
+//   - Mixed graphs exercise the backward walk over directed edges.
+//   - Zero weights and parallel edges exercise ties.
+//
+// AI-Hints:
+//   - Keep ErrNoPath agreement in the comparison; unreachable pairs are part of the law.
+func TestBidirectional_MatchesShortestPathTo(t *testing.T) {
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 4; seed++ {
+			graph := buildRandomMixedGraph(t, seed, 14, 30, mode)
+
+			engine, err := dijkstra.NewBidirectional(graph)
+			if err != nil {
+				t.Fatalf("%s/%d: NewBidirectional failed: %v", mode, seed, err)
+			}
+
+			vertices := graph.Vertices()
+			for _, sourceID := range vertices {
+				for _, targetID := range vertices {
+					wantPath, wantDistance, wantErr := dijkstra.ShortestPathTo(graph, sourceID, targetID)
+					gotPath, gotDistance, gotErr := engine.ShortestPathTo(sourceID, targetID)
+
+					if wantErr != nil {
+						mustErrorIs(t, gotErr, dijkstra.ErrNoPath)
+						continue
+					}
+					if gotErr != nil {
+						t.Fatalf("%s/%d %s->%s: unexpected error %v", mode, seed, sourceID, targetID, gotErr)
+					}
+
+					mustEqualFloat64(t, gotDistance, wantDistance,
+						"%s/%d %s->%s: got=%v want=%v", mode, seed, sourceID, targetID, gotDistance, wantDistance)
+					mustEqualString(t, fmt.Sprint(gotPath), fmt.Sprint(wantPath),
+						"%s/%d %s->%s: witness %v, ShortestPathTo %v", mode, seed, sourceID, targetID, gotPath, wantPath)
+
+					cost := witnessCost(t, graph, gotPath, math.Inf(1))
+					mustEqualFloat64(t, cost, wantDistance,
+						"%s/%d %s->%s witness cost: got=%v want=%v", mode, seed, sourceID, targetID, cost, wantDistance)
+				}
+			}
+		}
+	}
+}
+
+// TestBidirectional_TieHeavyWitnessEqualsShortestPathTo pins the witness on
+// grids where almost every pair has many equal-cost paths.
+//
+// Implementation:
+//   - Stage 1: Build 6x6 grids with 0/1 weights; "directed" points every edge
+//     right or down, "mixed" directs a random half of them.
+//   - Stage 2: For every ordered pair, the bidirectional witness must equal the
+//     ShortestPathTo witness, not merely cost the same.
+//
+// Behavior highlights:
+//   - Zero-weight runs create equal-distance groups whose settle order depends
+//     on vertex IDs and on which group member becomes reachable first.
+func TestBidirectional_TieHeavyWitnessEqualsShortestPathTo(t *testing.T) {
+	const side = 6
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 3; seed++ {
+			graph, err := core.NewGraph(core.WithWeighted(), core.WithDirected(mode == "directed"), core.WithMixedEdges())
+			if err != nil {
+				t.Fatalf("NewGraph failed: %v", err)
+			}
+			rng := rand.New(rand.NewSource(seed))
+			cell := func(row, col int) string { return fmt.Sprintf("r%dc%d", row, col) }
+			for row := 0; row < side; row++ {
+				for col := 0; col < side; col++ {
+					for _, next := range [][2]int{{row, col + 1}, {row + 1, col}} {
+						if next[0] == side || next[1] == side {
+							continue
+						}
+						directed := mode == "directed" || (mode == "mixed" && rng.Intn(2) == 0)
+						mustAddEdge(t, graph, cell(row, col), cell(next[0], next[1]), float64(rng.Intn(2)), core.WithEdgeDirected(directed))
+					}
+				}
+			}
+
+			engine, err := dijkstra.NewBidirectional(graph)
+			if err != nil {
+				t.Fatalf("%s/%d: NewBidirectional failed: %v", mode, seed, err)
+			}
+			for _, sourceID := range graph.Vertices() {
+				for _, targetID := range graph.Vertices() {
+					wantPath, _, wantErr := dijkstra.ShortestPathTo(graph, sourceID, targetID)
+					gotPath, _, gotErr := engine.ShortestPathTo(sourceID, targetID)
+					if wantErr != nil {
+						mustErrorIs(t, gotErr, dijkstra.ErrNoPath)
+						continue
+					}
+					mustEqualString(t, fmt.Sprint(gotPath), fmt.Sprint(wantPath),
+						"%s/%d %s->%s: witness %v, ShortestPathTo %v", mode, seed, sourceID, targetID, gotPath, wantPath)
+				}
+			}
+		}
+	}
+}
+
+// TestBidirectional_DirectedBackwardWalk verifies that the backward search honors
+// edge direction on a mixed graph.
+//
+// Implementation:
+//   - Stage 1: Reuse the mixed fixture from TestDijkstra_MixedGraph.
+//   - Stage 2: Assert the exact witness for A->D and ErrNoPath for D->A.
+//
+// AI-Hints:
+//   - A backward search over outgoing relations would wrongly find D->A here.
+func TestBidirectional_DirectedBackwardWalk(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithMixedEdges())
+	mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightTwo, core.WithEdgeDirected(true))
+	mustAddEdge(t, graph, testVertexMiddle, testVertexAlternative, testWeightThree, core.WithEdgeDirected(false))
+	mustAddEdge(t, graph, testVertexAlternative, testVertexTarget, testWeightOne, core.WithEdgeDirected(true))
+	mustAddEdge(t, graph, testVertexSource, testVertexTarget, testWeightTen, core.WithEdgeDirected(true))
+
+	path, distance, err := dijkstra.BidirectionalShortestPathTo(graph, testVertexSource, testVertexTarget)
+	if err != nil {
+		t.Fatalf("BidirectionalShortestPathTo failed: %v", err)
+	}
+	mustEqualFloat64(t, distance, testWeightSix, "distance: got=%v want=%v", distance, testWeightSix)
+	assertPathEqual(t, path, []string{testVertexSource, testVertexMiddle, testVertexAlternative, testVertexTarget})
+
+	_, _, err = dijkstra.BidirectionalShortestPathTo(graph, testVertexTarget, testVertexSource)
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+}
+
+// TestBidirectional_PolicyAndValidation verifies option reuse and input sentinels.
+//
+// Implementation:
+//   - Stage 1: Assert wall and inclusive cutoff behavior.
+//   - Stage 2: Assert DistanceTo publishes +Inf for unreachable pairs.
+//   - Stage 3: Assert input sentinels for empty and unknown endpoints.
+//
+// AI-Hints:
+//   - DistanceTo and ShortestPathTo intentionally differ on unreachable targets.
+func TestBidirectional_PolicyAndValidation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	mustAddEdge(t, graph, testVertexSource, testVertexTarget, testWeightTen)
+	mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightFive)
+	mustAddEdge(t, graph, testVertexMiddle, testVertexTarget, testWeightSix)
+	if err := graph.AddVertex(testVertexUnreachable); err != nil {
+		t.Fatalf("AddVertex failed: %v", err)
+	}
+
+	engine, err := dijkstra.NewBidirectional(graph, dijkstra.WithInfEdgeThreshold(testWeightTen), dijkstra.WithMaxDistance(11))
+	if err != nil {
+		t.Fatalf("NewBidirectional failed: %v", err)
+	}
+
+	path, distance, err := engine.ShortestPathTo(testVertexSource, testVertexTarget)
+	if err != nil {
+		t.Fatalf("ShortestPathTo failed: %v", err)
+	}
+	mustEqualFloat64(t, distance, 11, "distance: got=%v want=11", distance)
+	assertPathEqual(t, path, []string{testVertexSource, testVertexMiddle, testVertexTarget})
+
+	tight, err := dijkstra.NewBidirectional(graph, dijkstra.WithInfEdgeThreshold(testWeightTen), dijkstra.WithMaxDistance(testWeightTen))
+	if err != nil {
+		t.Fatalf("NewBidirectional(tight) failed: %v", err)
+	}
+	_, _, err = tight.ShortestPathTo(testVertexSource, testVertexTarget)
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+
+	unreachable, err := engine.DistanceTo(testVertexSource, testVertexUnreachable)
+	if err != nil {
+		t.Fatalf("DistanceTo(unreachable) failed: %v", err)
+	}
+	assertInfDistance(t, unreachable)
+
+	self, err := engine.DistanceTo(testVertexMiddle, testVertexMiddle)
+	if err != nil {
+		t.Fatalf("DistanceTo(self) failed: %v", err)
+	}
+	mustEqualFloat64(t, self, 0, "DistanceTo(self): got=%v want=0", self)
+
+	_, _, err = engine.ShortestPathTo("", testVertexTarget)
+	mustErrorIs(t, err, dijkstra.ErrEmptySourceID)
+	_, _, err = engine.ShortestPathTo(testVertexSource, "")
+	mustErrorIs(t, err, dijkstra.ErrEmptyTargetID)
+	_, _, err = engine.ShortestPathTo(testVertexMissing, testVertexTarget)
+	mustErrorIs(t, err, dijkstra.ErrSourceNotFound)
+	_, _, err = engine.ShortestPathTo(testVertexSource, testVertexMissing)
+	mustErrorIs(t, err, dijkstra.ErrTargetNotFound)
+
+	_, err = dijkstra.NewBidirectional(nil)
+	mustErrorIs(t, err, dijkstra.ErrNilGraph)
+
+	unweighted, _ := core.NewGraph()
+	_, err = dijkstra.NewBidirectional(unweighted)
+	mustErrorIs(t, err, dijkstra.ErrUnweightedGraph)
+
+	var nilEngine *dijkstra.Bidirectional
+	_, _, err = nilEngine.ShortestPathTo(testVertexSource, testVertexTarget)
+	mustErrorIs(t, err, dijkstra.ErrNilResult)
+}
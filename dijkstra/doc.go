@@ -24,11 +24,44 @@
 //     Convenience wrapper that runs Dijkstra with path tracking enabled and
 //     returns one deterministic shortest-path witness plus its distance.
 //
+//   - AStar(g, sourceID, targetID, heuristic, opts...)
+//     Target-directed A* search over the same kernel, guided by a caller
+//     Heuristic; stops once the target is finalized.
+//
+//   - NewBidirectional(g, opts...) / BidirectionalShortestPathTo(...)
+//     Point-to-point bidirectional Dijkstra. The engine freezes outgoing and
+//     incoming relation snapshots once and answers many source-target queries
+//     with the same distances and witnesses as ShortestPathTo.
+//
+//   - KShortestPaths(g, sourceID, targetID, k, opts...) / KShortestPathsContext(ctx, ...)
+//     Yen's algorithm: up to k shortest loopless paths as Path values carrying
+//     vertex IDs, edge IDs (so parallel edges stay distinguishable), and cost.
+//
+//   - MultiSource(g, sourceIDs, opts...) / MultiSourceWithOffsets(g, offsets, opts...)
+//     One run seeded at every source (optionally with per-source head starts);
+//     MultiSourceResult gives each vertex its distance, nearest source, and
+//     predecessor, i.e. the graph Voronoi partition of the sources.
+//
+//   - DistanceTable(g, rowIDs, colIDs, opts...) / DistanceTableContext(ctx, ...)
+//     Many-to-many origin x destination table computed row-parallel into a
+//     matrix.Dense; a square table plugs straight into tsp.SolveMatrix.
+//
+//   - Minimax(g, sourceID, opts...) / Widest(g, sourceID, opts...)
+//     Bottleneck path algebras on the same kernel: Minimax minimizes the heaviest
+//     edge and returns a Result; Widest maximizes the narrowest edge and returns a
+//     WidestResult (+Inf at the source, -Inf for unreachable vertices).
+//
+//   - NewDynamic(g, result, opts...) -> Dynamic.Apply(changes...)
+//     Incremental repair of a path-tracking result after edge insertions,
+//     deletions, and weight updates; distances always equal a full recomputation.
+//
 // Result is the public result artifact. It exposes:
 //
 //   - SourceID  - the source vertex identifier used for the run.
 //   - Distances - finalized shortest-path distances for the known result domain.
 //   - Prev      - optional predecessor map used for path reconstruction.
+//   - PrevEdge  - optional predecessor edge IDs recorded with Prev; EdgePathTo
+//     returns the witness as edge IDs plus cumulative per-hop distances.
 //
 // The package is designed as a reusable weighted shortest-path kernel for
 // downstream algorithms that require deterministic behavior, explicit numeric
@@ -118,6 +151,9 @@
 //     - math.Inf(-1)
 //     - every finite w < 0
 //
+//     Costs returned by a WithEdgeCost callback obey the same law; they are
+//     classified at relaxation time with the same sentinels.
+//
 //  2. Runtime-policy domain
 //
 //     Positive infinity is valid for:
@@ -279,11 +315,26 @@
 //   - WithInfEdgeThreshold(threshold)
 //     Treats edges with weight >= threshold as impassable walls.
 //
+//   - WithHeuristicCheck()
+//     AStar debug mode: verifies h(target) == 0, per-edge consistency, and
+//     admissibility along the returned witness.
+//
+//   - WithWorkers(n)
+//     Goroutine count for DistanceTable rows; results do not depend on it.
+//
+//   - WithEdgeCost(cost)
+//     Prices each edge with cost(edge) instead of Edge.Weight; ok == false skips it.
+//
+//   - WithEdgeFilter(keep) / WithVertexFilter(keep)
+//     Admission predicates evaluated during relaxation; the graph is not copied.
+//
 // Baseline default policy:
 //
 //   - TrackPaths       = false
 //   - MaxDistance      = +Inf
 //   - InfEdgeThreshold = +Inf
+//   - CheckHeuristic   = false
+//   - Workers          = 0 (runtime.GOMAXPROCS(0))
 //
 // Important separation:
 //
@@ -335,6 +386,11 @@
 //   - ErrNoPath
 //   - ErrEmptyTargetID
 //   - ErrNilResult
+//   - ErrNilHeuristic
+//   - ErrInvalidHeuristic
+//   - ErrInadmissibleHeuristic
+//   - ErrBadK
+//   - ErrNilContext
 //
 // Wrapping law:
 //
@@ -414,6 +470,32 @@
 //     Effective time O(V log V + E log V + graph-surface-enumeration cost).
 //     Effective space O(V + E_heap), excluding graph-owned storage.
 //
+//   - AStar
+//     Worst case equals Dijkstra; with an informative consistent heuristic only
+//     vertices whose key g+h stays below the target distance are settled.
+//
+//   - NewBidirectional
+//     Time O(V log V + E log E), Space O(V + E) for relation snapshots.
+//
+//   - Bidirectional.ShortestPathTo / DistanceTo
+//     Worst case equals Dijkstra; typically two small balls around the endpoints.
+//
+//   - KShortestPaths
+//     O(k * L) target-stopped spur searches, where L bounds the path length.
+//
+//   - MultiSource / MultiSourceWithOffsets
+//     One Dijkstra pass, O((V + E) log V), regardless of the number of sources.
+//
+//   - DistanceTable
+//     R target-stopped rows, O(R * (V + E) log V) work spread over Workers.
+//
+//   - Minimax / Widest
+//     Same as Dijkstra; the extension law max(current, w) never overflows.
+//
+//   - Dynamic.Apply
+//     O(V) child-index rebuild when a tree edge is invalidated, plus
+//     O((A + E_A) log V) for the A vertices whose distance actually changes.
+//
 // Result-surface summary:
 //
 //   - DistanceTo / HasPathTo
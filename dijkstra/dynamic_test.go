@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// AI-HINTS (file):
+//   - The oracle is a fresh Dijkstra run on the mutated graph; integer weights
+//     keep the distance comparison exact.
+//   - Predecessors may differ from the oracle under ties, so each witness edge is
+//     checked for existence, orientation, and tightness instead.
+
+// mutateRandomly applies one random batch to graph and returns its description.
+func mutateRandomly(t *testing.T, graph *core.Graph, rng *rand.Rand, serial *int) []dijkstra.EdgeChange {
+	t.Helper()
+
+	vertices := graph.Vertices()
+	var changes []dijkstra.EdgeChange
+	touched := make(map[string]bool)
+	for step := 0; step < 1+rng.Intn(4); step++ {
+		edges := graph.Edges()
+		switch kind := rng.Intn(3); {
+		case kind == 0 || len(edges) == 0:
+			*serial++
+			edgeID := fmt.Sprintf("x%03d", *serial)
+			from, to := vertices[rng.Intn(len(vertices))], vertices[rng.Intn(len(vertices))]
+			if rng.Intn(6) == 0 {
+				to = fmt.Sprintf("n%03d", *serial)
+			}
+			mustAddEdge(t, graph, from, to, float64(rng.Intn(10)),
+				core.WithID(edgeID), core.WithEdgeDirected(rng.Intn(2) == 0))
+			changes = append(changes, dijkstra.EdgeChange{Op: dijkstra.ChangeInsert, EdgeID: edgeID})
+			touched[edgeID] = true
+		default:
+			edge := *edges[rng.Intn(len(edges))]
+			if touched[edge.ID] {
+				continue
+			}
+			touched[edge.ID] = true
+			if err := graph.RemoveEdge(edge.ID); err != nil {
+				t.Fatalf("RemoveEdge failed: %v", err)
+			}
+			if kind == 1 {
+				changes = append(changes, dijkstra.EdgeChange{Op: dijkstra.ChangeDelete, EdgeID: edge.ID})
+				continue
+			}
+			mustAddEdge(t, graph, edge.From, edge.To, float64(rng.Intn(10)),
+				core.WithID(edge.ID), core.WithEdgeDirected(edge.Directed))
+			changes = append(changes, dijkstra.EdgeChange{Op: dijkstra.ChangeUpdate, EdgeID: edge.ID})
+		}
+	}
+
+	return changes
+}
+
+// assertTreeMatches compares dynamic state with a fresh run and validates witnesses.
+func assertTreeMatches(t *testing.T, graph *core.Graph, got *dijkstra.Result, opts []dijkstra.Option, label string) {
+	t.Helper()
+
+	want, err := dijkstra.Dijkstra(graph, got.SourceID, opts...)
+	if err != nil {
+		t.Fatalf("%s: Dijkstra failed: %v", label, err)
+	}
+	mustEqualInt(t, len(got.Distances), len(want.Distances), "%s: domain size", label)
+
+	for vertexID, distance := range want.Distances {
+		mustEqualFloat64(t, got.Distances[vertexID], distance,
+			"%s: distance %q got=%v want=%v", label, vertexID, got.Distances[vertexID], distance)
+		if vertexID == got.SourceID || math.IsInf(distance, 1) {
+			mustEqualString(t, got.Prev[vertexID], "", "%s: prev of %q", label, vertexID)
+			continue
+		}
+
+		edge, err := graph.GetEdge(got.PrevEdge[vertexID])
+		if err != nil {
+			t.Fatalf("%s: witness edge of %q: %v", label, vertexID, err)
+		}
+		parentID := got.Prev[vertexID]
+		forward := edge.From == parentID && edge.To == vertexID
+		backward := !edge.Directed && edge.To == parentID && edge.From == vertexID
+		mustEqualBool(t, forward || backward, true, "%s: edge %q does not lead %q->%q", label, edge.ID, parentID, vertexID)
+		mustEqualFloat64(t, got.Distances[parentID]+edge.Weight, distance,
+			"%s: witness of %q is not tight", label, vertexID)
+	}
+}
+
+// TestDynamic_MatchesRecomputation verifies incremental repair against a full
+// recomputation across long random mutation sequences.
+//
+// Implementation:
+//   - Stage 1: Build random directed, undirected, and mixed multigraphs.
+//   - Stage 2: Apply random insert / delete / update batches, sometimes growing
+//     the vertex set through inserted edges.
+//   - Stage 3: After every batch compare with Dijkstra, with and without a cutoff
+//     and an infinite-edge threshold.
+func TestDynamic_MatchesRecomputation(t *testing.T) {
+	optionSets := map[string][]dijkstra.Option{
+		"plain":  {dijkstra.WithPathTracking()},
+		"capped": {dijkstra.WithPathTracking(), dijkstra.WithMaxDistance(9), dijkstra.WithInfEdgeThreshold(8)},
+	}
+
+	for name, opts := range optionSets {
+		for _, mode := range []string{"directed", "undirected", "mixed"} {
+			for seed := int64(1); seed <= 3; seed++ {
+				graph := buildRandomMixedGraph(t, seed, 12, 30, mode)
+				sourceID := graph.Vertices()[0]
+				initial, err := dijkstra.Dijkstra(graph, sourceID, opts...)
+				if err != nil {
+					t.Fatalf("Dijkstra failed: %v", err)
+				}
+				dynamic, err := dijkstra.NewDynamic(graph, initial, opts...)
+				if err != nil {
+					t.Fatalf("NewDynamic failed: %v", err)
+				}
+
+				rng := rand.New(rand.NewSource(seed * 97))
+				serial := 0
+				for round := 0; round < 60; round++ {
+					changes := mutateRandomly(t, graph, rng, &serial)
+					if err = dynamic.Apply(changes...); err != nil {
+						t.Fatalf("%s/%s/%d round %d: Apply failed: %v", name, mode, seed, round, err)
+					}
+					label := fmt.Sprintf("%s/%s/%d round %d", name, mode, seed, round)
+					assertTreeMatches(t, graph, dynamic.Result(), opts, label)
+				}
+			}
+		}
+	}
+}
+
+// TestDynamic_Validation verifies the construction and batch sentinels and the
+// atomicity of a rejected batch.
+//
+// Implementation:
+//   - Stage 1: S->A (1), A->T (1), S->T (5) with T reached through A.
+//   - Stage 2: Reject malformed batches and check the state is unchanged.
+//   - Stage 3: Delete the tree edge A->T and observe the fallback distance.
+func TestDynamic_Validation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	mustAddEdge(t, graph, "S", "A", 1, core.WithID("sa"))
+	mustAddEdge(t, graph, "A", "T", 1, core.WithID("at"))
+	mustAddEdge(t, graph, "S", "T", 5, core.WithID("st"))
+
+	tracked, _ := dijkstra.Dijkstra(graph, "S", dijkstra.WithPathTracking())
+	plain, _ := dijkstra.Dijkstra(graph, "S")
+	dynamic, err := dijkstra.NewDynamic(graph, tracked)
+	if err != nil {
+		t.Fatalf("NewDynamic failed: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{"nil graph", dijkstra.ErrNilGraph, func() error { _, e := dijkstra.NewDynamic(nil, tracked); return e }},
+		{"nil result", dijkstra.ErrNilResult, func() error { _, e := dijkstra.NewDynamic(graph, nil); return e }},
+		{"untracked", dijkstra.ErrPathTrackingDisabled, func() error { _, e := dijkstra.NewDynamic(graph, plain); return e }},
+		{"nil option", dijkstra.ErrNilOption, func() error { _, e := dijkstra.NewDynamic(graph, tracked, nil); return e }},
+		{"nil dynamic", dijkstra.ErrNilResult, func() error { var d *dijkstra.Dynamic; return d.Apply() }},
+		{"unknown op", dijkstra.ErrBadEdgeChange, func() error { return dynamic.Apply(dijkstra.EdgeChange{Op: 9, EdgeID: "at"}) }},
+		{"empty id", dijkstra.ErrBadEdgeChange, func() error { return dynamic.Apply(dijkstra.EdgeChange{Op: dijkstra.ChangeUpdate}) }},
+		{"still present", dijkstra.ErrBadEdgeChange, func() error {
+			return dynamic.Apply(dijkstra.EdgeChange{Op: dijkstra.ChangeDelete, EdgeID: "at"})
+		}},
+		{"repeated", dijkstra.ErrBadEdgeChange, func() error {
+			return dynamic.Apply(
+				dijkstra.EdgeChange{Op: dijkstra.ChangeUpdate, EdgeID: "at"},
+				dijkstra.EdgeChange{Op: dijkstra.ChangeUpdate, EdgeID: "at"},
+			)
+		}},
+		{"unknown insert", dijkstra.ErrEdgeNotFound, func() error {
+			return dynamic.Apply(dijkstra.EdgeChange{Op: dijkstra.ChangeInsert, EdgeID: "zz"})
+		}},
+		{"unknown delete", dijkstra.ErrEdgeNotFound, func() error {
+			return dynamic.Apply(dijkstra.EdgeChange{Op: dijkstra.ChangeDelete, EdgeID: "zz"})
+		}},
+		{"vertex set", dijkstra.ErrVertexSetChanged, func() error {
+			if err := graph.AddVertex("lonely"); err != nil {
+				return err
+			}
+			defer func() { _ = graph.RemoveVertex("lonely") }()
+			return dynamic.Apply()
+		}},
+	}
+	for _, tc := range cases {
+		mustErrorIs(t, tc.run(), tc.err)
+	}
+
+	distance, err := dynamic.DistanceTo("T")
+	if err != nil {
+		t.Fatalf("DistanceTo failed: %v", err)
+	}
+	mustEqualFloat64(t, distance, 2, "rejected batches must not modify state: got=%v", distance)
+
+	if err = graph.RemoveEdge("at"); err != nil {
+		t.Fatalf("RemoveEdge failed: %v", err)
+	}
+	if err = dynamic.Apply(dijkstra.EdgeChange{Op: dijkstra.ChangeDelete, EdgeID: "at"}); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	path, err := dynamic.Result().PathTo("T")
+	if err != nil {
+		t.Fatalf("PathTo failed: %v", err)
+	}
+	assertPathEqual(t, path, []string{"S", "T"})
+	assertTreeMatches(t, graph, dynamic.Result(), []dijkstra.Option{dijkstra.WithPathTracking()}, "after delete")
+}
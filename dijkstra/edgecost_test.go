@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// AI-HINTS (file):
+//   - WithEdgeCost, WithEdgeFilter, and WithVertexFilter are per-call policies;
+//     the graph is never mutated.
+//   - Every kernel that relaxes edges must honour them, so one fixture is run
+//     through Dijkstra, ShortestPathTo, KShortestPaths, and Bidirectional.
+
+// TestDijkstra_EdgeCostAndFilters verifies callback pricing, edge and vertex
+// filtering, and numeric validation of callback results.
+//
+// Implementation:
+//   - Stage 1: Build S->A->T (1+1) and S->B->T (2+2) plus a direct S->T (10).
+//   - Stage 2: A penalty on edges into A reroutes through B.
+//   - Stage 3: Filtering the A->T edge or vertex A has the same effect.
+//   - Stage 4: Negative and NaN callback costs fail at relaxation time.
+//
+// Behavior highlights:
+//   - Callback costs drive distances, EdgePathTo cumulative values, and Yen costs.
+//   - Returning ok == false excludes an edge like a filter.
+//
+// AI-Hints:
+//   - The graph is never mutated; every variation is a per-call policy.
+func TestDijkstra_EdgeCostAndFilters(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	intoA := mustAddEdge(t, graph, "S", "A", 1)
+	mustAddEdge(t, graph, "A", "T", 1)
+	mustAddEdge(t, graph, "S", "B", 2)
+	mustAddEdge(t, graph, "B", "T", 2)
+	direct := mustAddEdge(t, graph, "S", "T", 10)
+
+	penalty := dijkstra.WithEdgeCost(func(edge core.Edge) (float64, bool) {
+		if edge.To == "A" {
+			return edge.Weight + 100, true
+		}
+		return edge.Weight, true
+	})
+	path, distance, err := dijkstra.ShortestPathTo(graph, "S", "T", penalty)
+	if err != nil {
+		t.Fatalf("ShortestPathTo(penalty) failed: %v", err)
+	}
+	assertPathEqual(t, path, []string{"S", "B", "T"})
+	mustEqualFloat64(t, distance, 4, "penalty distance: got=%v want=4", distance)
+
+	result, err := dijkstra.Dijkstra(graph, "S", dijkstra.WithPathTracking(), penalty)
+	if err != nil {
+		t.Fatalf("Dijkstra(penalty) failed: %v", err)
+	}
+	_, cumulative, err := result.EdgePathTo("A")
+	if err != nil {
+		t.Fatalf("EdgePathTo(A) failed: %v", err)
+	}
+	mustEqualFloat64(t, cumulative[0], 101, "priced hop into A: got=%v want=101", cumulative[0])
+
+	paths, err := dijkstra.KShortestPaths(graph, "S", "T", 3, penalty)
+	if err != nil {
+		t.Fatalf("KShortestPaths(penalty) failed: %v", err)
+	}
+	mustEqualFloat64(t, paths[2].Cost, 102, "third path cost: got=%v want=102", paths[2].Cost)
+
+	dropEdge := dijkstra.WithEdgeFilter(func(edge core.Edge) bool { return edge.ID != intoA })
+	dropVertex := dijkstra.WithVertexFilter(func(vertexID string) bool { return vertexID != "A" })
+	dropByCost := dijkstra.WithEdgeCost(func(edge core.Edge) (float64, bool) { return edge.Weight, edge.ID != intoA })
+	for _, option := range []dijkstra.Option{dropEdge, dropVertex, dropByCost} {
+		distances, err := dijkstra.Distances(graph, "S", option)
+		if err != nil {
+			t.Fatalf("Distances(filtered) failed: %v", err)
+		}
+		assertInfDistance(t, distances["A"])
+		mustEqualFloat64(t, distances["T"], 4, "filtered distance: got=%v want=4", distances["T"])
+
+		engine, err := dijkstra.NewBidirectional(graph, option)
+		if err != nil {
+			t.Fatalf("NewBidirectional(filtered) failed: %v", err)
+		}
+		_, distance, err = engine.ShortestPathTo("S", "T")
+		if err != nil {
+			t.Fatalf("Bidirectional(filtered) failed: %v", err)
+		}
+		mustEqualFloat64(t, distance, 4, "bidirectional filtered distance: got=%v want=4", distance)
+	}
+
+	_, _, err = dijkstra.ShortestPathTo(graph, "S", "A", dropVertex)
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+	engine, _ := dijkstra.NewBidirectional(graph, dropVertex)
+	_, _, err = engine.ShortestPathTo("S", "A")
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+
+	negative := dijkstra.WithEdgeCost(func(edge core.Edge) (float64, bool) {
+		if edge.ID == direct {
+			return -1, true
+		}
+		return edge.Weight, true
+	})
+	_, err = dijkstra.Distances(graph, "S", negative)
+	mustErrorIs(t, err, dijkstra.ErrNegativeWeight)
+
+	invalid := dijkstra.WithEdgeCost(func(core.Edge) (float64, bool) { return math.NaN(), true })
+	_, err = dijkstra.Distances(graph, "S", invalid)
+	mustErrorIs(t, err, dijkstra.ErrInvalidWeight)
+}
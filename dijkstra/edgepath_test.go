@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// AI-HINTS (file):
+//   - PrevEdge follows the lifecycle of Prev: nil without WithPathTracking,
+//     populated with it.
+//   - Parallel edges are told apart only by edge IDs; assert IDs, not endpoints.
+
+// TestDijkstra_PrevEdgeFollowsPathTracking verifies that predecessor edge IDs
+// are recorded exactly when path tracking is enabled.
+//
+// Implementation:
+//   - Stage 1: Build S->M->A.
+//   - Stage 2: Without tracking, PrevEdge is nil and EdgePathTo fails.
+//   - Stage 3: With tracking, PrevEdge names the last edge into each vertex.
+//
+// AI-Hints:
+//   - Keep this aligned with TestDijkstra_PathTrackingEnabled for Prev.
+func TestDijkstra_PrevEdgeFollowsPathTracking(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	firstID := mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightOne)
+	secondID := mustAddEdge(t, graph, testVertexMiddle, testVertexAlternative, testWeightOne)
+
+	untracked, err := dijkstra.Dijkstra(graph, testVertexSource)
+	if err != nil {
+		t.Fatalf("Dijkstra(%q) failed: %v", testVertexSource, err)
+	}
+	mustNilState(t, untracked.PrevEdge, true, "PrevEdge when path tracking is disabled")
+	_, _, err = untracked.EdgePathTo(testVertexAlternative)
+	mustErrorIs(t, err, dijkstra.ErrPathTrackingDisabled)
+
+	tracked, err := dijkstra.Dijkstra(graph, testVertexSource, dijkstra.WithPathTracking())
+	if err != nil {
+		t.Fatalf("Dijkstra(%q) failed: %v", testVertexSource, err)
+	}
+	mustNilState(t, tracked.PrevEdge, false, "PrevEdge when path tracking enabled")
+	mustEqualString(t, tracked.PrevEdge[testVertexMiddle], firstID, "PrevEdge[M]: got=%q want=%q", tracked.PrevEdge[testVertexMiddle], firstID)
+	mustEqualString(t, tracked.PrevEdge[testVertexAlternative], secondID, "PrevEdge[A]: got=%q want=%q", tracked.PrevEdge[testVertexAlternative], secondID)
+}
+
+// TestDijkstra_EdgePathToNamesLighterParallelEdge verifies that EdgePathTo
+// reports the parallel edge that actually carried the shortest path.
+//
+// Implementation:
+//   - Stage 1: Build S=>M with weights 5 and 2, then M->A (1) and S->A (10).
+//   - Stage 2: Run Dijkstra with path tracking.
+//   - Stage 3: Assert edge IDs and cumulative distances along the witness.
+//
+// AI-Hints:
+//   - The vertex witness cannot distinguish the two S=>M edges; only EdgePathTo can.
+func TestDijkstra_EdgePathToNamesLighterParallelEdge(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithMultiEdges())
+	mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightFive)
+	lightEdgeID := mustAddEdge(t, graph, testVertexSource, testVertexMiddle, testWeightTwo)
+	tailEdgeID := mustAddEdge(t, graph, testVertexMiddle, testVertexAlternative, testWeightOne)
+	mustAddEdge(t, graph, testVertexSource, testVertexAlternative, testWeightTen)
+
+	result, err := dijkstra.Dijkstra(graph, testVertexSource, dijkstra.WithPathTracking())
+	if err != nil {
+		t.Fatalf("Dijkstra(%q) failed: %v", testVertexSource, err)
+	}
+
+	edgeIDs, cumulative, err := result.EdgePathTo(testVertexAlternative)
+	if err != nil {
+		t.Fatalf("EdgePathTo(%q) failed: %v", testVertexAlternative, err)
+	}
+	assertPathEqual(t, edgeIDs, []string{lightEdgeID, tailEdgeID})
+	mustEqualInt(t, len(cumulative), 2, "EdgePathTo cumulative length")
+	mustEqualFloat64(t, cumulative[0], testWeightTwo, "cumulative[0]: got=%v want=%v", cumulative[0], testWeightTwo)
+	mustEqualFloat64(t, cumulative[1], testWeightThree, "cumulative[1]: got=%v want=%v", cumulative[1], testWeightThree)
+}
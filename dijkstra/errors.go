@@ -161,4 +161,108 @@ var (
 	//   - Result implements core.Nilable, but methods must still remain safe on nil receivers.
 	//   - Do not replace this with a panic or with ErrTargetNotFound.
 	ErrNilResult = errors.New("dijkstra: result is nil")
+
+	// ErrNilHeuristic reports that AStar was called without a heuristic function.
+	// This error originates during input validation before traversal state is allocated.
+	//
+	// AI-Hints:
+	//   - Pass a zero heuristic explicitly when target-stopped Dijkstra is intended.
+	//   - Do not substitute a silent default estimate.
+	ErrNilHeuristic = errors.New("dijkstra: heuristic is nil")
+
+	// ErrInvalidHeuristic reports that the heuristic returned NaN, an infinity,
+	// or a negative estimate for some vertex.
+	// The error originates when AStar evaluates the heuristic during traversal.
+	//
+	// AI-Hints:
+	//   - Preserve this sentinel with %w when attaching vertex context.
+	//   - Keep it distinct from ErrInadmissibleHeuristic: the value is malformed,
+	//     not merely too optimistic.
+	ErrInvalidHeuristic = errors.New("dijkstra: heuristic returned invalid estimate")
+
+	// ErrInadmissibleHeuristic reports that the heuristic violated the consistency
+	// or admissibility contract required by AStar.
+	// The error originates only when WithHeuristicCheck is enabled.
+	//
+	// AI-Hints:
+	//   - Consistency violations are reported on the relaxed edge that exposed them.
+	//   - Do not downgrade this to a warning; an inconsistent heuristic can make
+	//     the visited-finalization kernel publish a non-shortest path.
+	ErrInadmissibleHeuristic = errors.New("dijkstra: heuristic is not admissible")
+
+	// ErrBadK reports that KShortestPaths received k < 1.
+	// The error originates during input validation before any search runs.
+	//
+	// AI-Hints:
+	//   - k is an upper bound; fewer paths are returned when fewer simple paths exist.
+	ErrBadK = errors.New("dijkstra: k must be >= 1")
+
+	// ErrNilContext reports that a context-aware entry point received a nil context.
+	//
+	// AI-Hints:
+	//   - Use context.Background() or the non-Context wrapper instead of nil.
+	ErrNilContext = errors.New("dijkstra: context is nil")
+
+	// ErrNoSources reports that a multi-source run received an empty source set.
+	// The error originates during input validation before any traversal state exists.
+	//
+	// AI-Hints:
+	//   - An empty partition is not a valid result; the caller must name a source.
+	ErrNoSources = errors.New("dijkstra: no source vertices")
+
+	// ErrBadSourceOffset reports that a per-source initial offset is NaN, infinite,
+	// or negative. The error originates during multi-source input validation.
+	//
+	// AI-Hints:
+	//   - Omit a source instead of giving it +Inf; negative offsets would break
+	//     the visited-finalization invariant just like negative weights.
+	ErrBadSourceOffset = errors.New("dijkstra: source offset must be finite and >= 0")
+
+	// ErrNoTargets reports that DistanceTable received an empty destination set.
+	//
+	// AI-Hints:
+	//   - Empty origins are reported with ErrNoSources.
+	ErrNoTargets = errors.New("dijkstra: no target vertices")
+
+	// ErrBadWorkers reports a worker count below 1 in WithWorkers, or a negative
+	// Workers field in a caller-constructed Options value.
+	//
+	// AI-Hints:
+	//   - Omit WithWorkers to use runtime.GOMAXPROCS(0).
+	ErrBadWorkers = errors.New("dijkstra: workers must be >= 1")
+
+	// ErrNilEdgeCost reports that WithEdgeCost received a nil callback.
+	//
+	// AI-Hints:
+	//   - Omit the option to price edges by Edge.Weight.
+	ErrNilEdgeCost = errors.New("dijkstra: edge cost function is nil")
+
+	// ErrNilFilter reports that WithEdgeFilter or WithVertexFilter received a nil predicate.
+	//
+	// AI-Hints:
+	//   - Omit the option to admit every edge or vertex.
+	ErrNilFilter = errors.New("dijkstra: filter is nil")
+
+	// ErrBadEdgeChange reports a malformed Dynamic.Apply batch: an unknown
+	// ChangeOp, an empty edge ID, an edge listed twice, or a deletion of an
+	// edge that is still present in the graph.
+	//
+	// AI-Hints:
+	//   - Mutate the graph first, then describe the mutation to Apply.
+	ErrBadEdgeChange = errors.New("dijkstra: invalid edge change")
+
+	// ErrEdgeNotFound reports that a Dynamic.Apply change references an edge ID
+	// that is absent from the graph (insert, update) or from the repaired tree
+	// snapshot (delete, update).
+	//
+	// AI-Hints:
+	//   - Report a weight change made by remove-and-re-add under the same ID as ChangeUpdate.
+	ErrEdgeNotFound = errors.New("dijkstra: edge not found")
+
+	// ErrVertexSetChanged reports that the graph gained or lost vertices other
+	// than endpoints of inserted edges since the Dynamic state was built.
+	//
+	// AI-Hints:
+	//   - Rebuild with Dijkstra and NewDynamic after vertex removals.
+	ErrVertexSetChanged = errors.New("dijkstra: vertex set changed")
 )
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// pathAlgebra is the kernel hook that extends a path value by one edge.
+// Every law is monotone (extending a path never improves its value), which is
+// exactly what visited-finalization needs, so one kernel serves all of them.
+//
+// Behavior highlights:
+//   - algebraSum: value = current + w; the classic shortest-path law.
+//   - algebraMinimax: value = max(current, w); minimize the heaviest edge.
+//   - algebraWidest: value = max(current, -w); maximizing min(w) is run as
+//     minimizing max(-w), so the heap, tie-breaks, and +Inf-unreached
+//     convention stay those of the shortest-path kernel.
+//
+// AI-Hints:
+//   - Every runner sets its algebra at construction; there is no nil default.
+//   - Do not add non-monotone laws (for example, products of weights below 1):
+//     visited-finalization is unsound for them.
+type pathAlgebra interface {
+	// origin returns the internal value of the empty path at a seeded vertex.
+	origin() float64
+
+	// extend returns the value of current extended by edge at weight w.
+	// ok is false when the candidate is out of the maxDistance policy.
+	extend(edge *core.Edge, current, w, maxDistance float64) (value float64, ok bool, err error)
+}
+
+var (
+	algebraSum     pathAlgebra = sumAlgebra{}
+	algebraMinimax pathAlgebra = minimaxAlgebra{}
+	algebraWidest  pathAlgebra = widestAlgebra{}
+)
+
+// sumAlgebra is the additive shortest-path law.
+type sumAlgebra struct{}
+
+func (sumAlgebra) origin() float64 { return 0 }
+
+// extend adds w to current. A finite maxDistance is applied before the
+// addition, so an out-of-policy candidate cannot overflow even though it
+// would be discarded anyway.
+//
+// Errors:
+//   - ErrDistanceOverflow, wrapped with edge context, if current + w is not finite.
+func (sumAlgebra) extend(edge *core.Edge, current, w, maxDistance float64) (float64, bool, error) {
+	if !math.IsInf(maxDistance, 1) && w > maxDistance-current {
+		return 0, false, nil
+	}
+
+	value := current + w
+	if math.IsInf(value, 1) {
+		return 0, false, fmt.Errorf(
+			"%w: edge_id=%q from=%q to=%q current_distance=%g weight=%g",
+			ErrDistanceOverflow,
+			edge.ID,
+			edge.From,
+			edge.To,
+			current,
+			w,
+		)
+	}
+
+	return value, true, nil
+}
+
+// minimaxAlgebra minimizes the heaviest edge of a path.
+type minimaxAlgebra struct{}
+
+func (minimaxAlgebra) origin() float64 { return 0 }
+
+func (minimaxAlgebra) extend(_ *core.Edge, current, w, _ float64) (float64, bool, error) {
+	return math.Max(current, w), true, nil
+}
+
+// widestAlgebra maximizes the lightest edge of a path, stored negated.
+type widestAlgebra struct{}
+
+func (widestAlgebra) origin() float64 { return math.Inf(-1) }
+
+func (widestAlgebra) extend(_ *core.Edge, current, w, _ float64) (float64, bool, error) {
+	return math.Max(current, -w), true, nil
+}
+
+// runWidest executes the widest-path law and converts internal values to widths.
+//
+// Implementation:
+//   - Stage 1: Reject a finite MaxDistance or InfEdgeThreshold; neither has a
+//     widest-path meaning, and a threshold would wall off the widest edges.
+//   - Stage 2: Run the shared kernel under algebraWidest.
+//   - Stage 3: Publish width = -internal value (+Inf at the source, -Inf unreached).
+//
+// Errors:
+//   - ErrBadMaxDistance if MaxDistance is finite.
+//   - ErrBadInfEdgeThreshold if InfEdgeThreshold is finite.
+//   - Any error returned by runDijkstra.
+//
+// Complexity:
+//   - Time O((V + E) log V), Space O(V).
+func runWidest(g *core.Graph, sourceID string, config Options) (*WidestResult, error) {
+	if !math.IsInf(config.MaxDistance, 1) {
+		return nil, fmt.Errorf("%w: widest paths have no distance cutoff", ErrBadMaxDistance)
+	}
+	if !math.IsInf(config.InfEdgeThreshold, 1) {
+		return nil, fmt.Errorf("%w: widest paths would wall off their widest edges", ErrBadInfEdgeThreshold)
+	}
+
+	internal, err := runDijkstra(g, sourceID, config, algebraWidest)
+	if err != nil {
+		return nil, err
+	}
+
+	widths := make(map[string]float64, len(internal.Distances))
+	for vertexID, value := range internal.Distances {
+		widths[vertexID] = -value
+	}
+
+	return &WidestResult{
+		SourceID: sourceID,
+		Widths:   widths,
+		Prev:     internal.Prev,
+		PrevEdge: internal.PrevEdge,
+	}, nil
+}
@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// heuristicTolerance is the relative slack used by WithHeuristicCheck comparisons.
+// It absorbs floating-point rounding in geometric heuristics such as Euclidean
+// distance, where h(u) <= w(u,v) + h(v) holds exactly only in real arithmetic.
+const heuristicTolerance = 1e-9
+
+// runAStar executes the canonical kernel in target-directed A* mode and returns
+// one shortest-path witness together with its distance.
+// The search shares runner, nodePQ, endpoint resolution, and relaxation with
+// runDijkstra; only heap keys and the stop condition differ.
+//
+// Implementation:
+//   - Stage 1: Validate graph, source, target, and heuristic inputs.
+//   - Stage 2: In check mode, require h(target) == 0.
+//   - Stage 3: Seed the source lazily and run the visited-finalization loop with
+//     keys g(v) + h(v), stopping once the target is finalized.
+//   - Stage 4: Reconstruct the witness through the canonical Result.PathTo law.
+//   - Stage 5: In check mode, verify admissibility along the witness.
+//
+// Behavior highlights:
+//   - Edge weights are classified lazily on relaxed edges only, and the vertex
+//     domain is not pre-initialized, so the cost is proportional to the explored
+//     region rather than to the whole graph.
+//   - MaxDistance and InfEdgeThreshold keep their Dijkstra semantics.
+//   - A target unreachable under the effective policy yields ErrNoPath.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - sourceID: the source vertex identifier.
+//   - targetID: the target vertex identifier.
+//   - heuristic: the caller estimate of remaining distance to targetID.
+//   - config: the finalized runtime policy.
+//
+// Returns:
+//   - []string: one deterministic shortest-path witness from source to target.
+//   - float64: the shortest-path distance to the target.
+//
+// Errors:
+//   - Any error returned by validateInputs.
+//   - ErrEmptyTargetID, ErrTargetNotFound, ErrNilHeuristic.
+//   - ErrInvalidHeuristic, ErrInadmissibleHeuristic (check mode), wrapped with context.
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrDistanceOverflow from relaxation.
+//   - ErrNoPath if the target is unreachable under the effective policy.
+//
+// Determinism:
+//   - Deterministic for the same graph, inputs, pure heuristic, and policy.
+//   - Heap ties on (g+h, id) are broken by vertex ID exactly as in Dijkstra.
+//
+// Complexity:
+//   - Worst case equals Dijkstra: O((V + E) log V); typical cost is bounded by
+//     the region whose key g+h stays below the target distance.
+//   - Space O(V) for the shared state maps, excluding graph-owned storage.
+//
+// Notes:
+//   - With an inconsistent heuristic the returned witness may be suboptimal; use
+//     WithHeuristicCheck while developing heuristics.
+//
+// AI-Hints:
+//   - Do not publish runner.distances as a Result; unsettled vertices hold tentative
+//     values, which breaks the finalized-distance contract.
+//   - Do not reopen finalized vertices; consistency is the contract instead.
+func runAStar(
+	g *core.Graph,
+	sourceID, targetID string,
+	heuristic Heuristic,
+	config Options,
+) ([]string, float64, error) {
+	if err := validateInputs(g, sourceID); err != nil {
+		return nil, 0, err
+	}
+	if targetID == "" {
+		return nil, 0, ErrEmptyTargetID
+	}
+	if !g.HasVertex(targetID) {
+		return nil, 0, ErrTargetNotFound
+	}
+	if heuristic == nil {
+		return nil, 0, ErrNilHeuristic
+	}
+
+	keys := &astarKeys{
+		heuristic: heuristic,
+		check:     config.CheckHeuristic,
+		estimates: make(map[string]float64),
+	}
+	runnerState := &runner{
+		graph:     g,
+		sourceID:  sourceID,
+		options:   config,
+		distances: make(map[string]float64),
+		previous:  make(map[string]string),
+		visited:   make(map[string]bool),
+		frontier:  make(nodePQ, 0, 1),
+		heuristic: keys,
+		algebra:   algebraSum,
+	}
+
+	if config.CheckHeuristic {
+		targetEstimate, err := keys.estimate(targetID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if targetEstimate > heuristicTolerance {
+			return nil, 0, fmt.Errorf(
+				"%w: target=%q estimate=%g must be 0",
+				ErrInadmissibleHeuristic,
+				targetID,
+				targetEstimate,
+			)
+		}
+	}
+
+	if err := runnerState.seed(sourceID); err != nil {
+		return nil, 0, err
+	}
+	if err := runnerState.processTo(targetID); err != nil {
+		return nil, 0, err
+	}
+
+	// Reuse the canonical reconstruction law. The predecessor chain of a finalized
+	// target consists of finalized vertices only, so PathTo sees stable state.
+	witness := &Result{
+		SourceID:  sourceID,
+		Distances: runnerState.distances,
+		Prev:      runnerState.previous,
+	}
+	if !runnerState.visited[targetID] {
+		witness.Distances[targetID] = math.Inf(1)
+	}
+
+	path, err := witness.PathTo(targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	distance := witness.Distances[targetID]
+	if config.CheckHeuristic {
+		if err = keys.checkAdmissibility(path, runnerState.distances, distance); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return path, distance, nil
+}
+
+// astarKeys is the A* heuristic hook: heap keys are g(v) + h(v).
+//
+// Behavior highlights:
+//   - estimates caches heuristic values so each vertex is evaluated at most once.
+//   - check enables the WithHeuristicCheck consistency test on every relaxed edge.
+//
+// AI-Hints:
+//   - Do not call heuristic directly outside estimate; the cache is the single source of truth.
+type astarKeys struct {
+	heuristic Heuristic
+	check     bool
+	estimates map[string]float64
+}
+
+// priority returns the heap key for a vertex reached with the given distance.
+//
+// Implementation:
+//   - Stage 1: Add the cached heuristic estimate to distance.
+//   - Stage 2: Reject keys that overflow to +Inf.
+//
+// Inputs:
+//   - vertexID: the vertex whose heap key is being computed.
+//   - distance: the tentative source distance of vertexID.
+//
+// Returns:
+//   - float64: the heap key.
+//   - error: nil on success.
+//
+// Errors:
+//   - Wrapped ErrInvalidHeuristic from estimate.
+//   - Wrapped ErrDistanceOverflow if distance + estimate is not finite.
+//
+// Determinism:
+//   - Deterministic for the same cached estimate.
+//
+// Complexity:
+//   - Time O(1) amortized, Space O(1) amortized.
+//
+// AI-Hints:
+//   - Keep the key in distance units; nodePQ tie-breaking by ID relies on it.
+func (k *astarKeys) priority(vertexID string, distance float64) (float64, error) {
+	estimate, err := k.estimate(vertexID)
+	if err != nil {
+		return 0, err
+	}
+
+	key := distance + estimate
+	if math.IsInf(key, 1) {
+		return 0, fmt.Errorf(
+			"%w: vertex=%q distance=%g estimate=%g",
+			ErrDistanceOverflow,
+			vertexID,
+			distance,
+			estimate,
+		)
+	}
+
+	return key, nil
+}
+
+// estimate evaluates the heuristic for one vertex, validating and caching the value.
+//
+// Implementation:
+//   - Stage 1: Return the cached value when present.
+//   - Stage 2: Evaluate the heuristic and reject NaN, infinities, and negatives.
+//   - Stage 3: Cache and return the accepted value.
+//
+// Behavior highlights:
+//   - Each vertex is evaluated at most once per run.
+//
+// Inputs:
+//   - vertexID: the vertex to estimate.
+//
+// Returns:
+//   - float64: the finite non-negative estimate.
+//   - error: nil on success.
+//
+// Errors:
+//   - ErrInvalidHeuristic wrapped with vertex and value context.
+//
+// Determinism:
+//   - Deterministic for a pure heuristic.
+//
+// Complexity:
+//   - Time O(1) amortized plus one heuristic call on a cache miss.
+//
+// AI-Hints:
+//   - Do not call k.heuristic directly elsewhere; the cache is the single source of truth.
+func (k *astarKeys) estimate(vertexID string) (float64, error) {
+	if value, ok := k.estimates[vertexID]; ok {
+		return value, nil
+	}
+
+	value := k.heuristic(vertexID)
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+		return 0, fmt.Errorf("%w: vertex=%q estimate=%g", ErrInvalidHeuristic, vertexID, value)
+	}
+
+	k.estimates[vertexID] = value
+
+	return value, nil
+}
+
+// checkEdge verifies h(from) <= weight + h(to) for one relaxed edge.
+//
+// Implementation:
+//   - Stage 1: Return nil outside WithHeuristicCheck mode.
+//   - Stage 2: Read both cached estimates.
+//   - Stage 3: Compare with relative tolerance.
+//
+// Behavior highlights:
+//   - Checks only in WithHeuristicCheck mode.
+//
+// Inputs:
+//   - edge: the relaxed edge, used for diagnostics.
+//   - fromID: the finalized vertex being expanded.
+//   - toID: the resolved neighbor endpoint.
+//   - weight: the validated finite edge weight.
+//
+// Returns:
+//   - error: nil when the edge satisfies consistency.
+//
+// Errors:
+//   - Wrapped ErrInvalidHeuristic from estimate.
+//   - ErrInadmissibleHeuristic wrapped with edge and estimate context.
+//
+// Determinism:
+//   - Deterministic for the same estimates and weight.
+//
+// Complexity:
+//   - Time O(1), Space O(1) amortized.
+//
+// AI-Hints:
+//   - Keep the tolerance relative; absolute slack breaks large-coordinate graphs.
+func (k *astarKeys) checkEdge(edge *core.Edge, fromID, toID string, weight float64) error {
+	if !k.check {
+		return nil
+	}
+
+	fromEstimate, err := k.estimate(fromID)
+	if err != nil {
+		return err
+	}
+	toEstimate, err := k.estimate(toID)
+	if err != nil {
+		return err
+	}
+
+	bound := weight + toEstimate
+	if fromEstimate-bound > heuristicTolerance*math.Max(1, math.Abs(fromEstimate)) {
+		return fmt.Errorf(
+			"%w: edge_id=%q from=%q to=%q h(from)=%g weight=%g h(to)=%g",
+			ErrInadmissibleHeuristic,
+			edge.ID,
+			fromID,
+			toID,
+			fromEstimate,
+			weight,
+			toEstimate,
+		)
+	}
+
+	return nil
+}
+
+// checkAdmissibility verifies that no witness vertex overestimates its remaining distance.
+//
+// Implementation:
+//   - Stage 1: For each witness vertex v, compute remaining = distance - g(v).
+//   - Stage 2: Reject h(v) > remaining beyond the relative tolerance.
+//
+// Behavior highlights:
+//   - Complements the per-edge consistency check with an end-to-end bound.
+//
+// Inputs:
+//   - path: the reconstructed witness from source to target.
+//   - distances: the runner distances; witness vertices are finalized.
+//   - distance: the witness distance to the target.
+//
+// Returns:
+//   - error: nil when every witness estimate is admissible.
+//
+// Errors:
+//   - ErrInadmissibleHeuristic wrapped with vertex and bound context.
+//
+// Determinism:
+//   - Deterministic for the same witness and cached estimates.
+//
+// Complexity:
+//   - Time O(k), Space O(1), where k is the witness length.
+//
+// AI-Hints:
+//   - Witness vertices are finalized, so distances holds exact values here.
+func (k *astarKeys) checkAdmissibility(path []string, distances map[string]float64, distance float64) error {
+	for _, vertexID := range path {
+		vertexEstimate, err := k.estimate(vertexID)
+		if err != nil {
+			return err
+		}
+
+		remaining := distance - distances[vertexID]
+		if vertexEstimate-remaining > heuristicTolerance*math.Max(1, math.Abs(distance)) {
+			return fmt.Errorf(
+				"%w: vertex=%q estimate=%g remaining=%g",
+				ErrInadmissibleHeuristic,
+				vertexID,
+				vertexEstimate,
+				remaining,
+			)
+		}
+	}
+
+	return nil
+}
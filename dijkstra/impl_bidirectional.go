@@ -0,0 +1,348 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// witnessSlack is the relative rounding allowance when Stage 4 of
+// runBidirectional tests whether a candidate can still lie on a shortest path.
+const witnessSlack = 1e-9
+
+// runBidirectional executes one point-to-point query by interleaving a forward
+// runner from sourceID and a backward runner from targetID over relation snapshots.
+//
+// Implementation:
+//   - Stage 1: Resolve the trivial sourceID == targetID query.
+//   - Stage 2: Seed a forward runner on outgoing relations and a backward runner
+//     on incoming relations, both gated by one bidirectionalSearch.
+//   - Stage 3: Repeatedly settle one vertex on the side with the smaller live key
+//     (forward on ties) until topForward + topBackward > mu.
+//   - Stage 4: Resume the forward runner alone, admitting only candidates at
+//     backward-settled vertices whose two distances sum to at most mu, until
+//     targetID is settled.
+//   - Stage 5: Publish the forward tree path and distance to targetID.
+//
+// Behavior highlights:
+//   - mu is updated on every tentative improvement of either side, which makes
+//     the stopping rule exact for directed, undirected, and mixed edges.
+//   - The strict stopping rule settles every vertex of every shortest path on at
+//     least one side: a vertex missed by both would cost at least
+//     topForward + topBackward > mu.
+//   - The forward runner is the one-to-all kernel over the same relation order.
+//     A shortest-path vertex first becomes reachable at its final distance from
+//     another shortest-path vertex, so dropping every other candidate in Stage 4
+//     keeps the settle order, and therefore every predecessor, of ShortestPathTo.
+//   - Each side applies MaxDistance to its own half; mu itself never exceeds it.
+//   - Untouched vertices are never initialized, so a query costs only the
+//     explored region; Stage 4 walks only the shortest-path region of the
+//     backward ball.
+//
+// Inputs:
+//   - b: the validated engine holding relation snapshots and policy.
+//   - sourceID: a known source vertex.
+//   - targetID: a known target vertex.
+//
+// Returns:
+//   - []string: the ShortestPathTo witness.
+//   - float64: the ShortestPathTo distance.
+//
+// Errors:
+//   - ErrNoPath if targetID is unreachable under the effective policy.
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrDistanceOverflow from relaxation.
+//
+// Determinism:
+//   - Side selection, heap order, and snapshot order are fixed, so equal inputs
+//     yield equal witnesses.
+//
+// Complexity:
+//   - Worst case O((V + E) log V); typically both balls stay far smaller than
+//     the single one-to-all ball of Dijkstra.
+//
+// Notes:
+//   - Ties are detected by float64 equality, as in the one-to-all kernel. Weights
+//     whose partial sums round differently along equal-cost routes can still
+//     lead to another witness of the same distance.
+//
+// AI-Hints:
+//   - Do not stop when both searches merely settle a common vertex; that rule is
+//     incorrect. Keep the topForward + topBackward > mu criterion; with >= a
+//     shortest-path vertex can escape both balls and the witness drifts.
+//   - Do not use live g.Neighbors for the backward side; core has no incoming surface.
+func runBidirectional(b *Bidirectional, sourceID, targetID string) ([]string, float64, error) {
+	if sourceID == targetID {
+		return []string{sourceID}, 0, nil
+	}
+	if b.options.VertexFilter != nil && !b.options.VertexFilter(targetID) {
+		return nil, 0, ErrNoPath
+	}
+
+	search := newBidirectionalSearch(b)
+	forward, backward := search.forward, search.backward
+
+	if err := forward.seed(sourceID); err != nil {
+		return nil, 0, err
+	}
+	if err := backward.seed(targetID); err != nil {
+		return nil, 0, err
+	}
+
+	for {
+		forwardMin := forward.frontierMin()
+		backwardMin := backward.frontierMin()
+		if total := forwardMin + backwardMin; total > search.meeting || math.IsInf(total, 1) {
+			break
+		}
+
+		side := forward
+		if backwardMin < forwardMin {
+			side = backward
+		}
+		if _, _, err := side.settleNext(""); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if math.IsInf(search.meeting, 1) {
+		return nil, 0, ErrNoPath
+	}
+
+	search.finish()
+	for !forward.visited[targetID] {
+		_, ok, err := forward.settleNext("")
+		if err != nil {
+			return nil, 0, err
+		}
+		if !ok {
+			return nil, 0, ErrNoPath
+		}
+	}
+
+	forwardWitness := &Result{SourceID: sourceID, Distances: forward.distances, Prev: forward.previous}
+	path, err := forwardWitness.PathTo(targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return path, forward.distances[targetID], nil
+}
+
+// bidirectionalSearch holds the per-query state of a bidirectional search:
+// both runners, the best known source-target connection mu, and the Stage 4
+// admission limit of runBidirectional.
+//
+// Implementation:
+//   - Stage 1: Own a forward runner on outgoing relations and a backward runner
+//     on incoming relations.
+//   - Stage 2: Gate both through bidirectionalSide admission hooks.
+//
+// Behavior highlights:
+//   - While interleaving, every tentative improvement on either side is
+//     offered to mu; only the value is kept, the witness comes from the
+//     forward tree.
+//   - After finish, forward candidates are admitted only where they can still
+//     complete a shortest path through the backward ball.
+//
+// Determinism:
+//   - The minimum is independent of update order.
+//
+// Complexity:
+//   - Storage O(1) beyond the two runners.
+//
+// AI-Hints:
+//   - Keep this the only place where mu is stored.
+type bidirectionalSearch struct {
+	forward  *runner
+	backward *runner
+
+	meeting     float64
+	maxDistance float64
+	finishing   bool
+	limit       float64
+}
+
+// newBidirectionalSearch allocates lazily seeded runners over the engine snapshots.
+//
+// Implementation:
+//   - Stage 1: Allocate empty state maps; nothing is initialized per vertex.
+//   - Stage 2: Bind each runner to its snapshot orientation and admission side.
+//
+// Complexity:
+//   - Time O(1), Space O(1) before traversal.
+func newBidirectionalSearch(b *Bidirectional) *bidirectionalSearch {
+	search := &bidirectionalSearch{
+		meeting:     math.Inf(1),
+		maxDistance: b.options.MaxDistance,
+	}
+	search.forward = newSnapshotRunner(relationView{edges: b.outgoing}, b.options, &bidirectionalSide{search: search})
+	search.backward = newSnapshotRunner(relationView{edges: b.incoming, reverse: true}, b.options, &bidirectionalSide{search: search, backward: true})
+
+	return search
+}
+
+// newSnapshotRunner allocates a lazily seeded runner over a relation view.
+//
+// Inputs:
+//   - relations: outgoing or incoming relation snapshot.
+//   - config: finalized runtime policy.
+//   - admission: the side hook.
+//
+// Returns:
+//   - *runner: a runner ready for seed.
+//
+// Complexity:
+//   - Time O(1), Space O(1) before traversal.
+func newSnapshotRunner(relations relationView, config Options, admission admissionHook) *runner {
+	return &runner{
+		options:   config,
+		relations: relations,
+		distances: make(map[string]float64),
+		previous:  make(map[string]string),
+		visited:   make(map[string]bool),
+		frontier:  make(nodePQ, 0, 1),
+		admission: admission,
+		algebra:   algebraSum,
+	}
+}
+
+// finish switches the search to Stage 4 of runBidirectional.
+//
+// Behavior highlights:
+//   - Every shortest-path vertex the forward runner has not settled yet was
+//     settled backward with its exact distance to the target, so only
+//     candidates that can still complete a shortest path are admitted.
+//   - The slack absorbs rounding, so no shortest-path vertex is ever refused.
+func (s *bidirectionalSearch) finish() {
+	s.finishing = true
+	s.limit = s.meeting + witnessSlack*math.Max(1, s.meeting)
+}
+
+// observe offers a candidate connection through vertexID to mu.
+//
+// Implementation:
+//   - Stage 1: Ignore vertices not yet reached by the opposite side.
+//   - Stage 2: Reject overflowing totals with ErrDistanceOverflow.
+//   - Stage 3: Ignore totals beyond MaxDistance.
+//   - Stage 4: Keep the smaller total.
+//
+// Inputs:
+//   - vertexID: the vertex whose tentative distance just improved.
+//   - distance: the improved distance on the calling side.
+//   - oppositeDistance: the tentative distance on the opposite side.
+//
+// Returns:
+//   - error: nil unless the total overflows.
+//
+// Errors:
+//   - Wrapped ErrDistanceOverflow.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (s *bidirectionalSearch) observe(vertexID string, distance, oppositeDistance float64) error {
+	if math.IsInf(oppositeDistance, 1) {
+		return nil
+	}
+
+	total := distance + oppositeDistance
+	if math.IsInf(total, 1) {
+		return fmt.Errorf(
+			"%w: meeting vertex=%q forward/backward=%g/%g",
+			ErrDistanceOverflow,
+			vertexID,
+			distance,
+			oppositeDistance,
+		)
+	}
+	if total > s.maxDistance {
+		return nil
+	}
+	if total < s.meeting {
+		s.meeting = total
+	}
+
+	return nil
+}
+
+// bidirectionalSide is the admission hook of one side of a bidirectional search.
+//
+// Behavior highlights:
+//   - Every edge is scanned; only candidates are gated.
+//   - While interleaving, candidates are always admitted and reported to mu.
+//   - After finish, only the forward side runs, and it admits a candidate only
+//     at a backward-settled vertex whose two distances sum to at most the limit.
+type bidirectionalSide struct {
+	search   *bidirectionalSearch
+	backward bool
+}
+
+func (s *bidirectionalSide) admitEdge(*core.Edge, string) bool { return true }
+
+func (s *bidirectionalSide) admitCandidate(vertexID string, distance float64) (bool, error) {
+	opposite := s.search.backward
+	if s.backward {
+		opposite = s.search.forward
+	}
+
+	if s.search.finishing {
+		return opposite.visited[vertexID] && distance+opposite.distances[vertexID] <= s.search.limit, nil
+	}
+
+	return true, s.search.observe(vertexID, distance, opposite.distanceOf(vertexID))
+}
+
+// buildRelationSnapshots indexes every edge of g by traversal origin in both
+// orientations, preserving core.Edges() Edge.ID order inside each bucket.
+//
+// Implementation:
+//   - Stage 1: Enumerate core.Edges() once.
+//   - Stage 2: Directed edges enter outgoing[From] and incoming[To].
+//   - Stage 3: Undirected edges enter both buckets of both endpoints
+//     (once for self-loops).
+//
+// Behavior highlights:
+//   - Bucket order matches the g.Neighbors order used by the live kernel.
+//
+// Inputs:
+//   - g: a non-nil graph.
+//
+// Returns:
+//   - map[string][]*core.Edge: outgoing relation by vertex.
+//   - map[string][]*core.Edge: incoming relation by vertex.
+//
+// Errors:
+//   - None.
+//
+// Determinism:
+//   - Deterministic for the same graph state.
+//
+// Complexity:
+//   - Time O(E log E) for the sorted core.Edges() surface, Space O(E).
+//
+// AI-Hints:
+//   - Snapshots are frozen; rebuild them after any graph mutation.
+func buildRelationSnapshots(g *core.Graph) (map[string][]*core.Edge, map[string][]*core.Edge) {
+	edges := g.Edges()
+	outgoing := make(map[string][]*core.Edge, g.VertexCount())
+	incoming := make(map[string][]*core.Edge, g.VertexCount())
+
+	for _, edge := range edges {
+		outgoing[edge.From] = append(outgoing[edge.From], edge)
+		if edge.Directed {
+			incoming[edge.To] = append(incoming[edge.To], edge)
+			continue
+		}
+
+		incoming[edge.From] = append(incoming[edge.From], edge)
+		if edge.To != edge.From {
+			outgoing[edge.To] = append(outgoing[edge.To], edge)
+			incoming[edge.To] = append(incoming[edge.To], edge)
+		}
+	}
+
+	return outgoing, incoming
+}
@@ -34,6 +34,7 @@ import (
 //   - g: the weighted graph to traverse.
 //   - sourceID: the source vertex identifier for the shortest-path run.
 //   - config: the finalized runtime policy for this execution.
+//   - algebra: the path-value law; algebraSum for shortest paths.
 //
 // Returns:
 //   - *Result: the detached shortest-path result for the requested source.
@@ -65,7 +66,7 @@ import (
 //   - Do not move endpoint resolution logic out of the canonical helper path or simplify it to edge.To.
 //   - Do not remove finite MaxDistance subtraction guard before candidate addition.
 //   - Do not convert ErrDistanceOverflow into +Inf unreachable publication.
-func runDijkstra(g *core.Graph, sourceID string, config Options) (*Result, error) {
+func runDijkstra(g *core.Graph, sourceID string, config Options, algebra pathAlgebra) (*Result, error) {
 	if err := validateInputs(g, sourceID); err != nil {
 		return nil, err
 	}
@@ -78,9 +79,10 @@ func runDijkstra(g *core.Graph, sourceID string, config Options) (*Result, error
 	distances := make(map[string]float64, vertexCount)
 	visited := make(map[string]bool, vertexCount)
 
-	var previous map[string]string
+	var previous, previousEdge map[string]string
 	if config.TrackPaths {
 		previous = make(map[string]string, vertexCount)
+		previousEdge = make(map[string]string, vertexCount)
 	}
 
 	frontierCapacity := vertexCount
@@ -89,17 +91,20 @@ func runDijkstra(g *core.Graph, sourceID string, config Options) (*Result, error
 	}
 
 	runnerState := &runner{
-		graph:     g,
-		sourceID:  sourceID,
-		options:   config,
-		distances: distances,
-		previous:  previous,
-		visited:   visited,
-		frontier:  make(nodePQ, 0, frontierCapacity),
+		graph:        g,
+		sourceID:     sourceID,
+		options:      config,
+		distances:    distances,
+		previous:     previous,
+		visited:      visited,
+		frontier:     make(nodePQ, 0, frontierCapacity),
+		previousEdge: previousEdge,
+		algebra:      algebra,
 	}
 
-	runnerState.init()
-
+	if err := runnerState.init(); err != nil {
+		return nil, err
+	}
 	if err := runnerState.process(); err != nil {
 		return nil, err
 	}
@@ -108,6 +113,7 @@ func runDijkstra(g *core.Graph, sourceID string, config Options) (*Result, error
 		SourceID:  sourceID,
 		Distances: runnerState.distances,
 		Prev:      runnerState.previous,
+		PrevEdge:  runnerState.previousEdge,
 	}, nil
 }
 
@@ -115,18 +121,27 @@ func runDijkstra(g *core.Graph, sourceID string, config Options) (*Result, error
 // The structure is private to the kernel and is discarded after result publication.
 //
 // Implementation:
-//   - Stage 1: Hold graph and policy references.
+//   - Stage 1: Hold graph, relation view, and policy references.
 //   - Stage 2: Hold mutable shortest-path state maps.
 //   - Stage 3: Hold the frontier heap used by the lazy decrease-key loop.
+//   - Stage 4: Hold the hooks that specialize the kernel, set at construction.
 //
 // Behavior highlights:
 //   - The graph is treated as read-only.
 //   - Distances and predecessor data are owned by the runner until publication.
+//   - Per-request state (A* estimates, spur exclusions, bidirectional meeting
+//     state) lives in the hooks, not in the runner.
 //
 // Inputs:
 //   - graph: the traversed graph.
 //   - sourceID: the source vertex identifier.
 //   - options: the finalized runtime policy.
+//   - relations: the scanned relation; the zero value is live g.Neighbors.
+//   - previous, previousEdge: optional predecessor vertex and edge IDs; nil
+//     disables the corresponding tracking.
+//   - heuristic: optional heap-key hook; nil keys the heap by distance.
+//   - admission: optional candidate gate; nil admits everything.
+//   - algebra: path-value law; required.
 //
 // Returns:
 //   - runner: internal-only traversal state.
@@ -146,14 +161,61 @@ func runDijkstra(g *core.Graph, sourceID string, config Options) (*Result, error
 // AI-Hints:
 //   - Keep this as the single mutable kernel state carrier.
 //   - Do not duplicate distance/predecessor state in parallel structs.
+//   - Add new kernel variants as hooks, not as runner fields.
 type runner struct {
 	graph     *core.Graph
 	sourceID  string
 	options   Options
+	relations relationView
 	distances map[string]float64
 	previous  map[string]string
 	visited   map[string]bool
 	frontier  nodePQ
+
+	previousEdge map[string]string
+	heuristic    heuristicHook
+	admission    admissionHook
+	algebra      pathAlgebra
+}
+
+// heuristicHook turns tentative distances into heap keys (A*).
+//
+// AI-Hints:
+//   - Keys stay in distance units; nodePQ tie-breaking by ID relies on it.
+type heuristicHook interface {
+	// priority returns the heap key of vertexID reached at distance.
+	priority(vertexID string, distance float64) (float64, error)
+
+	// checkEdge validates the estimates across one relaxed edge.
+	checkEdge(edge *core.Edge, fromID, toID string, weight float64) error
+}
+
+// admissionHook gates what a runner may relax.
+//
+// AI-Hints:
+//   - admitEdge runs before any weight inspection; admitCandidate runs after
+//     the strict-improvement check and before the candidate is stored.
+type admissionHook interface {
+	// admitEdge reports whether edge may be scanned into neighborID.
+	admitEdge(edge *core.Edge, neighborID string) bool
+
+	// admitCandidate reports whether an improving candidate may be stored.
+	// A rejected candidate is dropped as if it were no improvement.
+	admitCandidate(vertexID string, distance float64) (bool, error)
+}
+
+// relationView selects the relation a runner scans.
+//
+// Behavior highlights:
+//   - nil edges: the live g.Neighbors surface.
+//   - Otherwise a frozen snapshot from buildRelationSnapshots, walked against
+//     edge direction when reverse is set.
+//
+// AI-Hints:
+//   - Reverse views must always use a snapshot; core exposes outgoing relations only.
+type relationView struct {
+	edges   map[string][]*core.Edge
+	reverse bool
 }
 
 // init initializes the full traversal state for a single Dijkstra execution.
@@ -161,23 +223,22 @@ type runner struct {
 // and the source is pushed into the heap as the first frontier item.
 //
 // Implementation:
-//   - Stage 1: Enumerate the deterministic vertex domain.
-//   - Stage 2: Initialize distances and visited flags.
-//   - Stage 3: Initialize predecessor storage when enabled.
-//   - Stage 4: Initialize the frontier heap with the source vertex.
+//   - Stage 1: Initialize the full vertex domain through initDomain.
+//   - Stage 2: Seed the frontier heap with the source vertex.
 //
 // Behavior highlights:
 //   - All known vertices are present in the distance map before processing begins.
 //   - Predecessors are initialized only when tracking is enabled.
+//   - With a heuristic, the source heap key is h(source) instead of 0.
 //
 // Inputs:
 //   - None.
 //
 // Returns:
-//   - None.
+//   - error: nil on success, or a wrapped heuristic error for the source vertex.
 //
 // Errors:
-//   - None.
+//   - ErrInvalidHeuristic if the heuristic rejects the source estimate.
 //
 // Determinism:
 //   - Initialization order follows core.Vertices() lexicographic order.
@@ -193,7 +254,38 @@ type runner struct {
 // AI-Hints:
 //   - Keep the initial source distance at exactly 0.
 //   - Do not leave vertices absent from distances; missing keys mean unknown target, not unreachable vertex.
-func (r *runner) init() {
+func (r *runner) init() error {
+	r.initDomain()
+
+	return r.seed(r.sourceID)
+}
+
+// initDomain assigns +Inf, unvisited, and empty predecessor state to every
+// graph vertex without seeding any origin.
+//
+// Implementation:
+//   - Stage 1: Enumerate the deterministic vertex domain.
+//   - Stage 2: Initialize distances, visited flags, and enabled predecessor maps.
+//   - Stage 3: Initialize the frontier heap.
+//
+// Behavior highlights:
+//   - Shared by single-source init and multi-source seeding.
+//
+// Inputs:
+//   - None.
+//
+// Returns:
+//   - None.
+//
+// Errors:
+//   - None.
+//
+// Determinism:
+//   - Initialization order follows core.Vertices() lexicographic order.
+//
+// Complexity:
+//   - Time O(V log V) including core.Vertices() sorting, Space O(V).
+func (r *runner) initDomain() {
 	vertexIDs := r.graph.Vertices()
 
 	for _, vertexID := range vertexIDs {
@@ -205,16 +297,130 @@ func (r *runner) init() {
 		}
 	}
 
-	r.distances[r.sourceID] = 0
-
 	heap.Init(&r.frontier)
+}
+
+// seed places one origin vertex at the algebra origin value (0 for shortest
+// paths) and pushes it onto the frontier.
+// It is the lazy counterpart of init for point-to-point kernels that must not
+// pay a full vertex-domain initialization per query.
+//
+// Implementation:
+//   - Stage 1: Set the origin distance to exactly 0.
+//   - Stage 2: Clear its predecessor when tracking is enabled.
+//   - Stage 3: Push the origin with its heap key.
+//
+// Behavior highlights:
+//   - Vertices never touched by the search stay absent from distances;
+//     distanceOf reports them as +Inf.
+//
+// Inputs:
+//   - vertexID: the origin vertex, already validated by the caller.
+//
+// Returns:
+//   - error: nil on success, or a wrapped heuristic error for the origin.
+//
+// Errors:
+//   - ErrInvalidHeuristic if the heuristic rejects the origin estimate.
+//
+// Determinism:
+//   - Deterministic.
+//
+// Complexity:
+//   - Time O(log H), Space O(1).
+//
+// AI-Hints:
+//   - Do not publish a lazily seeded runner as a Result; its domain is partial.
+func (r *runner) seed(vertexID string) error {
+	return r.seedAt(vertexID, r.algebra.origin())
+}
+
+// seedAt places one origin vertex at the given initial distance and pushes it
+// onto the frontier. Multi-source runs use it to apply per-source offsets.
+//
+// Implementation:
+//   - Stage 1: Keep an existing smaller-or-equal seed (duplicate origins).
+//   - Stage 2: Set the origin distance and clear its predecessor state.
+//   - Stage 3: Push the origin with its heap key.
+//
+// Inputs:
+//   - vertexID: the origin vertex, already validated by the caller.
+//   - distance: the finite non-negative initial distance.
+//
+// Returns:
+//   - error: nil on success, or a wrapped heuristic error for the origin.
+//
+// Errors:
+//   - ErrInvalidHeuristic if the heuristic rejects the origin estimate.
+//
+// Determinism:
+//   - Deterministic.
+//
+// Complexity:
+//   - Time O(log H), Space O(1).
+func (r *runner) seedAt(vertexID string, distance float64) error {
+	if distance >= r.distanceOf(vertexID) {
+		return nil
+	}
+
+	r.distances[vertexID] = distance
+	if r.previous != nil {
+		r.previous[vertexID] = ""
+	}
+	if r.previousEdge != nil {
+		delete(r.previousEdge, vertexID)
+	}
+
+	priority, err := r.priority(vertexID, distance)
+	if err != nil {
+		return err
+	}
+
 	heap.Push(&r.frontier, &nodeItem{
-		id:   r.sourceID,
-		dist: 0,
+		id:   vertexID,
+		dist: priority,
 	})
+
+	return nil
+}
+
+// distanceOf returns the tentative distance of vertexID, treating absent keys as +Inf.
+//
+// Implementation:
+//   - Stage 1: Look up the distance map.
+//   - Stage 2: Map a missing key to +Inf.
+//
+// Behavior highlights:
+//   - Makes relaxation safe for lazily seeded runners.
+//
+// Inputs:
+//   - vertexID: the vertex to query.
+//
+// Returns:
+//   - float64: the tentative distance or +Inf.
+//
+// Errors:
+//   - None.
+//
+// Determinism:
+//   - Deterministic.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+//
+// AI-Hints:
+//   - Never read r.distances[id] directly in relaxation; a missing key would read as 0.
+func (r *runner) distanceOf(vertexID string) float64 {
+	distance, ok := r.distances[vertexID]
+	if !ok {
+		return math.Inf(1)
+	}
+
+	return distance
 }
 
-// process runs the main visited-finalization loop of Dijkstra's algorithm.
+// process runs the main visited-finalization loop of Dijkstra's algorithm
+// over the whole reachable region.
 // The loop repeatedly extracts the smallest frontier item, finalizes it once,
 // and relaxes the corresponding outgoing relation surface.
 //
@@ -246,32 +452,136 @@ func (r *runner) init() {
 //
 // Notes:
 //   - A popped item with distance greater than MaxDistance is not finalized and terminates the loop.
+//   - Under a consistent heuristic the popped key h-adjusts the distance upward,
+//     so the MaxDistance cutoff stays exact for the target (h(target) == 0).
 //
 // AI-Hints:
 //   - Do not replace the visited-finalization model unless you can prove full contract equivalence.
 //   - Keep the cutoff comparison on the popped minimum item, not on arbitrary neighbors.
 func (r *runner) process() error {
+	return r.processTo("")
+}
+
+// processTo runs the visited-finalization loop until targetID is finalized.
+// An empty targetID runs the one-to-all loop of process.
+//
+// Behavior highlights:
+//   - The target is finalized but not expanded.
+//
+// Errors:
+//   - Any error returned by relax.
+//
+// Complexity:
+//   - Same as process, bounded by the region settled before targetID.
+func (r *runner) processTo(targetID string) error {
+	for {
+		currentID, ok, err := r.settleNext(targetID)
+		if err != nil {
+			return err
+		}
+		if !ok || currentID == targetID {
+			return nil
+		}
+	}
+}
+
+// settleNext finalizes the next frontier vertex and relaxes its relation.
+// It is the single-step form of process, shared with kernels that interleave
+// several runners.
+//
+// Implementation:
+//   - Stage 1: Pop frontier items, discarding stale duplicates.
+//   - Stage 2: Apply the MaxDistance cutoff and drain the frontier when it fires.
+//   - Stage 3: Finalize the vertex.
+//   - Stage 4: Relax its relation unless it is stopID.
+//
+// Behavior highlights:
+//   - stopID is finalized but not expanded; an empty stopID expands every vertex.
+//
+// Inputs:
+//   - stopID: the target of a point query, or empty.
+//
+// Returns:
+//   - string: the finalized vertex.
+//   - bool: false when the frontier is exhausted or cut off.
+//   - error: nil on success, or an error returned by relax.
+//
+// Errors:
+//   - Any error returned by relax.
+//
+// Determinism:
+//   - Deterministic under the heap tie-break and relation order.
+//
+// Complexity:
+//   - Amortized O(log H) per pop plus the relax cost of the finalized vertex.
+//
+// AI-Hints:
+//   - Keep the cutoff comparison on the popped minimum item.
+func (r *runner) settleNext(stopID string) (string, bool, error) {
 	for r.frontier.Len() > 0 {
 		item := heap.Pop(&r.frontier).(*nodeItem)
 
 		currentID := item.id
-		currentDistance := item.dist
-
 		if r.visited[currentID] {
 			continue
 		}
-		if currentDistance > r.options.MaxDistance {
+		if item.dist > r.options.MaxDistance {
+			r.frontier = r.frontier[:0]
 			break
 		}
 
 		r.visited[currentID] = true
+		if currentID == stopID {
+			return currentID, true, nil
+		}
 
 		if err := r.relax(currentID); err != nil {
-			return err
+			return "", false, err
 		}
+
+		return currentID, true, nil
 	}
 
-	return nil
+	return "", false, nil
+}
+
+// frontierMin returns the smallest live heap key, discarding stale tops.
+//
+// Implementation:
+//   - Stage 1: Pop finalized duplicates from the heap top.
+//   - Stage 2: Return the top key, or +Inf for an empty frontier.
+//
+// Behavior highlights:
+//   - Used by interleaved kernels for their stopping criteria.
+//
+// Inputs:
+//   - None.
+//
+// Returns:
+//   - float64: the minimum live key or +Inf.
+//
+// Errors:
+//   - None.
+//
+// Determinism:
+//   - Deterministic.
+//
+// Complexity:
+//   - Amortized O(log H).
+//
+// AI-Hints:
+//   - Do not read r.frontier[0] without discarding stale items first.
+func (r *runner) frontierMin() float64 {
+	for r.frontier.Len() > 0 {
+		top := r.frontier[0]
+		if !r.visited[top.id] {
+			return top.dist
+		}
+
+		heap.Pop(&r.frontier)
+	}
+
+	return math.Inf(1)
 }
 
 // relax scans the neighbor relation of the current vertex and performs
@@ -283,13 +593,17 @@ func (r *runner) process() error {
 //   - Stage 1: Enumerate the graph neighbor relation of the current vertex.
 //   - Stage 2: Resolve the effective neighbor endpoint for each edge.
 //   - Stage 3: Apply runtime numeric validation and wall policy.
-//   - Stage 4: Compute candidate distances and apply strict-improvement relaxation.
-//   - Stage 5: Record predecessor state when tracking is enabled and push the new heap item.
+//   - Stage 4: Extend the current value through the algebra hook and apply
+//     strict-improvement relaxation.
+//   - Stage 5: Offer the candidate to the admission hook, record predecessor
+//     state when tracking is enabled, and push the new heap item.
 //
 // Behavior highlights:
 //   - Undirected and mixed edges are handled through canonical endpoint resolution.
+//   - Reverse views walk incoming relations through reverseEndpoint.
 //   - Equal candidate distances do not overwrite predecessor state.
 //   - Already finalized neighbors are skipped eagerly.
+//   - Edges refused by the admission hook are skipped before any weight inspection.
 //
 // Inputs:
 //   - currentID: the currently finalized vertex whose neighbor relation will be relaxed.
@@ -303,6 +617,7 @@ func (r *runner) process() error {
 //   - Wrapped ErrNegativeWeight if runtime observation finds a finite negative weight.
 //   - Wrapped ErrDistanceOverflow if currentDistance + weight cannot be represented
 //     as a finite float64 under the active MaxDistance policy.
+//   - Errors from the heuristic and admission hooks.
 //
 // Determinism:
 //   - Neighbor scanning order follows core.Neighbors(currentID).
@@ -321,21 +636,30 @@ func (r *runner) process() error {
 //   - Never replace endpoint resolution with edge.To for all cases; undirected edges require relative endpoint logic.
 //   - Keep strict-improvement-only updates to preserve deterministic predecessor selection.
 func (r *runner) relax(currentID string) error {
-	neighborEdges, err := r.graph.Neighbors(currentID)
+	neighborEdges, err := r.relation(currentID)
 	if err != nil {
 		return fmt.Errorf("dijkstra: failed to get neighbors of %q: %w", currentID, err)
 	}
 
 	for _, edge := range neighborEdges {
-		neighborID, ok := otherEndpoint(edge, currentID)
+		neighborID, ok := r.endpoint(edge, currentID)
 		if !ok {
 			continue
 		}
 		if r.visited[neighborID] {
 			continue
 		}
+		if r.admission != nil && !r.admission.admitEdge(edge, neighborID) {
+			continue
+		}
+		if r.options.VertexFilter != nil && !r.options.VertexFilter(neighborID) {
+			continue
+		}
 
-		weight := edge.Weight
+		weight, admitted := edgeCost(r.options, edge)
+		if !admitted {
+			continue
+		}
 		if err = classifyWeight(weight); err != nil {
 			return fmt.Errorf(
 				"%w: edge_id=%q from=%q to=%q directed=%t weight=%g",
@@ -350,52 +674,92 @@ func (r *runner) relax(currentID string) error {
 		if weight >= r.options.InfEdgeThreshold {
 			continue
 		}
-
-		currentDistance := r.distances[currentID]
-
-		// Apply a finite MaxDistance cutoff before addition. This prevents an
-		// out-of-policy candidate from overflowing even though the candidate
-		// would be discarded by the traversal policy anyway.
-		if !math.IsInf(r.options.MaxDistance, 1) &&
-			weight > r.options.MaxDistance-currentDistance {
-			continue
+		if r.heuristic != nil {
+			if err = r.heuristic.checkEdge(edge, currentID, neighborID, weight); err != nil {
+				return err
+			}
 		}
 
-		candidateDistance := currentDistance + weight
-		if math.IsInf(candidateDistance, 1) {
-			return fmt.Errorf(
-				"%w: edge_id=%q from=%q to=%q current_distance=%g weight=%g",
-				ErrDistanceOverflow,
-				edge.ID,
-				edge.From,
-				edge.To,
-				currentDistance,
-				weight,
-			)
+		candidateDistance, ok, err := r.algebra.extend(edge, r.distanceOf(currentID), weight, r.options.MaxDistance)
+		if err != nil {
+			return err
 		}
-
-		if candidateDistance > r.options.MaxDistance {
+		if !ok || candidateDistance > r.options.MaxDistance {
 			continue
 		}
-		if candidateDistance >= r.distances[neighborID] {
+		if candidateDistance >= r.distanceOf(neighborID) {
 			continue
 		}
+		if r.admission != nil {
+			if ok, err = r.admission.admitCandidate(neighborID, candidateDistance); err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		}
 
-		r.distances[neighborID] = candidateDistance
-
-		if r.previous != nil {
-			r.previous[neighborID] = currentID
+		if err = r.accept(currentID, neighborID, edge.ID, candidateDistance); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+// accept publishes an improved tentative value for neighborID and queues it.
+//
+// Implementation:
+//   - Stage 1: Store the value.
+//   - Stage 2: Record predecessor vertex and edge when tracking is enabled.
+//   - Stage 3: Push the neighbor with its heap key.
+//
+// Errors:
+//   - Errors from the heuristic hook.
+//
+// Complexity:
+//   - Time O(log H), Space O(1).
+func (r *runner) accept(currentID, neighborID, edgeID string, candidateDistance float64) error {
+	r.distances[neighborID] = candidateDistance
+
+	if r.previous != nil {
+		r.previous[neighborID] = currentID
+	}
+	if r.previousEdge != nil {
+		r.previousEdge[neighborID] = edgeID
+	}
 
-		heap.Push(&r.frontier, &nodeItem{
-			id:   neighborID,
-			dist: candidateDistance,
-		})
+	candidatePriority, err := r.priority(neighborID, candidateDistance)
+	if err != nil {
+		return err
 	}
 
+	heap.Push(&r.frontier, &nodeItem{
+		id:   neighborID,
+		dist: candidatePriority,
+	})
+
 	return nil
 }
 
+// priority returns the heap key for a vertex reached with the given distance.
+//
+// Behavior highlights:
+//   - Plain runs pay a single nil check per push; A* delegates to its hook.
+//
+// Errors:
+//   - Errors from the heuristic hook.
+//
+// Complexity:
+//   - Time O(1) plus the hook cost.
+func (r *runner) priority(vertexID string, distance float64) (float64, error) {
+	if r.heuristic == nil {
+		return distance, nil
+	}
+
+	return r.heuristic.priority(vertexID, distance)
+}
+
 // nodeItem stores one frontier candidate for the priority queue.
 // The item contains the target vertex identifier and the candidate distance
 // currently associated with that heap entry.
@@ -409,7 +773,8 @@ func (r *runner) relax(currentID string) error {
 //
 // Inputs:
 //   - id: the candidate vertex identifier.
-//   - dist: the candidate source distance associated with this heap item.
+//   - dist: the heap key; the candidate source distance, plus the heuristic
+//     estimate when the runner executes A*.
 //
 // Returns:
 //   - nodeItem: an internal heap payload type.
@@ -698,3 +1063,133 @@ func otherEndpoint(e *core.Edge, currentID string) (string, bool) {
 
 	return "", false
 }
+
+// reverseEndpoint resolves the vertex that reaches currentID through e, i.e. the
+// endpoint law of otherEndpoint applied to the reversed relation.
+//
+// Implementation:
+//   - Stage 1: Reject a nil edge.
+//   - Stage 2: For directed edges, accept only e.To == currentID and return e.From.
+//   - Stage 3: Delegate undirected edges to otherEndpoint.
+//
+// Behavior highlights:
+//   - Undirected edges are symmetric, so the canonical law is reused unchanged.
+//
+// Inputs:
+//   - e: the edge whose reversed relation is being resolved.
+//   - currentID: the vertex currently expanded by a backward search.
+//
+// Returns:
+//   - string: the predecessor-side vertex in the forward orientation.
+//   - bool: true when e can be traversed into currentID.
+//
+// Errors:
+//   - None.
+//
+// Determinism:
+//   - Deterministic for the same edge and currentID.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+//
+// AI-Hints:
+//   - Keep undirected handling delegated to otherEndpoint; only the directed rule mirrors.
+func reverseEndpoint(e *core.Edge, currentID string) (string, bool) {
+	if e == nil {
+		return "", false
+	}
+	if e.Directed {
+		if e.To != currentID {
+			return "", false
+		}
+
+		return e.From, true
+	}
+
+	return otherEndpoint(e, currentID)
+}
+
+// relation returns the edges a runner scans from currentID.
+//
+// Implementation:
+//   - Stage 1: Use the snapshot of the relation view when present.
+//   - Stage 2: Otherwise read the live g.Neighbors surface.
+//
+// Inputs:
+//   - currentID: the finalized vertex being expanded.
+//
+// Returns:
+//   - []*core.Edge: edges in Edge.ID order.
+//   - error: graph-surface error from g.Neighbors.
+//
+// Errors:
+//   - Any error returned by g.Neighbors.
+//
+// Determinism:
+//   - Snapshots are built in core.Edges() order, matching g.Neighbors order.
+//
+// Complexity:
+//   - O(1) for snapshots; otherwise the g.Neighbors cost.
+//
+// AI-Hints:
+//   - Reverse views must always use a snapshot; core exposes outgoing relations only.
+func (r *runner) relation(currentID string) ([]*core.Edge, error) {
+	if r.relations.edges != nil {
+		return r.relations.edges[currentID], nil
+	}
+
+	return r.graph.Neighbors(currentID)
+}
+
+// endpoint resolves the neighbor across e under the runner orientation.
+//
+// Implementation:
+//   - Stage 1: Dispatch to reverseEndpoint for backward runners.
+//   - Stage 2: Dispatch to otherEndpoint otherwise.
+//
+// Inputs:
+//   - e: the scanned edge.
+//   - currentID: the finalized vertex being expanded.
+//
+// Returns:
+//   - string: the resolved neighbor.
+//   - bool: true when e is traversable from currentID in this orientation.
+//
+// Errors:
+//   - None.
+//
+// Determinism:
+//   - Deterministic.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (r *runner) endpoint(e *core.Edge, currentID string) (string, bool) {
+	if r.relations.reverse {
+		return reverseEndpoint(e, currentID)
+	}
+
+	return otherEndpoint(e, currentID)
+}
+
+// edgeCost resolves the traversal cost of edge under the EdgeFilter and
+// EdgeCost policy.
+//
+// Returns:
+//   - float64: EdgeCost(edge) when configured, otherwise edge.Weight.
+//   - bool: false when the edge is filtered out or priced as unusable.
+//
+// Complexity:
+//   - Time O(1) plus callback cost, Space O(1).
+//
+// AI-Hints:
+//   - The returned cost is unclassified; callers must still apply classifyWeight.
+func edgeCost(config Options, edge *core.Edge) (float64, bool) {
+	if config.EdgeFilter != nil && !config.EdgeFilter(*edge) {
+		return 0, false
+	}
+	if config.EdgeCost != nil {
+		return config.EdgeCost(*edge)
+	}
+
+	return edge.Weight, true
+}
@@ -0,0 +1,394 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// newDynamic builds the edge snapshot and the incoming relation index of a
+// Dynamic over an already validated graph and detached result.
+//
+// Implementation:
+//   - Stage 1: Record every edge by ID.
+//   - Stage 2: Index every edge under each vertex it can be traversed into.
+//
+// Complexity:
+//   - Time O(E log E) for the sorted core.Edges() surface, Space O(E).
+func newDynamic(g *core.Graph, result *Result, config Options) *Dynamic {
+	d := &Dynamic{
+		graph:    g,
+		options:  config,
+		result:   result,
+		edges:    make(map[string]*core.Edge, g.EdgeCount()),
+		incoming: make(map[string]map[string]*core.Edge, g.VertexCount()),
+	}
+	for _, edge := range g.Edges() {
+		d.link(edge)
+	}
+
+	return d
+}
+
+// Apply repairs distances and predecessors after a batch of edge mutations
+// that the caller has already performed on the graph.
+//
+// Implementation:
+//   - Stage 1: Validate the whole batch against the graph and the snapshot;
+//     nothing is modified on failure.
+//   - Stage 2: Admit endpoints of inserted edges that are new vertices.
+//   - Stage 3: Swap snapshot edges and collect invalidated tree edges and
+//     the tails of new or cheaper edges.
+//   - Stage 4: Reset the subtrees below invalidated tree edges to +Inf.
+//   - Stage 5: Seed every intact tail that reaches a reset vertex or owns a
+//     new edge at its current distance and resume the Dijkstra kernel.
+//
+// Behavior highlights:
+//   - A tree edge whose relation is unchanged and whose effective cost did not
+//     grow keeps its subtree; only cheaper alternatives are propagated.
+//   - Non-tree deletions and increases cost nothing beyond validation.
+//   - The resulting Distances equal a fresh Dijkstra run under the same options.
+//
+// Inputs:
+//   - changes: the mutations, one entry per distinct edge ID.
+//
+// Returns:
+//   - error: nil on success.
+//
+// Errors:
+//   - ErrNilResult if the receiver is nil.
+//   - ErrBadEdgeChange for unknown ops, empty or repeated IDs, or a deleted edge
+//     that is still present in the graph.
+//   - ErrEdgeNotFound if an edge is missing from the graph or the snapshot.
+//   - ErrVertexSetChanged if vertices appeared other than endpoints of inserted
+//     edges, or disappeared.
+//   - ErrInvalidWeight or ErrNegativeWeight, wrapped with edge context, for a
+//     malformed inserted or updated edge.
+//   - ErrDistanceOverflow or weight errors from relaxation; these poison the state.
+//
+// Determinism:
+//   - Deterministic for the same state and batch; batch order is irrelevant.
+//
+// Complexity:
+//   - O(V) to rebuild the child index when a tree edge is invalidated, plus
+//     O((A + E_A) log V) for the A repaired vertices and their E_A incident edges.
+//
+// AI-Hints:
+//   - A failed validation leaves the state usable; a failed relaxation does not.
+func (d *Dynamic) Apply(changes ...EdgeChange) error {
+	if d == nil {
+		return ErrNilResult
+	}
+	if d.err != nil {
+		return d.err
+	}
+
+	current, added, err := d.validateBatch(changes)
+	if err != nil {
+		return err
+	}
+
+	for _, vertexID := range added {
+		d.result.Distances[vertexID] = math.Inf(1)
+		d.result.Prev[vertexID] = ""
+	}
+
+	var roots []string
+	var seeds []*core.Edge
+	for _, change := range changes {
+		previous, next := d.edges[change.EdgeID], current[change.EdgeID]
+		if previous != nil {
+			d.unlink(previous)
+			if !d.keepsTree(previous, next) {
+				roots = append(roots, d.treeHeads(previous)...)
+			}
+		}
+		if next != nil {
+			d.link(next)
+			seeds = append(seeds, next)
+		}
+	}
+
+	if err = d.repair(d.invalidate(roots), seeds); err != nil {
+		d.err = err
+
+		return err
+	}
+
+	return nil
+}
+
+// validateBatch checks every change before any state is touched.
+//
+// Returns:
+//   - map[string]*core.Edge: the current graph edge of every insert and update.
+//   - []string: endpoints of inserted or updated edges unknown to the result.
+//   - error: the first violation in batch order.
+//
+// Complexity:
+//   - Time O(B) for a batch of B changes, Space O(B).
+func (d *Dynamic) validateBatch(changes []EdgeChange) (map[string]*core.Edge, []string, error) {
+	current := make(map[string]*core.Edge, len(changes))
+	seen := make(map[string]bool, len(changes))
+	var added []string
+	admitted := make(map[string]bool)
+
+	for index, change := range changes {
+		if change.EdgeID == "" {
+			return nil, nil, fmt.Errorf("%w: empty edge id at index %d", ErrBadEdgeChange, index)
+		}
+		if seen[change.EdgeID] {
+			return nil, nil, fmt.Errorf("%w: edge %q listed twice", ErrBadEdgeChange, change.EdgeID)
+		}
+		seen[change.EdgeID] = true
+
+		_, known := d.edges[change.EdgeID]
+		edge, lookupErr := d.graph.GetEdge(change.EdgeID)
+		switch change.Op {
+		case ChangeInsert:
+			if known {
+				return nil, nil, fmt.Errorf("%w: inserted edge %q is already known", ErrBadEdgeChange, change.EdgeID)
+			}
+		case ChangeDelete:
+			if !known {
+				return nil, nil, fmt.Errorf("%w: deleted edge %q", ErrEdgeNotFound, change.EdgeID)
+			}
+			if lookupErr == nil {
+				return nil, nil, fmt.Errorf("%w: deleted edge %q is still present", ErrBadEdgeChange, change.EdgeID)
+			}
+			continue
+		case ChangeUpdate:
+			if !known {
+				return nil, nil, fmt.Errorf("%w: updated edge %q", ErrEdgeNotFound, change.EdgeID)
+			}
+		default:
+			return nil, nil, fmt.Errorf("%w: unknown op %d for edge %q", ErrBadEdgeChange, change.Op, change.EdgeID)
+		}
+
+		if lookupErr != nil {
+			return nil, nil, fmt.Errorf("%w: edge %q is absent from the graph", ErrEdgeNotFound, change.EdgeID)
+		}
+		if err := classifyWeight(edge.Weight); err != nil {
+			return nil, nil, fmt.Errorf(
+				"%w: edge_id=%q from=%q to=%q directed=%t weight=%g",
+				err,
+				edge.ID,
+				edge.From,
+				edge.To,
+				edge.Directed,
+				edge.Weight,
+			)
+		}
+		current[change.EdgeID] = edge
+
+		for _, vertexID := range [2]string{edge.From, edge.To} {
+			if _, ok := d.result.Distances[vertexID]; !ok && !admitted[vertexID] {
+				admitted[vertexID] = true
+				added = append(added, vertexID)
+			}
+		}
+	}
+
+	if d.graph.VertexCount() != len(d.result.Distances)+len(added) {
+		return nil, nil, fmt.Errorf("%w: tree has %d vertices, graph has %d",
+			ErrVertexSetChanged, len(d.result.Distances)+len(added), d.graph.VertexCount())
+	}
+
+	return current, added, nil
+}
+
+// link records edge in the snapshot and indexes it under every vertex it can
+// be traversed into.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (d *Dynamic) link(edge *core.Edge) {
+	d.edges[edge.ID] = edge
+	d.index(edge.To, edge)
+	if !edge.Directed {
+		d.index(edge.From, edge)
+	}
+}
+
+// index adds edge to the incoming relation of vertexID.
+func (d *Dynamic) index(vertexID string, edge *core.Edge) {
+	relation := d.incoming[vertexID]
+	if relation == nil {
+		relation = make(map[string]*core.Edge)
+		d.incoming[vertexID] = relation
+	}
+	relation[edge.ID] = edge
+}
+
+// unlink removes edge from the snapshot and the incoming relation index.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (d *Dynamic) unlink(edge *core.Edge) {
+	delete(d.edges, edge.ID)
+	delete(d.incoming[edge.To], edge.ID)
+	delete(d.incoming[edge.From], edge.ID)
+}
+
+// treeHeads returns the vertices whose tree edge is edge.
+//
+// Behavior highlights:
+//   - A directed edge can only be the tree edge of its head; an undirected edge
+//     may serve either endpoint.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (d *Dynamic) treeHeads(edge *core.Edge) []string {
+	var heads []string
+	if d.result.PrevEdge[edge.To] == edge.ID {
+		heads = append(heads, edge.To)
+	}
+	if !edge.Directed && edge.From != edge.To && d.result.PrevEdge[edge.From] == edge.ID {
+		heads = append(heads, edge.From)
+	}
+
+	return heads
+}
+
+// keepsTree reports whether next can stand in for previous in the tree without
+// invalidating any distance below it.
+//
+// Behavior highlights:
+//   - True only for the same relation (endpoints and directedness) with an
+//     admitted effective cost that did not grow.
+//
+// Complexity:
+//   - Time O(1) plus the EdgeCost and EdgeFilter callback costs.
+func (d *Dynamic) keepsTree(previous, next *core.Edge) bool {
+	if next == nil ||
+		next.From != previous.From || next.To != previous.To || next.Directed != previous.Directed {
+		return false
+	}
+
+	previousCost, previousOK := d.cost(previous)
+	nextCost, nextOK := d.cost(next)
+
+	return previousOK && nextOK && nextCost <= previousCost
+}
+
+// cost returns the effective traversal cost of edge and whether relax would
+// admit it at all.
+func (d *Dynamic) cost(edge *core.Edge) (float64, bool) {
+	weight, admitted := edgeCost(d.options, edge)
+	if !admitted || classifyWeight(weight) != nil || weight >= d.options.InfEdgeThreshold {
+		return 0, false
+	}
+
+	return weight, true
+}
+
+// invalidate resets every vertex in the predecessor subtrees of roots to the
+// unreached state and returns the reset set.
+//
+// Implementation:
+//   - Stage 1: Build the child index from Prev.
+//   - Stage 2: Walk the subtrees breadth-first, clearing distance and predecessors.
+//
+// Complexity:
+//   - Time O(V) when roots is non-empty, O(1) otherwise; Space O(V).
+func (d *Dynamic) invalidate(roots []string) map[string]bool {
+	affected := make(map[string]bool)
+	if len(roots) == 0 {
+		return affected
+	}
+
+	children := make(map[string][]string)
+	for vertexID, parentID := range d.result.Prev {
+		if parentID != "" {
+			children[parentID] = append(children[parentID], vertexID)
+		}
+	}
+
+	queue := make([]string, 0, len(roots))
+	for _, rootID := range roots {
+		if !affected[rootID] {
+			affected[rootID] = true
+			queue = append(queue, rootID)
+		}
+	}
+	for head := 0; head < len(queue); head++ {
+		vertexID := queue[head]
+		d.result.Distances[vertexID] = math.Inf(1)
+		d.result.Prev[vertexID] = ""
+		delete(d.result.PrevEdge, vertexID)
+
+		for _, childID := range children[vertexID] {
+			if !affected[childID] {
+				affected[childID] = true
+				queue = append(queue, childID)
+			}
+		}
+	}
+
+	return affected
+}
+
+// repair seeds the frontier and resumes the Dijkstra kernel on the stored maps.
+//
+// Implementation:
+//   - Stage 1: Seed every intact vertex with an edge into an affected vertex.
+//   - Stage 2: Seed the tails of inserted and updated edges.
+//   - Stage 3: Run the kernel; intact vertices start unvisited, so strictly
+//     better candidates still overwrite them.
+//
+// Behavior highlights:
+//   - Every seed carries its current distance, an upper bound on the new one;
+//     any vertex whose distance improves is reached from some seed.
+//
+// Errors:
+//   - Any error returned by relax.
+//
+// Complexity:
+//   - O((S + A + E_A) log V) for S seeds and A settled vertices.
+func (d *Dynamic) repair(affected map[string]bool, seeds []*core.Edge) error {
+	r := &runner{
+		graph:        d.graph,
+		sourceID:     d.result.SourceID,
+		options:      d.options,
+		distances:    d.result.Distances,
+		previous:     d.result.Prev,
+		previousEdge: d.result.PrevEdge,
+		visited:      make(map[string]bool),
+		frontier:     make(nodePQ, 0, len(affected)+len(seeds)),
+		algebra:      algebraSum,
+	}
+
+	queued := make(map[string]bool)
+	push := func(vertexID string) {
+		if queued[vertexID] || affected[vertexID] {
+			return
+		}
+		distance := r.distanceOf(vertexID)
+		if math.IsInf(distance, 1) {
+			return
+		}
+		queued[vertexID] = true
+		heap.Push(&r.frontier, &nodeItem{id: vertexID, dist: distance})
+	}
+
+	for vertexID := range affected {
+		for _, edge := range d.incoming[vertexID] {
+			if tailID, ok := reverseEndpoint(edge, vertexID); ok {
+				push(tailID)
+			}
+		}
+	}
+	for _, edge := range seeds {
+		push(edge.From)
+		if !edge.Directed {
+			push(edge.To)
+		}
+	}
+
+	return r.process()
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// runMultiSource executes one Dijkstra run seeded at every source with its
+// offset and derives the nearest-source assignment of every vertex.
+//
+// Implementation:
+//   - Stage 1: Validate graph, sources, offsets, and edge weights.
+//   - Stage 2: Initialize the full vertex domain and seed every source whose
+//     offset lies within MaxDistance, in sorted source order.
+//   - Stage 3: Run the canonical visited-finalization loop.
+//   - Stage 4: Resolve each reached vertex to the root of its predecessor chain.
+//
+// Behavior highlights:
+//   - Equivalent to one run from a virtual source joined to every source s by an
+//     edge of weight offset(s).
+//   - A source reached more cheaply from another source belongs to that source's cell.
+//   - Vertices beyond MaxDistance or unreachable keep +Inf and no nearest source.
+//
+// Inputs:
+//   - g: the weighted graph to traverse.
+//   - offsets: source vertex -> initial distance.
+//   - config: finalized runtime policy (path tracking is always on here).
+//
+// Returns:
+//   - *MultiSourceResult: distances, nearest sources, and predecessors.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrNoSources, ErrEmptySourceID,
+//     ErrSourceNotFound, ErrBadSourceOffset.
+//   - ErrInvalidWeight, ErrNegativeWeight from the weight pre-scan.
+//   - ErrDistanceOverflow from relaxation.
+//
+// Determinism:
+//   - Seeds are pushed in sorted order; the heap and strict-improvement rules
+//     then fix every tie, including ties between sources.
+//
+// Complexity:
+//   - Time O((V + E) log V + S log S), Space O(V).
+//
+// AI-Hints:
+//   - Do not loop single-source runs per source; one seeded run gives the same
+//     distances in a single pass.
+func runMultiSource(g *core.Graph, offsets map[string]float64, config Options) (*MultiSourceResult, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if !g.Weighted() {
+		return nil, ErrUnweightedGraph
+	}
+	if len(offsets) == 0 {
+		return nil, ErrNoSources
+	}
+
+	sourceIDs := make([]string, 0, len(offsets))
+	for sourceID, offset := range offsets {
+		if sourceID == "" {
+			return nil, ErrEmptySourceID
+		}
+		if !g.HasVertex(sourceID) {
+			return nil, fmt.Errorf("%w: source=%q", ErrSourceNotFound, sourceID)
+		}
+		if math.IsNaN(offset) || math.IsInf(offset, 0) || offset < 0 {
+			return nil, fmt.Errorf("%w: source=%q offset=%g", ErrBadSourceOffset, sourceID, offset)
+		}
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+	sort.Strings(sourceIDs)
+
+	if err := validateEdgeWeights(g); err != nil {
+		return nil, err
+	}
+
+	vertexCount := g.VertexCount()
+	runnerState := &runner{
+		graph:     g,
+		options:   config,
+		distances: make(map[string]float64, vertexCount),
+		previous:  make(map[string]string, vertexCount),
+		visited:   make(map[string]bool, vertexCount),
+		frontier:  make(nodePQ, 0, vertexCount),
+		algebra:   algebraSum,
+	}
+	runnerState.initDomain()
+
+	for _, sourceID := range sourceIDs {
+		if offsets[sourceID] > config.MaxDistance {
+			continue
+		}
+		if err := runnerState.seedAt(sourceID, offsets[sourceID]); err != nil {
+			return nil, err
+		}
+	}
+	if err := runnerState.process(); err != nil {
+		return nil, err
+	}
+
+	nearest := make(map[string]string, vertexCount)
+	for vertexID, distance := range runnerState.distances {
+		if math.IsInf(distance, 1) {
+			nearest[vertexID] = ""
+			continue
+		}
+		resolveNearest(vertexID, runnerState.previous, nearest)
+	}
+
+	return &MultiSourceResult{
+		Distances: runnerState.distances,
+		Nearest:   nearest,
+		Prev:      runnerState.previous,
+	}, nil
+}
+
+// resolveNearest assigns the root of vertexID's predecessor chain to nearest,
+// memoizing every vertex on the chain.
+//
+// Complexity:
+//   - Amortized O(1) per vertex across all calls, Space O(chain length).
+func resolveNearest(vertexID string, previous, nearest map[string]string) {
+	var chain []string
+	currentID := vertexID
+	for {
+		if _, known := nearest[currentID]; known {
+			break
+		}
+		chain = append(chain, currentID)
+		if previous[currentID] == "" {
+			nearest[currentID] = currentID
+			break
+		}
+		currentID = previous[currentID]
+	}
+
+	root := nearest[currentID]
+	for _, chainID := range chain {
+		nearest[chainID] = root
+	}
+}
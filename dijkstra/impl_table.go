@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// runTable computes the origin x destination distance table with one
+// target-stopped search per row on a bounded worker pool.
+//
+// Implementation:
+//   - Stage 1: Validate graph, endpoint lists, and edge weights once.
+//   - Stage 2: Freeze the outgoing relation snapshot shared by all rows.
+//   - Stage 3: Feed row indices to Workers goroutines; each fills its own rows.
+//   - Stage 4: Report the first row error in row order.
+//
+// Behavior highlights:
+//   - A row search stops as soon as every distinct destination is finalized.
+//   - Unreachable destinations and destinations beyond MaxDistance are +Inf.
+//   - Duplicate IDs are allowed in either list and yield duplicate rows or columns.
+//
+// Inputs:
+//   - ctx: cancellation context, checked before each row is dispatched.
+//   - g: the weighted graph to traverse.
+//   - rowIDs, colIDs: origins and destinations.
+//   - config: finalized runtime policy.
+//
+// Returns:
+//   - *Table: the distance table and its row and column IDs.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrNoSources, ErrNoTargets.
+//   - ErrEmptySourceID, ErrSourceNotFound, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrInvalidWeight, ErrNegativeWeight from the weight pre-scan.
+//   - ErrDistanceOverflow from relaxation; ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Every cell is written by exactly one worker from a deterministic search,
+//     so the table does not depend on Workers or scheduling.
+//
+// Complexity:
+//   - Time O(R * (V + E) log V / Workers) wall-clock, Space O(R * C + Workers * V + E).
+//
+// AI-Hints:
+//   - Rows are independent; do not share runner state between workers.
+func runTable(ctx context.Context, g *core.Graph, rowIDs, colIDs []string, config Options) (*Table, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if !g.Weighted() {
+		return nil, ErrUnweightedGraph
+	}
+	if len(rowIDs) == 0 {
+		return nil, ErrNoSources
+	}
+	if len(colIDs) == 0 {
+		return nil, ErrNoTargets
+	}
+	for _, rowID := range rowIDs {
+		if err := validateInputs(g, rowID); err != nil {
+			return nil, err
+		}
+	}
+	for _, colID := range colIDs {
+		if colID == "" {
+			return nil, ErrEmptyTargetID
+		}
+		if !g.HasVertex(colID) {
+			return nil, fmt.Errorf("%w: target=%q", ErrTargetNotFound, colID)
+		}
+	}
+	if err := validateEdgeWeights(g); err != nil {
+		return nil, err
+	}
+
+	distances, err := matrix.NewPreparedDense(len(rowIDs), len(colIDs), matrix.WithAllowInfDistances())
+	if err != nil {
+		return nil, err
+	}
+
+	outgoing, _ := buildRelationSnapshots(g)
+	workers := config.Workers
+	if workers == 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(rowIDs) {
+		workers = len(rowIDs)
+	}
+
+	errs := make([]error, len(rowIDs))
+	rows := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range rows {
+				errs[row] = fillTableRow(g, outgoing, distances, rowIDs[row], colIDs, row, config)
+			}
+		}()
+	}
+
+	for row := range rowIDs {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		rows <- row
+	}
+	close(rows)
+	wg.Wait()
+
+	if err != nil {
+		return nil, err
+	}
+	for _, rowErr := range errs {
+		if rowErr != nil {
+			return nil, rowErr
+		}
+	}
+
+	return newTable(rowIDs, colIDs, distances), nil
+}
+
+// fillTableRow runs one search from originID and writes row of distances.
+//
+// Implementation:
+//   - Stage 1: Seed a lazily initialized runner over the shared snapshot.
+//   - Stage 2: Settle vertices until every distinct destination is finalized
+//     or the frontier is exhausted.
+//   - Stage 3: Write finalized distances, +Inf elsewhere.
+//
+// Errors:
+//   - ErrDistanceOverflow from relaxation; matrix errors from Set.
+//
+// Complexity:
+//   - Time O((V + E) log V) worst case, Space O(V).
+func fillTableRow(
+	g *core.Graph,
+	outgoing map[string][]*core.Edge,
+	distances *matrix.Dense,
+	originID string,
+	colIDs []string,
+	row int,
+	config Options,
+) error {
+	rowRunner := &runner{
+		graph:     g,
+		sourceID:  originID,
+		options:   config,
+		relations: relationView{edges: outgoing},
+		distances: make(map[string]float64),
+		visited:   make(map[string]bool),
+		frontier:  make(nodePQ, 0, 1),
+		algebra:   algebraSum,
+	}
+	if err := rowRunner.seed(originID); err != nil {
+		return err
+	}
+
+	pending := make(map[string]bool, len(colIDs))
+	for _, colID := range colIDs {
+		pending[colID] = true
+	}
+	for len(pending) > 0 {
+		settledID, ok, err := rowRunner.settleNext("")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		delete(pending, settledID)
+	}
+
+	for col, colID := range colIDs {
+		distance := math.Inf(1)
+		if rowRunner.visited[colID] {
+			distance = rowRunner.distances[colID]
+		}
+		if err := distances.Set(row, col, distance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
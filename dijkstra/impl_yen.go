@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra
+
+import (
+	"context"
+	"strings"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// yenSearch holds the frozen inputs shared by all spur searches of one Yen run.
+//
+// Implementation:
+//   - Stage 1: Freeze the outgoing relation snapshot once.
+//   - Stage 2: Index edges by ID for root-cost accumulation.
+//
+// AI-Hints:
+//   - Spur searches never touch the live graph; the snapshot is the single source of truth.
+type yenSearch struct {
+	graph    *core.Graph
+	targetID string
+	config   Options
+	outgoing map[string][]*core.Edge
+	edges    map[string]*core.Edge
+}
+
+// runYen enumerates up to k shortest loopless paths from sourceID to targetID.
+//
+// Implementation:
+//   - Stage 1: Validate inputs and freeze the relation snapshot.
+//   - Stage 2: Find the shortest path with one spur search from the source.
+//   - Stage 3: For every prefix of the last accepted path, ban the next edge of
+//     every accepted path sharing that prefix and the prefix vertices before the
+//     spur vertex, then search spur vertex -> target.
+//   - Stage 4: Add unseen root+spur candidates to the pool and accept the best.
+//
+// Behavior highlights:
+//   - Paths are distinguished by edge IDs, so parallel edges yield distinct paths.
+//   - Costs are accumulated left to right along each path.
+//   - MaxDistance bounds the total path cost; InfEdgeThreshold applies per edge.
+//
+// Inputs:
+//   - ctx: cancellation context, checked before every spur search.
+//   - g, sourceID, targetID: the query.
+//   - k: the maximum number of paths to return.
+//   - config: finalized runtime policy.
+//
+// Returns:
+//   - []Path: between 1 and k paths in non-decreasing cost order.
+//
+// Errors:
+//   - Any error returned by validateInputs or validateEdgeWeights.
+//   - ErrEmptyTargetID, ErrTargetNotFound, ErrBadK.
+//   - ErrNoPath if the target is unreachable under the effective policy.
+//   - ErrDistanceOverflow from relaxation; ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Candidates are ranked by (cost, hop count, vertex IDs, edge IDs).
+//
+// Complexity:
+//   - Time O(k * L * (V + E) log V), where L is the longest accepted path;
+//     Space O(k * L + V + E).
+//
+// AI-Hints:
+//   - Do not ban edges from candidates in the pool; Yen bans from accepted paths only.
+func runYen(ctx context.Context, g *core.Graph, sourceID, targetID string, k int, config Options) ([]Path, error) {
+	if err := validateInputs(g, sourceID); err != nil {
+		return nil, err
+	}
+	if targetID == "" {
+		return nil, ErrEmptyTargetID
+	}
+	if !g.HasVertex(targetID) {
+		return nil, ErrTargetNotFound
+	}
+	if k < 1 {
+		return nil, ErrBadK
+	}
+	if err := validateEdgeWeights(g); err != nil {
+		return nil, err
+	}
+	if sourceID == targetID {
+		return []Path{{VertexIDs: []string{sourceID}, EdgeIDs: []string{}, Cost: 0}}, nil
+	}
+
+	search := &yenSearch{
+		graph:    g,
+		targetID: targetID,
+		config:   config,
+		edges:    make(map[string]*core.Edge),
+	}
+	search.outgoing, _ = buildRelationSnapshots(g)
+	for _, edge := range g.Edges() {
+		search.edges[edge.ID] = edge
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	first, found, err := search.spur(sourceID, config.MaxDistance, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNoPath
+	}
+
+	accepted := []Path{search.complete(first)}
+	seen := map[string]bool{pathKey(accepted[0]): true}
+	var pool []Path
+
+	for len(accepted) < k {
+		last := accepted[len(accepted)-1]
+		rootCost := 0.0
+
+		for spurIndex := 0; spurIndex < len(last.VertexIDs)-1; spurIndex++ {
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+			if spurIndex > 0 {
+				rootCost += search.cost(last.EdgeIDs[spurIndex-1])
+			}
+			if rootCost > config.MaxDistance {
+				break
+			}
+
+			bannedEdges := make(map[string]bool)
+			for _, path := range accepted {
+				if len(path.EdgeIDs) > spurIndex && sameIDs(path.EdgeIDs[:spurIndex], last.EdgeIDs[:spurIndex]) {
+					bannedEdges[path.EdgeIDs[spurIndex]] = true
+				}
+			}
+			bannedVertices := make(map[string]bool, spurIndex)
+			for _, vertexID := range last.VertexIDs[:spurIndex] {
+				bannedVertices[vertexID] = true
+			}
+
+			var spurPath Path
+			spurPath, found, err = search.spur(last.VertexIDs[spurIndex], config.MaxDistance-rootCost, bannedEdges, bannedVertices)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+
+			candidate := search.complete(Path{
+				VertexIDs: append(append([]string{}, last.VertexIDs[:spurIndex]...), spurPath.VertexIDs...),
+				EdgeIDs:   append(append([]string{}, last.EdgeIDs[:spurIndex]...), spurPath.EdgeIDs...),
+			})
+			if candidate.Cost > config.MaxDistance {
+				continue
+			}
+
+			key := pathKey(candidate)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			pool = append(pool, candidate)
+		}
+
+		if len(pool) == 0 {
+			break
+		}
+
+		best := 0
+		for index := 1; index < len(pool); index++ {
+			if lessPath(pool[index], pool[best]) {
+				best = index
+			}
+		}
+		accepted = append(accepted, pool[best])
+		pool = append(pool[:best], pool[best+1:]...)
+	}
+
+	return accepted, nil
+}
+
+// spur runs one target-stopped search from originID over the snapshot,
+// excluding banned edges and vertices, with the given distance budget.
+//
+// Returns:
+//   - Path: vertex and edge IDs from originID to the target (Cost unset).
+//   - bool: false when the target is unreachable.
+//
+// Errors:
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrDistanceOverflow from relaxation.
+//
+// Complexity:
+//   - Time O((V + E) log V), Space O(V).
+func (s *yenSearch) spur(
+	originID string,
+	budget float64,
+	bannedEdges, bannedVertices map[string]bool,
+) (Path, bool, error) {
+	config := s.config
+	config.MaxDistance = budget
+
+	spurRunner := &runner{
+		graph:        s.graph,
+		sourceID:     originID,
+		options:      config,
+		relations:    relationView{edges: s.outgoing},
+		distances:    make(map[string]float64),
+		previous:     make(map[string]string),
+		visited:      make(map[string]bool),
+		frontier:     make(nodePQ, 0, 1),
+		previousEdge: make(map[string]string),
+		admission:    spurExclusions{edges: bannedEdges, vertices: bannedVertices},
+		algebra:      algebraSum,
+	}
+	if err := spurRunner.seed(originID); err != nil {
+		return Path{}, false, err
+	}
+	if err := spurRunner.processTo(s.targetID); err != nil {
+		return Path{}, false, err
+	}
+	if !spurRunner.visited[s.targetID] {
+		return Path{}, false, nil
+	}
+
+	var vertexIDs, edgeIDs []string
+	for currentID := s.targetID; currentID != originID; currentID = spurRunner.previous[currentID] {
+		vertexIDs = append(vertexIDs, currentID)
+		edgeIDs = append(edgeIDs, spurRunner.previousEdge[currentID])
+	}
+	vertexIDs = append(vertexIDs, originID)
+	reverseIDs(vertexIDs)
+	reverseIDs(edgeIDs)
+
+	return Path{VertexIDs: vertexIDs, EdgeIDs: edgeIDs}, true, nil
+}
+
+// spurExclusions is the admission hook of a spur search: it refuses the banned
+// root-path vertices and the banned next edges of accepted paths.
+//
+// Behavior highlights:
+//   - Nil maps exclude nothing.
+//   - Exclusions apply before any weight inspection; candidates are never gated.
+type spurExclusions struct {
+	edges    map[string]bool
+	vertices map[string]bool
+}
+
+func (x spurExclusions) admitEdge(edge *core.Edge, neighborID string) bool {
+	return !x.edges[edge.ID] && !x.vertices[neighborID]
+}
+
+func (spurExclusions) admitCandidate(string, float64) (bool, error) { return true, nil }
+
+// complete accumulates the cost of path left to right and returns it.
+//
+// Complexity:
+//   - Time O(L), Space O(1).
+func (s *yenSearch) complete(path Path) Path {
+	path.Cost = 0
+	for _, edgeID := range path.EdgeIDs {
+		path.Cost += s.cost(edgeID)
+	}
+	if path.EdgeIDs == nil {
+		path.EdgeIDs = []string{}
+	}
+
+	return path
+}
+
+// cost returns the traversal cost of an edge already admitted by a spur search.
+func (s *yenSearch) cost(edgeID string) float64 {
+	weight, _ := edgeCost(s.config, s.edges[edgeID])
+
+	return weight
+}
+
+// lessPath orders candidates by cost, hop count, vertex IDs, then edge IDs.
+func lessPath(a, b Path) bool {
+	if a.Cost != b.Cost {
+		return a.Cost < b.Cost
+	}
+	if len(a.EdgeIDs) != len(b.EdgeIDs) {
+		return len(a.EdgeIDs) < len(b.EdgeIDs)
+	}
+	if keyA, keyB := strings.Join(a.VertexIDs, "\x00"), strings.Join(b.VertexIDs, "\x00"); keyA != keyB {
+		return keyA < keyB
+	}
+
+	return pathKey(a) < pathKey(b)
+}
+
+// pathKey identifies a path from a fixed source by its edge ID sequence.
+func pathKey(path Path) string {
+	return strings.Join(path.EdgeIDs, "\x00")
+}
+
+// sameIDs reports whether two ID slices are element-wise equal.
+func sameIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reverseIDs reverses ids in place.
+func reverseIDs(ids []string) {
+	for left, right := 0, len(ids)-1; left < right; left, right = left+1, right-1 {
+		ids[left], ids[right] = ids[right], ids[left]
+	}
+}
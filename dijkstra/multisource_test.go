@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// AI-HINTS (file):
+//   - The oracle is one single-source run per source; the multi-source distance
+//     must equal min over sources of offset + single-source distance.
+//   - Nearest is validated by walking Prev back to it and summing the offset.
+
+// TestMultiSource_MatchesPerSourceMinimum verifies distances, nearest sources, and
+// predecessor chains against per-source Dijkstra runs on random graphs.
+//
+// Implementation:
+//   - Stage 1: Build random directed, undirected, and mixed multigraphs.
+//   - Stage 2: Seed three sources with distinct offsets.
+//   - Stage 3: Compare with the per-source minimum and check each vertex's cell.
+func TestMultiSource_MatchesPerSourceMinimum(t *testing.T) {
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 3; seed++ {
+			graph := buildRandomMixedGraph(t, seed, 12, 30, mode)
+			vertices := graph.Vertices()
+			offsets := map[string]float64{vertices[0]: 0, vertices[5]: 2, vertices[9]: 1}
+
+			perSource := make(map[string]map[string]float64, len(offsets))
+			for sourceID := range offsets {
+				distances, err := dijkstra.Distances(graph, sourceID)
+				if err != nil {
+					t.Fatalf("Distances(%q) failed: %v", sourceID, err)
+				}
+				perSource[sourceID] = distances
+			}
+
+			res, err := dijkstra.MultiSourceWithOffsets(graph, offsets)
+			if err != nil {
+				t.Fatalf("%s/%d MultiSourceWithOffsets failed: %v", mode, seed, err)
+			}
+
+			for _, vertexID := range vertices {
+				want := math.Inf(1)
+				for sourceID, offset := range offsets {
+					want = math.Min(want, offset+perSource[sourceID][vertexID])
+				}
+				mustEqualFloat64(t, res.Distances[vertexID], want,
+					"%s/%d distance %q: got=%v want=%v", mode, seed, vertexID, res.Distances[vertexID], want)
+
+				nearestID := res.Nearest[vertexID]
+				if math.IsInf(want, 1) {
+					mustEqualString(t, nearestID, "", "%s/%d nearest of unreachable %q", mode, seed, vertexID)
+					continue
+				}
+				viaNearest := offsets[nearestID] + perSource[nearestID][vertexID]
+				mustEqualFloat64(t, viaNearest, want,
+					"%s/%d %q via nearest %q: got=%v want=%v", mode, seed, vertexID, nearestID, viaNearest, want)
+
+				path, err := res.PathTo(vertexID)
+				if err != nil {
+					t.Fatalf("%s/%d PathTo(%q) failed: %v", mode, seed, vertexID, err)
+				}
+				mustEqualString(t, path[0], nearestID, "%s/%d path start for %q", mode, seed, vertexID)
+				mustEqualString(t, path[len(path)-1], vertexID, "%s/%d path end", mode, seed)
+			}
+
+			cellTotal := 0
+			for _, members := range res.Cells() {
+				cellTotal += len(members)
+			}
+			reached := 0
+			for _, distance := range res.Distances {
+				if !math.IsInf(distance, 1) {
+					reached++
+				}
+			}
+			mustEqualInt(t, cellTotal, reached, "%s/%d cells cover reached vertices", mode, seed)
+		}
+	}
+}
+
+// TestMultiSource_PartitionAndPolicy verifies a hand-checked Voronoi split, offset
+// takeover of a source, the MaxDistance cutoff, and input sentinels.
+//
+// Implementation:
+//   - Stage 1: On the path A-B-C-D-E with unit weights, sources A and E split at C.
+//   - Stage 2: A large offset on E hands its whole cell, E included, to A.
+//   - Stage 3: WithMaxDistance(1) leaves C unreached.
+//   - Stage 4: Assert validation sentinels.
+func TestMultiSource_PartitionAndPolicy(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	for _, pair := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "E"}} {
+		mustAddEdge(t, graph, pair[0], pair[1], 1)
+	}
+
+	res, err := dijkstra.MultiSource(graph, []string{"E", "A", "E"})
+	if err != nil {
+		t.Fatalf("MultiSource failed: %v", err)
+	}
+	cells := res.Cells()
+	mustEqualInt(t, len(cells["A"])+len(cells["E"]), 5, "cell coverage")
+	mustEqualString(t, res.Nearest["B"], "A", "nearest of B")
+	mustEqualString(t, res.Nearest["D"], "E", "nearest of D")
+	mustEqualFloat64(t, res.Distances["C"], 2, "distance of C: got=%v want=2", res.Distances["C"])
+
+	res, err = dijkstra.MultiSourceWithOffsets(graph, map[string]float64{"A": 0, "E": 5})
+	if err != nil {
+		t.Fatalf("MultiSourceWithOffsets failed: %v", err)
+	}
+	mustEqualString(t, res.Nearest["E"], "A", "offset takeover of E")
+	mustEqualInt(t, len(res.Cells()["A"]), 5, "takeover cell size")
+
+	res, err = dijkstra.MultiSource(graph, []string{"A", "E"}, dijkstra.WithMaxDistance(1))
+	if err != nil {
+		t.Fatalf("MultiSource(max) failed: %v", err)
+	}
+	mustEqualString(t, res.Nearest["C"], "", "cut-off vertex has no nearest source")
+	_, err = res.PathTo("C")
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+
+	_, err = dijkstra.MultiSource(graph, nil)
+	mustErrorIs(t, err, dijkstra.ErrNoSources)
+	_, err = dijkstra.MultiSource(graph, []string{""})
+	mustErrorIs(t, err, dijkstra.ErrEmptySourceID)
+	_, err = dijkstra.MultiSource(graph, []string{"Q"})
+	mustErrorIs(t, err, dijkstra.ErrSourceNotFound)
+	_, err = dijkstra.MultiSourceWithOffsets(graph, map[string]float64{"A": -1})
+	mustErrorIs(t, err, dijkstra.ErrBadSourceOffset)
+	_, err = dijkstra.MultiSourceWithOffsets(graph, map[string]float64{"A": math.Inf(1)})
+	mustErrorIs(t, err, dijkstra.ErrBadSourceOffset)
+	_, err = dijkstra.MultiSource(nil, []string{"A"})
+	mustErrorIs(t, err, dijkstra.ErrNilGraph)
+}
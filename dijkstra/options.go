@@ -3,7 +3,11 @@
 
 package dijkstra
 
-import "math"
+import (
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
 
 // Options defines the explicit runtime policy for a single Dijkstra execution.
 // The structure contains only contract-changing options that affect path tracking,
@@ -23,6 +27,10 @@ import "math"
 //   - TrackPaths: enables predecessor tracking for later path reconstruction.
 //   - MaxDistance: limits exploration to shortest paths whose distance does not exceed this bound.
 //   - InfEdgeThreshold: treats edges with weight greater than or equal to this threshold as impassable.
+//   - CheckHeuristic: enables runtime consistency/admissibility checks for AStar heuristics.
+//   - Workers: bounds the goroutines of many-to-many tables; 0 means runtime.GOMAXPROCS(0).
+//   - EdgeCost: optional traversal price replacing Edge.Weight; nil means Edge.Weight.
+//   - EdgeFilter, VertexFilter: optional admission predicates; nil admits everything.
 //
 // Returns:
 //   - Options: a detached value object consumed by the API and kernel.
@@ -34,7 +42,7 @@ import "math"
 //   - The structure itself is deterministic value configuration and does not introduce hidden ordering.
 //
 // Complexity:
-//   - Copy and return cost is O(1); the structure contains only scalar fields.
+//   - Copy and return cost is O(1); the structure holds scalars and function values.
 //
 // Notes:
 //   - sourceID is intentionally not stored here and must be passed explicitly to the public API.
@@ -47,6 +55,11 @@ type Options struct {
 	TrackPaths       bool
 	MaxDistance      float64
 	InfEdgeThreshold float64
+	CheckHeuristic   bool
+	Workers          int
+	EdgeCost         func(edge core.Edge) (float64, bool)
+	EdgeFilter       func(edge core.Edge) bool
+	VertexFilter     func(vertexID string) bool
 }
 
 // Option applies a single configuration mutation to Options and may reject
@@ -99,6 +112,8 @@ type Option func(*Options) error
 //   - Path tracking is disabled by default.
 //   - MaxDistance defaults to +Inf, which preserves full reachable exploration.
 //   - InfEdgeThreshold defaults to +Inf, which preserves all finite edges.
+//   - Heuristic checking is disabled by default.
+//   - Workers defaults to 0, resolved to runtime.GOMAXPROCS(0) at run time.
 //
 // Inputs:
 //   - None.
@@ -125,6 +140,8 @@ func DefaultOptions() Options {
 		TrackPaths:       false,
 		MaxDistance:      math.Inf(1),
 		InfEdgeThreshold: math.Inf(1),
+		CheckHeuristic:   false,
+		Workers:          0,
 	}
 }
 
@@ -279,6 +296,208 @@ func WithInfEdgeThreshold(threshold float64) Option {
 	}
 }
 
+// WithHeuristicCheck enables the AStar debug mode that verifies the caller
+// heuristic while the search runs.
+//
+// Implementation:
+//   - Stage 1: Mark CheckHeuristic as enabled.
+//
+// Behavior highlights:
+//   - AStar rejects h(target) != 0 before traversal begins.
+//   - Every relaxed edge u->v is checked for consistency: h(u) <= w(u,v) + h(v).
+//   - The returned witness is checked for admissibility: h(v) never exceeds the
+//     remaining witness distance from v to the target.
+//   - Comparisons allow a small relative tolerance for floating-point rounding.
+//
+// Inputs:
+//   - None.
+//
+// Returns:
+//   - Option: a functional option that enables heuristic verification.
+//
+// Errors:
+//   - None at construction time; AStar reports ErrInadmissibleHeuristic.
+//
+// Determinism:
+//   - Always enables the same field in the same way.
+//
+// Complexity:
+//   - Time O(1), Space O(1) for the option itself.
+//   - Adds O(1) work per relaxed edge and O(k) work for a k-vertex witness.
+//
+// Notes:
+//   - Single-source APIs such as Dijkstra ignore this flag because they do not
+//     consume a heuristic.
+//   - Intended for tests and debugging; production code may leave it disabled once
+//     the heuristic is trusted.
+//
+// AI-Hints:
+//   - Do not treat a passing check as a proof for other targets; only the explored
+//     region and the returned witness are verified.
+func WithHeuristicCheck() Option {
+	return func(opts *Options) error {
+		opts.CheckHeuristic = true
+		return nil
+	}
+}
+
+// WithWorkers sets the number of goroutines DistanceTable uses to compute rows.
+//
+// Implementation:
+//   - Stage 1: Reject non-positive worker counts.
+//   - Stage 2: Store the count in Workers.
+//
+// Behavior highlights:
+//   - Each worker owns whole rows, so the published table does not depend on the count.
+//   - Single-run APIs ignore this field.
+//
+// Inputs:
+//   - workers: the goroutine count; must be >= 1.
+//
+// Returns:
+//   - Option: a functional option that configures table parallelism.
+//
+// Errors:
+//   - ErrBadWorkers if workers < 1.
+//
+// Determinism:
+//   - Results are identical for every valid worker count.
+//
+// Complexity:
+//   - Time O(1), Space O(1) for the option itself.
+//
+// AI-Hints:
+//   - Omit this option to use runtime.GOMAXPROCS(0); WithWorkers(1) gives a
+//     sequential run that is easiest to profile.
+func WithWorkers(workers int) Option {
+	return func(opts *Options) error {
+		if workers < 1 {
+			return ErrBadWorkers
+		}
+		opts.Workers = workers
+
+		return nil
+	}
+}
+
+// WithEdgeCost prices every traversed edge with a caller callback instead of
+// Edge.Weight, so costs can change per query without cloning the graph.
+//
+// Implementation:
+//   - Stage 1: Reject a nil callback.
+//   - Stage 2: Store the callback in EdgeCost.
+//
+// Behavior highlights:
+//   - The callback receives a copy of the edge; returning ok == false excludes it.
+//   - Returned costs pass the same numeric law as stored weights at relaxation
+//     time: NaN and ±Inf fail with ErrInvalidWeight, negatives with ErrNegativeWeight.
+//   - InfEdgeThreshold and MaxDistance apply to the returned cost.
+//   - Stored weights are still pre-scanned; the graph itself must stay valid input.
+//
+// Inputs:
+//   - cost: returns the traversal cost of an edge and whether it may be used.
+//
+// Returns:
+//   - Option: a functional option that installs the cost callback.
+//
+// Errors:
+//   - ErrNilEdgeCost if cost is nil.
+//
+// Determinism:
+//   - Results are deterministic when cost is pure for the duration of one call.
+//
+// Complexity:
+//   - Time O(1), Space O(1) for the option itself; one callback per relaxed edge.
+//
+// Notes:
+//   - Path costs reported by KShortestPaths and distances of every API use the callback.
+//   - DistanceTable invokes the callback from several goroutines; it must be safe
+//     for concurrent use.
+//
+// AI-Hints:
+//   - Typical uses: time-of-day penalties, per-vehicle tolls, or converting
+//     distance to travel time from a speed lookup keyed by Edge.ID.
+func WithEdgeCost(cost func(edge core.Edge) (float64, bool)) Option {
+	return func(opts *Options) error {
+		if cost == nil {
+			return ErrNilEdgeCost
+		}
+		opts.EdgeCost = cost
+
+		return nil
+	}
+}
+
+// WithEdgeFilter admits only edges for which keep returns true.
+//
+// Implementation:
+//   - Stage 1: Reject a nil predicate.
+//   - Stage 2: Store the predicate in EdgeFilter.
+//
+// Behavior highlights:
+//   - Filtered edges are skipped before pricing; EdgeCost never sees them.
+//
+// Inputs:
+//   - keep: edge admission predicate.
+//
+// Returns:
+//   - Option: a functional option that installs the edge filter.
+//
+// Errors:
+//   - ErrNilFilter if keep is nil.
+//
+// Complexity:
+//   - Time O(1), Space O(1) for the option itself; one call per relaxed edge.
+//
+// AI-Hints:
+//   - Use this to exclude failed links; unlike InfEdgeThreshold it does not depend on weight.
+func WithEdgeFilter(keep func(edge core.Edge) bool) Option {
+	return func(opts *Options) error {
+		if keep == nil {
+			return ErrNilFilter
+		}
+		opts.EdgeFilter = keep
+
+		return nil
+	}
+}
+
+// WithVertexFilter admits only vertices for which keep returns true.
+//
+// Implementation:
+//   - Stage 1: Reject a nil predicate.
+//   - Stage 2: Store the predicate in VertexFilter.
+//
+// Behavior highlights:
+//   - A rejected vertex is never entered, so it keeps +Inf and no path crosses it.
+//   - Query origins (sources, and the target of a bidirectional backward search)
+//     are seeded regardless; a rejected target is reported unreachable.
+//
+// Inputs:
+//   - keep: vertex admission predicate.
+//
+// Returns:
+//   - Option: a functional option that installs the vertex filter.
+//
+// Errors:
+//   - ErrNilFilter if keep is nil.
+//
+// Complexity:
+//   - Time O(1), Space O(1) for the option itself; one call per relaxed edge.
+//
+// AI-Hints:
+//   - Prefer this over deleting vertices from a shared graph.
+func WithVertexFilter(keep func(vertexID string) bool) Option {
+	return func(opts *Options) error {
+		if keep == nil {
+			return ErrNilFilter
+		}
+		opts.VertexFilter = keep
+
+		return nil
+	}
+}
+
 // applyOptions builds the finalized Dijkstra configuration from the canonical
 // defaults and the provided functional options.
 // The assembler validates both option-returned errors and the complete state
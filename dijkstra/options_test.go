@@ -804,3 +804,33 @@ func TestWithPathTracking(t *testing.T) {
 		t.Fatalf("InfEdgeThreshold changed: got=%v want=+Inf", config.InfEdgeThreshold)
 	}
 }
+
+// TestWithCallbacks_RejectNil verifies that callback options reject nil functions
+// and store non-nil ones.
+//
+// Implementation:
+//   - Stage 1: Apply each constructor with nil and assert its sentinel.
+//   - Stage 2: Apply each constructor with a function and assert the field is set.
+//
+// AI-Hints:
+//   - Keep ErrNilEdgeCost and ErrNilFilter distinct from ErrNilOption.
+func TestWithCallbacks_RejectNil(t *testing.T) {
+	config := dijkstra.DefaultOptions()
+
+	mustErrorIs(t, dijkstra.WithEdgeCost(nil)(&config), dijkstra.ErrNilEdgeCost)
+	mustErrorIs(t, dijkstra.WithEdgeFilter(nil)(&config), dijkstra.ErrNilFilter)
+	mustErrorIs(t, dijkstra.WithVertexFilter(nil)(&config), dijkstra.ErrNilFilter)
+	mustErrorIs(t, dijkstra.WithWorkers(0)(&config), dijkstra.ErrBadWorkers)
+
+	if err := dijkstra.WithEdgeCost(func(edge core.Edge) (float64, bool) { return edge.Weight, true })(&config); err != nil {
+		t.Fatalf("WithEdgeCost failed: %v", err)
+	}
+	if err := dijkstra.WithEdgeFilter(func(core.Edge) bool { return true })(&config); err != nil {
+		t.Fatalf("WithEdgeFilter failed: %v", err)
+	}
+	if err := dijkstra.WithVertexFilter(func(string) bool { return true })(&config); err != nil {
+		t.Fatalf("WithVertexFilter failed: %v", err)
+	}
+	mustEqualBool(t, config.EdgeCost != nil && config.EdgeFilter != nil && config.VertexFilter != nil, true,
+		"callbacks stored")
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+	"github.com/katalvlaran/lvlath/tsp"
+)
+
+// AI-HINTS (file):
+//   - The oracle is one Distances call per origin; every cell must match it for
+//     every worker count.
+//   - The TSP round-trip proves the table shape is accepted by tsp.SolveMatrix as-is.
+
+// TestDistanceTable_MatchesDistances verifies every table cell against
+// single-source runs for several worker counts.
+//
+// Implementation:
+//   - Stage 1: Build random directed, undirected, and mixed multigraphs.
+//   - Stage 2: Compute a 5 x 7 table (duplicate column included) for Workers 1, 3, 16.
+//   - Stage 3: Compare each cell with Distances from its origin.
+func TestDistanceTable_MatchesDistances(t *testing.T) {
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 3; seed++ {
+			graph := buildRandomMixedGraph(t, seed, 12, 30, mode)
+			vertices := graph.Vertices()
+			rowIDs := vertices[:5]
+			colIDs := append([]string{vertices[4]}, vertices[6:]...)
+
+			for _, workers := range []int{1, 3, 16} {
+				table, err := dijkstra.DistanceTable(graph, rowIDs, colIDs, dijkstra.WithWorkers(workers))
+				if err != nil {
+					t.Fatalf("%s/%d workers=%d: %v", mode, seed, workers, err)
+				}
+				mustEqualInt(t, table.Distances.Rows(), len(rowIDs), "row count")
+				mustEqualInt(t, table.Distances.Cols(), len(colIDs), "column count")
+
+				for row, rowID := range rowIDs {
+					want, err := dijkstra.Distances(graph, rowID)
+					if err != nil {
+						t.Fatalf("Distances(%q) failed: %v", rowID, err)
+					}
+					for col, colID := range colIDs {
+						got, _ := table.Distances.At(row, col)
+						mustEqualFloat64(t, got, want[colID],
+							"%s/%d workers=%d %s->%s: got=%v want=%v", mode, seed, workers, rowID, colID, got, want[colID])
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestDistanceTable_TSPAndPolicy verifies the tsp.SolveMatrix hand-off, the
+// MaxDistance cutoff, and input sentinels.
+//
+// Implementation:
+//   - Stage 1: Square table over a weighted 4-cycle feeds tsp.SolveMatrix.
+//   - Stage 2: WithMaxDistance turns far pairs into +Inf.
+//   - Stage 3: Assert validation and cancellation sentinels.
+func TestDistanceTable_TSPAndPolicy(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	mustAddEdge(t, graph, "A", "B", 1)
+	mustAddEdge(t, graph, "B", "C", 2)
+	mustAddEdge(t, graph, "C", "D", 1)
+	mustAddEdge(t, graph, "D", "A", 2)
+
+	ids := graph.Vertices()
+	table, err := dijkstra.DistanceTable(graph, ids, ids)
+	if err != nil {
+		t.Fatalf("DistanceTable failed: %v", err)
+	}
+	tour, err := tsp.SolveMatrix(table.Distances, table.RowIDs, tsp.DefaultOptions())
+	if err != nil {
+		t.Fatalf("SolveMatrix failed: %v", err)
+	}
+	mustEqualFloat64(t, tour.Cost, 6, "tour cost: got=%v want=6", tour.Cost)
+
+	distance, err := table.DistanceTo("A", "C")
+	if err != nil {
+		t.Fatalf("DistanceTo failed: %v", err)
+	}
+	mustEqualFloat64(t, distance, 3, "A->C: got=%v want=3", distance)
+	_, err = table.DistanceTo("Q", "C")
+	mustErrorIs(t, err, dijkstra.ErrSourceNotFound)
+	_, err = table.DistanceTo("A", "Q")
+	mustErrorIs(t, err, dijkstra.ErrTargetNotFound)
+
+	table, err = dijkstra.DistanceTable(graph, []string{"A"}, []string{"B", "C"}, dijkstra.WithMaxDistance(2))
+	if err != nil {
+		t.Fatalf("DistanceTable(max) failed: %v", err)
+	}
+	far, _ := table.Distances.At(0, 1)
+	mustEqualBool(t, math.IsInf(far, 1), true, "cut-off pair is +Inf")
+
+	_, err = dijkstra.DistanceTable(graph, nil, ids)
+	mustErrorIs(t, err, dijkstra.ErrNoSources)
+	_, err = dijkstra.DistanceTable(graph, ids, nil)
+	mustErrorIs(t, err, dijkstra.ErrNoTargets)
+	_, err = dijkstra.DistanceTable(graph, []string{"Q"}, ids)
+	mustErrorIs(t, err, dijkstra.ErrSourceNotFound)
+	_, err = dijkstra.DistanceTable(graph, ids, []string{"Q"})
+	mustErrorIs(t, err, dijkstra.ErrTargetNotFound)
+	_, err = dijkstra.DistanceTable(graph, ids, ids, dijkstra.WithWorkers(0))
+	mustErrorIs(t, err, dijkstra.ErrBadWorkers)
+	_, err = dijkstra.DistanceTable(nil, ids, ids)
+	mustErrorIs(t, err, dijkstra.ErrNilGraph)
+	//nolint:staticcheck // nil context is the case under test
+	_, err = dijkstra.DistanceTableContext(nil, graph, ids, ids)
+	mustErrorIs(t, err, dijkstra.ErrNilContext)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = dijkstra.DistanceTableContext(ctx, graph, ids, ids)
+	mustErrorIs(t, err, context.Canceled)
+}
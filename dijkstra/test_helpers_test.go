@@ -488,3 +488,48 @@ func mustPanicFree(t *testing.T, fn func()) {
 
 	fn()
 }
+
+// mustAddEdge inserts one edge into a fixture graph and returns its identifier.
+//
+// Implementation:
+//   - Stage 1: Mark the helper frame.
+//   - Stage 2: Delegate to core.Graph.AddEdge with the supplied edge options.
+//   - Stage 3: Fail immediately on any construction error.
+//
+// Behavior highlights:
+//   - Keeps multi-edge fixtures readable without hiding construction failures.
+//
+// Inputs:
+//   - graph: fixture graph under construction.
+//   - from: edge source endpoint.
+//   - to: edge target endpoint.
+//   - weight: finite edge weight.
+//   - opts: optional core edge options such as core.WithEdgeDirected.
+//
+// Returns:
+//   - string: the identifier assigned by core.
+//
+// Errors:
+//   - Fatal test failure if AddEdge fails.
+//
+// Determinism:
+//   - Deterministic for the same graph state and inputs.
+//
+// Complexity:
+//   - Time O(1) amortized plus core insertion cost, Space O(1).
+//
+// Notes:
+//   - Use it only for valid fixtures; invalid-edge tests must call AddEdge directly.
+//
+// AI-Hints:
+//   - Never ignore graph fixture construction errors.
+func mustAddEdge(t *testing.T, graph *core.Graph, from, to string, weight float64, opts ...core.EdgeOption) string {
+	t.Helper()
+
+	edgeID, err := graph.AddEdge(from, to, weight, opts...)
+	if err != nil {
+		t.Fatalf("AddEdge(%q,%q,%v) failed: %v", from, to, weight, err)
+	}
+
+	return edgeID
+}
@@ -4,9 +4,12 @@
 package dijkstra
 
 import (
+	"errors"
 	"math"
+	"sort"
 
 	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/matrix"
 )
 
 // Result stores the detached, queryable outcome of a single-source
@@ -22,12 +25,14 @@ import (
 // Behavior highlights:
 //   - Distances uses +Inf to represent known but unreachable vertices.
 //   - Prev == nil means path tracking was disabled for the producing run.
+//   - PrevEdge names the edge behind each Prev link, so multigraph witnesses are exact.
 //   - The result is detached from the graph and remains stable after return.
 //
 // Inputs:
 //   - SourceID: the source vertex identifier used for the originating run.
 //   - Distances: the finalized shortest-path distance map.
 //   - Prev: the optional predecessor map for path reconstruction.
+//   - PrevEdge: the optional predecessor edge-ID map recorded alongside Prev.
 //
 // Returns:
 //   - Result: a detached contract type for post-run queries.
@@ -59,6 +64,7 @@ type Result struct {
 	SourceID  string
 	Distances map[string]float64
 	Prev      map[string]string
+	PrevEdge  map[string]string
 }
 
 // Ensure that Result satisfies core.Nilable without requiring callers
@@ -278,13 +284,28 @@ func (r *Result) PathTo(vertexID string) ([]string, error) {
 		return []string{r.SourceID}, nil
 	}
 
+	return tracePrev(r.SourceID, vertexID, r.Distances, r.Prev)
+}
+
+// tracePrev walks prev from vertexID back to sourceID and returns the path in
+// source-to-target order. Every vertex on the chain must belong to domain.
+//
+// Errors:
+//   - ErrNoPath on a broken, cyclic, or out-of-domain predecessor chain.
+//
+// Complexity:
+//   - Time O(k), Space O(k) for a k-vertex path.
+//
+// AI-Hints:
+//   - Do not remove the cycle-detection set; prev is caller-owned state.
+func tracePrev(sourceID, vertexID string, domain map[string]float64, prev map[string]string) ([]string, error) {
 	path := make([]string, 0)
 	seenIDs := make(map[string]struct{})
 	currentID := vertexID
 	var known, repeated bool
 
 	for {
-		if _, known = r.Distances[currentID]; !known {
+		if _, known = domain[currentID]; !known {
 			return nil, ErrNoPath
 		}
 		if _, repeated = seenIDs[currentID]; repeated {
@@ -294,11 +315,11 @@ func (r *Result) PathTo(vertexID string) ([]string, error) {
 		seenIDs[currentID] = struct{}{}
 		path = append(path, currentID)
 
-		if currentID == r.SourceID {
+		if currentID == sourceID {
 			break
 		}
 
-		parentID, ok := r.Prev[currentID]
+		parentID, ok := prev[currentID]
 		if !ok || parentID == "" {
 			return nil, ErrNoPath
 		}
@@ -319,6 +340,70 @@ func (r *Result) PathTo(vertexID string) ([]string, error) {
 	return path, nil
 }
 
+// EdgePathTo reconstructs the shortest-path witness to vertexID as the sequence
+// of traversed edge IDs, together with the distance reached after every hop.
+//
+// Implementation:
+//   - Stage 1: Reconstruct and validate the vertex witness through PathTo.
+//   - Stage 2: Map every non-source vertex on it to its PrevEdge entry.
+//   - Stage 3: Read the cumulative distance of every reached vertex.
+//
+// Behavior highlights:
+//   - edgeIDs[i] leads from path vertex i to path vertex i+1, so parallel
+//     multi-edges are identified exactly.
+//   - cumulative[i] is Distances of path vertex i+1; the last entry equals
+//     DistanceTo(vertexID), and per-hop weights are successive differences.
+//   - The source itself yields two empty slices.
+//
+// Inputs:
+//   - vertexID: the target vertex identifier to reconstruct.
+//
+// Returns:
+//   - []string: edge IDs from source to vertexID.
+//   - []float64: cumulative distances, one per edge.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrPathTrackingDisabled if Prev or PrevEdge is nil.
+//   - ErrNoPath if the target is unreachable or the witness state is broken.
+//
+// Determinism:
+//   - Deterministic for the same stored Prev, PrevEdge, and Distances.
+//
+// Complexity:
+//   - Time O(k), Space O(k), where k is the number of vertices on the path.
+//
+// AI-Hints:
+//   - Resolve IDs to *core.Edge through the producing graph when full edge data is needed.
+//   - Do not recompute weights from the graph; the result may outlive graph edits.
+func (r *Result) EdgePathTo(vertexID string) ([]string, []float64, error) {
+	if r == nil {
+		return nil, nil, ErrNilResult
+	}
+	if r.Prev != nil && r.PrevEdge == nil {
+		return nil, nil, ErrPathTrackingDisabled
+	}
+
+	path, err := r.PathTo(vertexID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edgeIDs := make([]string, 0, len(path)-1)
+	cumulative := make([]float64, 0, len(path)-1)
+	for _, currentID := range path[1:] {
+		edgeID := r.PrevEdge[currentID]
+		if edgeID == "" {
+			return nil, nil, ErrNoPath
+		}
+
+		edgeIDs = append(edgeIDs, edgeID)
+		cumulative = append(cumulative, r.Distances[currentID])
+	}
+
+	return edgeIDs, cumulative, nil
+}
+
 // Clone returns a detached deep copy of the result.
 // Maps are copied deeply so that the returned value can be mutated by the caller
 // without affecting the original result.
@@ -327,7 +412,7 @@ func (r *Result) PathTo(vertexID string) ([]string, error) {
 //   - Stage 1: Handle the nil receiver safely.
 //   - Stage 2: Copy scalar fields.
 //   - Stage 3: Deep-copy Distances.
-//   - Stage 4: Deep-copy Prev only when tracking data exists.
+//   - Stage 4: Deep-copy Prev and PrevEdge only when tracking data exists.
 //
 // Behavior highlights:
 //   - Nil receivers produce nil clones.
@@ -363,6 +448,7 @@ func (r *Result) Clone() *Result {
 		SourceID:  r.SourceID,
 		Distances: make(map[string]float64, len(r.Distances)),
 		Prev:      nil,
+		PrevEdge:  nil,
 	}
 
 	for vertexID, distance := range r.Distances {
@@ -376,5 +462,563 @@ func (r *Result) Clone() *Result {
 		}
 	}
 
+	if r.PrevEdge != nil {
+		clonedResult.PrevEdge = make(map[string]string, len(r.PrevEdge))
+		for vertexID, edgeID := range r.PrevEdge {
+			clonedResult.PrevEdge[vertexID] = edgeID
+		}
+	}
+
 	return clonedResult
 }
+
+// Heuristic estimates the remaining shortest-path distance from a vertex to the
+// fixed A* target. It is supplied by the caller and consulted lazily by AStar.
+//
+// Implementation:
+//   - Stage 1: Receive one vertex identifier from the traversal kernel.
+//   - Stage 2: Return a finite non-negative lower bound on the remaining distance.
+//
+// Behavior highlights:
+//   - The kernel evaluates each vertex at most once per run and caches the value.
+//   - A zero heuristic degrades AStar to target-stopped Dijkstra.
+//
+// Inputs:
+//   - vertexID: a vertex known to the traversed graph.
+//
+// Returns:
+//   - float64: the estimated remaining distance to the target.
+//
+// Errors:
+//   - NaN, infinite, or negative estimates are rejected by the kernel with
+//     ErrInvalidHeuristic.
+//
+// Determinism:
+//   - The function must be pure for a given vertexID; AStar determinism depends on it.
+//
+// Complexity:
+//   - Caller-defined; it is invoked at most once per discovered vertex.
+//
+// Notes:
+//   - AStar finalizes vertices once, so optimality requires a consistent heuristic:
+//     h(target) == 0 and h(u) <= w(u,v) + h(v) for every traversable edge u->v.
+//   - Consistency implies admissibility; WithHeuristicCheck verifies both at runtime.
+//   - Geographic routing typically uses straight-line distance scaled to edge units.
+//
+// AI-Hints:
+//   - Do not return +Inf to mark dead ends; filter them with InfEdgeThreshold instead.
+//   - Do not capture mutable state that changes between calls within one run.
+type Heuristic func(vertexID string) float64
+
+// Path is one route returned by KShortestPaths.
+//
+// Behavior highlights:
+//   - EdgeIDs[i] is the edge traversed from VertexIDs[i] to VertexIDs[i+1], so
+//     parallel multi-edges yield distinct paths.
+//   - Cost is the left-to-right sum of the traversed edge weights.
+//   - A source queried against itself yields one vertex and no edges.
+//
+// AI-Hints:
+//   - Compare paths by EdgeIDs, not VertexIDs; two paths may visit the same
+//     vertices over different parallel edges.
+type Path struct {
+	// VertexIDs lists the visited vertices from source to target.
+	VertexIDs []string
+
+	// EdgeIDs lists the traversed edge IDs; len(EdgeIDs) == len(VertexIDs)-1.
+	EdgeIDs []string
+
+	// Cost is the total weight of the path.
+	Cost float64
+}
+
+// MultiSourceResult is the outcome of one multi-source Dijkstra run: for every
+// vertex, its distance to the nearest source, that source, and its predecessor.
+// Grouping vertices by Nearest yields the graph Voronoi partition.
+//
+// Behavior highlights:
+//   - Distances[v] = min over sources s of offset(s) + dist(s, v).
+//   - Nearest[v] is the source whose cell v belongs to; "" when v is unreachable
+//     or beyond MaxDistance. Every source reached first by itself is its own nearest.
+//   - Prev[v] is "" for seeded sources and unreachable vertices.
+//
+// Errors:
+//   - PathTo may return ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound, or ErrNoPath.
+//
+// Determinism:
+//   - Equal-distance ties between sources are resolved by the kernel's fixed
+//     seed and heap order, so the partition is reproducible.
+//
+// Complexity:
+//   - Cells is O(V log V); PathTo is O(k) for a path of k vertices.
+//
+// AI-Hints:
+//   - A source can belong to another source's cell when its offset exceeds the
+//     distance from that other source.
+type MultiSourceResult struct {
+	Distances map[string]float64
+	Nearest   map[string]string
+	Prev      map[string]string
+}
+
+// Cells groups reached vertices by their nearest source. Vertex lists are sorted
+// lexicographically; unreachable vertices are omitted.
+//
+// Complexity:
+//   - Time O(V log V), Space O(V).
+func (r *MultiSourceResult) Cells() map[string][]string {
+	if r == nil {
+		return nil
+	}
+
+	cells := make(map[string][]string)
+	for vertexID, sourceID := range r.Nearest {
+		if sourceID != "" {
+			cells[sourceID] = append(cells[sourceID], vertexID)
+		}
+	}
+	for _, members := range cells {
+		sort.Strings(members)
+	}
+
+	return cells
+}
+
+// PathTo reconstructs the shortest path from the nearest source to vertexID.
+//
+// Returns:
+//   - []string: vertex IDs from Nearest[vertexID] to vertexID inclusive.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrNoPath if vertexID is unreachable or the predecessor chain is broken.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+//
+// AI-Hints:
+//   - Like Result.PathTo, the chain is validated against cycles because Prev is caller-owned.
+func (r *MultiSourceResult) PathTo(vertexID string) ([]string, error) {
+	if r == nil {
+		return nil, ErrNilResult
+	}
+	if vertexID == "" {
+		return nil, ErrEmptyTargetID
+	}
+
+	distance, ok := r.Distances[vertexID]
+	if !ok {
+		return nil, ErrTargetNotFound
+	}
+	if math.IsInf(distance, 1) || r.Nearest[vertexID] == "" {
+		return nil, ErrNoPath
+	}
+
+	sourceID := r.Nearest[vertexID]
+	path := make([]string, 0)
+	seenIDs := make(map[string]struct{})
+	for currentID := vertexID; ; currentID = r.Prev[currentID] {
+		if _, repeated := seenIDs[currentID]; repeated {
+			return nil, ErrNoPath
+		}
+		seenIDs[currentID] = struct{}{}
+		path = append(path, currentID)
+
+		if currentID == sourceID {
+			break
+		}
+		if r.Prev[currentID] == "" {
+			return nil, ErrNoPath
+		}
+	}
+
+	for left, right := 0, len(path)-1; left < right; left, right = left+1, right-1 {
+		path[left], path[right] = path[right], path[left]
+	}
+
+	return path, nil
+}
+
+// WidestResult is the outcome of Widest: for every vertex, the largest
+// bottleneck width over all paths from the source, with an optional witness.
+//
+// Behavior highlights:
+//   - Widths[v] = max over paths P of min over edges e in P of w(e).
+//   - Widths[source] = +Inf (the empty path has no bottleneck).
+//   - Widths[v] = -Inf marks an unreachable vertex, mirroring +Inf in Result.
+//   - Prev and PrevEdge follow the Result conventions and are nil unless
+//     WithPathTracking was given.
+//
+// Errors:
+//   - WidthTo may return ErrNilResult, ErrEmptyTargetID, or ErrTargetNotFound.
+//   - PathTo may additionally return ErrPathTrackingDisabled or ErrNoPath.
+//
+// Complexity:
+//   - WidthTo is O(1); PathTo is O(k) for a k-vertex path.
+//
+// AI-Hints:
+//   - The witness is one widest path, not necessarily the shortest among them.
+type WidestResult struct {
+	SourceID string
+	Widths   map[string]float64
+	Prev     map[string]string
+	PrevEdge map[string]string
+}
+
+// WidthTo returns the stored bottleneck width of vertexID; -Inf means unreachable.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (r *WidestResult) WidthTo(vertexID string) (float64, error) {
+	if r == nil {
+		return 0, ErrNilResult
+	}
+	if vertexID == "" {
+		return 0, ErrEmptyTargetID
+	}
+
+	width, ok := r.Widths[vertexID]
+	if !ok {
+		return 0, ErrTargetNotFound
+	}
+
+	return width, nil
+}
+
+// PathTo reconstructs one widest path from the source to vertexID.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrPathTrackingDisabled if Prev is nil.
+//   - ErrNoPath if vertexID is unreachable or the predecessor chain is broken.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func (r *WidestResult) PathTo(vertexID string) ([]string, error) {
+	width, err := r.WidthTo(vertexID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Prev == nil {
+		return nil, ErrPathTrackingDisabled
+	}
+	if math.IsInf(width, -1) {
+		return nil, ErrNoPath
+	}
+	if vertexID == r.SourceID {
+		return []string{r.SourceID}, nil
+	}
+
+	return tracePrev(r.SourceID, vertexID, r.Widths, r.Prev)
+}
+
+// Table is an origin x destination distance table produced by DistanceTable.
+//
+// Behavior highlights:
+//   - Distances.At(i, j) is the shortest distance RowIDs[i] -> ColIDs[j];
+//     +Inf marks unreachable pairs and pairs beyond MaxDistance.
+//   - When RowIDs and ColIDs are the same list, the table is square and can be
+//     passed to tsp.SolveMatrix(table.Distances, table.RowIDs, ...) directly.
+//
+// Errors:
+//   - DistanceTo may return ErrNilResult, ErrSourceNotFound, or ErrTargetNotFound.
+//
+// Complexity:
+//   - DistanceTo is O(1).
+//
+// AI-Hints:
+//   - The Dense matrix is caller-owned; copy it before mutation if the table is shared.
+//   - TSP solvers reject +Inf; check reachability first on disconnected graphs.
+type Table struct {
+	RowIDs    []string
+	ColIDs    []string
+	Distances *matrix.Dense
+
+	rowIndex map[string]int
+	colIndex map[string]int
+}
+
+// newTable indexes the first occurrence of every row and column ID.
+func newTable(rowIDs, colIDs []string, distances *matrix.Dense) *Table {
+	table := &Table{
+		RowIDs:    rowIDs,
+		ColIDs:    colIDs,
+		Distances: distances,
+		rowIndex:  make(map[string]int, len(rowIDs)),
+		colIndex:  make(map[string]int, len(colIDs)),
+	}
+	for row := len(rowIDs) - 1; row >= 0; row-- {
+		table.rowIndex[rowIDs[row]] = row
+	}
+	for col := len(colIDs) - 1; col >= 0; col-- {
+		table.colIndex[colIDs[col]] = col
+	}
+
+	return table
+}
+
+// DistanceTo returns the table entry for the pair (rowID, colID).
+//
+// Errors:
+//   - ErrNilResult for a nil table.
+//   - ErrSourceNotFound / ErrTargetNotFound when the ID is not a row / column.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (t *Table) DistanceTo(rowID, colID string) (float64, error) {
+	if t == nil || t.Distances == nil {
+		return 0, ErrNilResult
+	}
+	row, ok := t.rowIndex[rowID]
+	if !ok {
+		return 0, ErrSourceNotFound
+	}
+	col, ok := t.colIndex[colID]
+	if !ok {
+		return 0, ErrTargetNotFound
+	}
+
+	return t.Distances.At(row, col)
+}
+
+// Bidirectional is a reusable point-to-point shortest-path engine bound to one
+// graph snapshot. It answers source-target queries with bidirectional Dijkstra.
+//
+// Implementation:
+//   - Stage 1: NewBidirectional validates the graph and freezes outgoing and
+//     incoming relation snapshots in Edge.ID order.
+//   - Stage 2: Each query runs a forward and a backward search that meet in the middle.
+//
+// Behavior highlights:
+//   - Construction pays the O(E log E) indexing cost once; queries pay only for
+//     the explored region.
+//   - Directed edges are walked backward through the incoming snapshot, which core
+//     does not expose as a live surface.
+//   - Options (MaxDistance, InfEdgeThreshold) are fixed at construction time.
+//
+// Inputs:
+//   - Constructed through NewBidirectional only.
+//
+// Returns:
+//   - Bidirectional: an immutable query engine.
+//
+// Errors:
+//   - Query methods return the sentinels documented on ShortestPathTo and DistanceTo.
+//
+// Determinism:
+//   - Queries are deterministic for the same snapshot, options, and endpoints.
+//
+// Complexity:
+//   - Space O(V + E) for the snapshots.
+//
+// Notes:
+//   - The engine does not observe later graph mutation; rebuild it after edits.
+//   - The engine is immutable after construction, so concurrent queries are safe.
+//
+// AI-Hints:
+//   - Reuse one engine across many queries; the one-shot wrapper
+//     BidirectionalShortestPathTo rebuilds the snapshot per call.
+type Bidirectional struct {
+	options  Options
+	vertices map[string]struct{}
+	outgoing map[string][]*core.Edge
+	incoming map[string][]*core.Edge
+}
+
+// ShortestPathTo returns one deterministic shortest-path witness from sourceID to
+// targetID together with its distance.
+//
+// Implementation:
+//   - Stage 1: Validate the receiver and endpoint identifiers.
+//   - Stage 2: Delegate to the bidirectional kernel.
+//
+// Behavior highlights:
+//   - The distance equals dijkstra.ShortestPathTo under the same options.
+//   - When several shortest paths exist, the witness is the one ShortestPathTo
+//     returns: the heap (distance, vertex ID) tie-break and strict-improvement
+//     predecessor law of the one-to-all kernel decide it.
+//
+// Inputs:
+//   - sourceID: the source vertex identifier.
+//   - targetID: the target vertex identifier.
+//
+// Returns:
+//   - []string: one deterministic shortest-path witness.
+//   - float64: the shortest-path distance.
+//
+// Errors:
+//   - ErrNilResult if the receiver is nil.
+//   - ErrEmptySourceID, ErrEmptyTargetID.
+//   - ErrSourceNotFound, ErrTargetNotFound against the snapshot.
+//   - ErrNoPath if targetID is unreachable under the effective policy.
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrDistanceOverflow from relaxation.
+//
+// Determinism:
+//   - Deterministic for the same snapshot and endpoints.
+//
+// Complexity:
+//   - Worst case O((V + E) log V), typically much less.
+//
+// AI-Hints:
+//   - Use DistanceTo when +Inf instead of ErrNoPath is the desired unreachable outcome.
+func (b *Bidirectional) ShortestPathTo(sourceID, targetID string) ([]string, float64, error) {
+	if b == nil {
+		return nil, 0, ErrNilResult
+	}
+	if sourceID == "" {
+		return nil, 0, ErrEmptySourceID
+	}
+	if targetID == "" {
+		return nil, 0, ErrEmptyTargetID
+	}
+	if _, ok := b.vertices[sourceID]; !ok {
+		return nil, 0, ErrSourceNotFound
+	}
+	if _, ok := b.vertices[targetID]; !ok {
+		return nil, 0, ErrTargetNotFound
+	}
+
+	return runBidirectional(b, sourceID, targetID)
+}
+
+// DistanceTo returns the shortest-path distance from sourceID to targetID.
+//
+// Implementation:
+//   - Stage 1: Delegate to ShortestPathTo.
+//   - Stage 2: Translate ErrNoPath into the canonical +Inf distance.
+//
+// Behavior highlights:
+//   - Mirrors the package DistanceTo contract: unreachable is +Inf with nil error.
+//
+// Inputs:
+//   - sourceID: the source vertex identifier.
+//   - targetID: the target vertex identifier.
+//
+// Returns:
+//   - float64: the distance, or +Inf when unreachable.
+//
+// Errors:
+//   - Any error of ShortestPathTo except ErrNoPath.
+//
+// Determinism:
+//   - Deterministic.
+//
+// Complexity:
+//   - Same as ShortestPathTo.
+//
+// AI-Hints:
+//   - Keep the ErrNoPath -> +Inf translation here only; path queries must keep ErrNoPath.
+func (b *Bidirectional) DistanceTo(sourceID, targetID string) (float64, error) {
+	_, distance, err := b.ShortestPathTo(sourceID, targetID)
+	if errors.Is(err, ErrNoPath) {
+		return math.Inf(1), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return distance, nil
+}
+
+// ChangeOp classifies one edge mutation reported to Dynamic.Apply.
+type ChangeOp int
+
+const (
+	// ChangeInsert reports an edge that was added to the graph.
+	ChangeInsert ChangeOp = iota + 1
+	// ChangeDelete reports an edge that was removed from the graph.
+	ChangeDelete
+	// ChangeUpdate reports an edge whose weight changed; core has no in-place
+	// weight setter, so the edge is removed and re-added under the same ID.
+	ChangeUpdate
+)
+
+// EdgeChange describes one edge mutation that has already been applied to the
+// graph owned by a Dynamic.
+//
+// Behavior highlights:
+//   - Only the edge ID is carried; the previous endpoints and weight are taken
+//     from the Dynamic snapshot and the current ones from the graph.
+//
+// AI-Hints:
+//   - Endpoints of an updated edge may change too; the repair treats it as delete plus insert.
+type EdgeChange struct {
+	Op     ChangeOp
+	EdgeID string
+}
+
+// Dynamic maintains a single-source shortest-path tree under batches of edge
+// insertions, deletions, and weight updates.
+//
+// Behavior highlights:
+//   - Apply repairs only the subtrees hanging below invalidated tree edges and
+//     the vertices improved by cheaper or new edges; the rest of the tree is kept.
+//   - After every successful Apply, Distances equal those of a fresh Dijkstra run
+//     on the current graph with the same options.
+//   - Prev and PrevEdge always form a valid shortest-path tree, but under ties
+//     they may name a different (equally short) witness than a fresh run.
+//
+// Inputs:
+//   - Constructed through NewDynamic only.
+//
+// Errors:
+//   - Apply returns the sentinels documented on it; after a relaxation error the
+//     state is poisoned and every later Apply returns that error again.
+//
+// Determinism:
+//   - Deterministic for the same initial result, graph states, and batches.
+//
+// Complexity:
+//   - Space O(V + E) for the tree and the edge snapshot.
+//
+// Notes:
+//   - Dynamic is not safe for concurrent use.
+//   - The snapshot must stay in sync with the graph: report every edge mutation.
+//
+// AI-Hints:
+//   - Keep one Dynamic per cached source and feed all of them the same batch.
+type Dynamic struct {
+	graph    *core.Graph
+	options  Options
+	result   *Result
+	edges    map[string]*core.Edge
+	incoming map[string]map[string]*core.Edge
+	err      error
+}
+
+// Result returns a detached copy of the current shortest-path tree.
+//
+// Returns:
+//   - *Result: an isolated copy; nil if the receiver is nil or poisoned.
+//
+// Complexity:
+//   - Time O(V), Space O(V).
+func (d *Dynamic) Result() *Result {
+	if d == nil || d.err != nil {
+		return nil
+	}
+
+	return d.result.Clone()
+}
+
+// DistanceTo returns the current distance of vertexID; +Inf means unreachable.
+//
+// Errors:
+//   - ErrNilResult if the receiver is nil, the poisoning error if Apply failed,
+//     and the Result.DistanceTo sentinels otherwise.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (d *Dynamic) DistanceTo(vertexID string) (float64, error) {
+	if d == nil {
+		return 0, ErrNilResult
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+
+	return d.result.DistanceTo(vertexID)
+}
@@ -822,3 +822,70 @@ func TestResult_PathTo_PredecessorOutsideResultDomain(t *testing.T) {
 	mustNilState(t, path, true, "PathTo foreign predecessor path")
 	mustErrorIs(t, err, dijkstra.ErrNoPath)
 }
+
+// TestResult_EdgePathTo verifies edge-ID reconstruction on fixture results.
+//
+// Implementation:
+//   - Stage 1: Reconstruct A->B->C from Prev, PrevEdge, and Distances.
+//   - Stage 2: Assert the source yields empty slices.
+//   - Stage 3: Assert missing PrevEdge data and broken edge entries are rejected.
+//
+// Behavior highlights:
+//   - Cumulative distances are read from Distances, not recomputed.
+//   - Prev without PrevEdge means edge tracking was disabled.
+//
+// AI-Hints:
+//   - Keep tracking-disabled and broken-witness outcomes on separate sentinels.
+func TestResult_EdgePathTo(t *testing.T) {
+	newResult := func() *dijkstra.Result {
+		return &dijkstra.Result{
+			SourceID: resultTestSourceID,
+			Distances: map[string]float64{
+				resultTestSourceID: resultTestDistanceSource,
+				resultTestMiddleID: resultTestDistanceMiddle,
+				resultTestTargetID: resultTestDistanceTarget,
+			},
+			Prev: map[string]string{
+				resultTestSourceID: "",
+				resultTestMiddleID: resultTestSourceID,
+				resultTestTargetID: resultTestMiddleID,
+			},
+			PrevEdge: map[string]string{
+				resultTestMiddleID: "e1",
+				resultTestTargetID: "e2",
+			},
+		}
+	}
+
+	edgeIDs, cumulative, err := newResult().EdgePathTo(resultTestTargetID)
+	if err != nil {
+		t.Fatalf("EdgePathTo(%q) failed: %v", resultTestTargetID, err)
+	}
+	assertPathEqual(t, edgeIDs, []string{"e1", "e2"})
+	mustEqualInt(t, len(cumulative), 2, "cumulative length")
+	mustEqualFloat64(t, cumulative[0], resultTestDistanceMiddle, "cumulative[0]: got=%v want=%v", cumulative[0], resultTestDistanceMiddle)
+	mustEqualFloat64(t, cumulative[1], resultTestDistanceTarget, "cumulative[1]: got=%v want=%v", cumulative[1], resultTestDistanceTarget)
+
+	edgeIDs, cumulative, err = newResult().EdgePathTo(resultTestSourceID)
+	if err != nil {
+		t.Fatalf("EdgePathTo(source) failed: %v", err)
+	}
+	mustEqualInt(t, len(edgeIDs)+len(cumulative), 0, "source edge path length")
+
+	untracked := newResult()
+	untracked.PrevEdge = nil
+	_, _, err = untracked.EdgePathTo(resultTestTargetID)
+	mustErrorIs(t, err, dijkstra.ErrPathTrackingDisabled)
+
+	broken := newResult()
+	delete(broken.PrevEdge, resultTestTargetID)
+	_, _, err = broken.EdgePathTo(resultTestTargetID)
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+
+	_, _, err = newResult().EdgePathTo(resultTestUnknownID)
+	mustErrorIs(t, err, dijkstra.ErrTargetNotFound)
+
+	var nilResult *dijkstra.Result
+	_, _, err = nilResult.EdgePathTo(resultTestTargetID)
+	mustErrorIs(t, err, dijkstra.ErrNilResult)
+}
@@ -312,9 +312,10 @@ func validateInfEdgeThreshold(threshold float64) error {
 // Errors:
 //   - ErrBadMaxDistance if MaxDistance is invalid.
 //   - ErrBadInfEdgeThreshold if InfEdgeThreshold is invalid.
+//   - ErrBadWorkers if Workers is negative.
 //
 // Determinism:
-//   - Field-validation order is fixed: MaxDistance, then InfEdgeThreshold.
+//   - Field-validation order is fixed: MaxDistance, InfEdgeThreshold, then Workers.
 //
 // Complexity:
 //   - Time O(1), Space O(1).
@@ -332,6 +333,9 @@ func validateOptions(config Options) error {
 	if err := validateInfEdgeThreshold(config.InfEdgeThreshold); err != nil {
 		return err
 	}
+	if config.Workers < 0 {
+		return ErrBadWorkers
+	}
 
 	return nil
 }
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package dijkstra_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+)
+
+// AI-HINTS (file):
+//   - The oracle enumerates every simple path by DFS over real edges; Yen must
+//     reproduce the k smallest costs exactly (integer weights).
+//   - Returned paths are validated structurally: simple, distinct, and walkable.
+
+// enumerateSimplePathCosts returns the sorted costs of all simple paths
+// sourceID -> targetID, one per distinct edge sequence.
+func enumerateSimplePathCosts(t *testing.T, graph *core.Graph, sourceID, targetID string) []float64 {
+	t.Helper()
+
+	var costs []float64
+	onPath := map[string]bool{sourceID: true}
+
+	var walk func(currentID string, cost float64)
+	walk = func(currentID string, cost float64) {
+		if currentID == targetID {
+			costs = append(costs, cost)
+			return
+		}
+		edges, err := graph.Neighbors(currentID)
+		if err != nil {
+			t.Fatalf("Neighbors(%q) failed: %v", currentID, err)
+		}
+		for _, edge := range edges {
+			nextID := edge.To
+			if edge.To == currentID {
+				nextID = edge.From
+			}
+			if edge.Directed && edge.From != currentID {
+				continue
+			}
+			if onPath[nextID] {
+				continue
+			}
+			onPath[nextID] = true
+			walk(nextID, cost+edge.Weight)
+			onPath[nextID] = false
+		}
+	}
+	walk(sourceID, 0)
+
+	sort.Float64s(costs)
+
+	return costs
+}
+
+// assertValidPaths checks that paths are simple, distinct, walkable, and sorted.
+func assertValidPaths(t *testing.T, graph *core.Graph, paths []dijkstra.Path, sourceID, targetID string) {
+	t.Helper()
+
+	edges := make(map[string]*core.Edge)
+	for _, edge := range graph.Edges() {
+		edges[edge.ID] = edge
+	}
+
+	seen := make(map[string]bool)
+	for index, path := range paths {
+		if path.VertexIDs[0] != sourceID || path.VertexIDs[len(path.VertexIDs)-1] != targetID {
+			t.Fatalf("path %d endpoints: %v", index, path.VertexIDs)
+		}
+		if len(path.EdgeIDs) != len(path.VertexIDs)-1 {
+			t.Fatalf("path %d: %d edges for %d vertices", index, len(path.EdgeIDs), len(path.VertexIDs))
+		}
+		if index > 0 && path.Cost < paths[index-1].Cost {
+			t.Fatalf("path %d cost %v below previous %v", index, path.Cost, paths[index-1].Cost)
+		}
+
+		key := strings.Join(path.EdgeIDs, ",")
+		if seen[key] {
+			t.Fatalf("path %d repeats edge sequence %s", index, key)
+		}
+		seen[key] = true
+
+		visited := make(map[string]bool)
+		total := 0.0
+		for hop, edgeID := range path.EdgeIDs {
+			from, to := path.VertexIDs[hop], path.VertexIDs[hop+1]
+			if visited[from] {
+				t.Fatalf("path %d revisits %q", index, from)
+			}
+			visited[from] = true
+
+			edge := edges[edgeID]
+			forward := edge.From == from && edge.To == to
+			backward := !edge.Directed && edge.From == to && edge.To == from
+			if !forward && !backward {
+				t.Fatalf("path %d edge %q does not lead %q->%q", index, edgeID, from, to)
+			}
+			total += edge.Weight
+		}
+		mustEqualFloat64(t, total, path.Cost, "path %d cost: edges=%v reported=%v", index, total, path.Cost)
+	}
+}
+
+// TestKShortestPaths_MatchesEnumeration verifies Yen against exhaustive simple-path
+// enumeration on random directed, undirected, and mixed multigraphs.
+//
+// Implementation:
+//   - Stage 1: Build small random graphs (parallel edges and loops included).
+//   - Stage 2: For several pairs, request k = 6 paths.
+//   - Stage 3: Compare costs with the oracle prefix and validate every path.
+//
+// AI-Hints:
+//   - Small vertex counts keep exhaustive enumeration cheap.
+func TestKShortestPaths_MatchesEnumeration(t *testing.T) {
+	const k = 6
+
+	for _, mode := range []string{"directed", "undirected", "mixed"} {
+		for seed := int64(1); seed <= 3; seed++ {
+			graph := buildRandomMixedGraph(t, seed, 7, 16, mode)
+			vertices := graph.Vertices()
+
+			for _, sourceID := range vertices[:3] {
+				for _, targetID := range vertices[len(vertices)-3:] {
+					if sourceID == targetID {
+						continue
+					}
+					want := enumerateSimplePathCosts(t, graph, sourceID, targetID)
+					paths, err := dijkstra.KShortestPaths(graph, sourceID, targetID, k)
+					if len(want) == 0 {
+						mustErrorIs(t, err, dijkstra.ErrNoPath)
+						continue
+					}
+					if err != nil {
+						t.Fatalf("%s/%d %s->%s: %v", mode, seed, sourceID, targetID, err)
+					}
+
+					if len(want) > k {
+						want = want[:k]
+					}
+					mustEqualInt(t, len(paths), len(want), "%s/%d %s->%s path count", mode, seed, sourceID, targetID)
+					for index := range want {
+						mustEqualFloat64(t, paths[index].Cost, want[index],
+							"%s/%d %s->%s path %d cost: got=%v want=%v", mode, seed, sourceID, targetID, index, paths[index].Cost, want[index])
+					}
+					assertValidPaths(t, graph, paths, sourceID, targetID)
+				}
+			}
+		}
+	}
+}
+
+// TestKShortestPaths_ParallelEdgesAndPolicy verifies edge-level distinctness,
+// the total-cost cutoff, the self query, and input sentinels.
+//
+// Implementation:
+//   - Stage 1: Two parallel A->B edges plus B->C yield two distinct A->C paths.
+//   - Stage 2: WithMaxDistance drops the more expensive one.
+//   - Stage 3: Assert validation and cancellation sentinels.
+func TestKShortestPaths_ParallelEdgesAndPolicy(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true), core.WithMultiEdges())
+	cheap := mustAddEdge(t, graph, "A", "B", 1)
+	dear := mustAddEdge(t, graph, "A", "B", 3)
+	tail := mustAddEdge(t, graph, "B", "C", 1)
+
+	paths, err := dijkstra.KShortestPaths(graph, "A", "C", 5)
+	if err != nil {
+		t.Fatalf("KShortestPaths failed: %v", err)
+	}
+	mustEqualInt(t, len(paths), 2, "parallel path count")
+	mustEqualString(t, fmt.Sprint(paths[0].EdgeIDs), fmt.Sprint([]string{cheap, tail}), "first path edges")
+	mustEqualString(t, fmt.Sprint(paths[1].EdgeIDs), fmt.Sprint([]string{dear, tail}), "second path edges")
+	mustEqualFloat64(t, paths[1].Cost, 4, "second path cost: got=%v want=4", paths[1].Cost)
+
+	paths, err = dijkstra.KShortestPaths(graph, "A", "C", 5, dijkstra.WithMaxDistance(3))
+	if err != nil {
+		t.Fatalf("KShortestPaths(max) failed: %v", err)
+	}
+	mustEqualInt(t, len(paths), 1, "cutoff path count")
+
+	paths, err = dijkstra.KShortestPaths(graph, "B", "B", 3)
+	if err != nil {
+		t.Fatalf("KShortestPaths(self) failed: %v", err)
+	}
+	mustEqualInt(t, len(paths), 1, "self path count")
+	mustEqualInt(t, len(paths[0].EdgeIDs), 0, "self path edges")
+
+	_, err = dijkstra.KShortestPaths(graph, "C", "A", 2)
+	mustErrorIs(t, err, dijkstra.ErrNoPath)
+	_, err = dijkstra.KShortestPaths(graph, "A", "C", 0)
+	mustErrorIs(t, err, dijkstra.ErrBadK)
+	_, err = dijkstra.KShortestPaths(graph, "A", "", 1)
+	mustErrorIs(t, err, dijkstra.ErrEmptyTargetID)
+	_, err = dijkstra.KShortestPaths(graph, "A", "Q", 1)
+	mustErrorIs(t, err, dijkstra.ErrTargetNotFound)
+	_, err = dijkstra.KShortestPaths(nil, "A", "C", 1)
+	mustErrorIs(t, err, dijkstra.ErrNilGraph)
+	//nolint:staticcheck // nil context is the case under test
+	_, err = dijkstra.KShortestPathsContext(nil, graph, "A", "C", 1)
+	mustErrorIs(t, err, dijkstra.ErrNilContext)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = dijkstra.KShortestPathsContext(ctx, graph, "A", "C", 2)
+	mustErrorIs(t, err, context.Canceled)
+}
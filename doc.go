@@ -17,8 +17,17 @@
 //   - bfs       - unweighted hop-distance traversal and weak components.
 //   - dfs       - finish-order traversal, cycle witnesses, and topological sort.
 //   - dijkstra  - non-negative weighted single-source shortest paths.
+//   - bellmanford - negative-weight shortest paths with negative cycle witnesses.
+//   - johnson   - sparse all-pairs shortest paths into a matrix.Dense table.
+//   - rcsp      - resource-constrained shortest paths with Pareto fronts.
+//   - ch        - contraction hierarchies for repeated point-to-point queries.
+//   - alt       - landmark lower bounds (ALT) for goal-directed A* search.
+//   - tdsp      - time-dependent shortest paths with FIFO travel-time functions.
 //   - mst       - strict MST and explicit minimum spanning forest via Kruskal/Prim.
 //   - flow      - max-flow / min-cut algorithms with residual graph artifacts.
+//   - mincut    - global minimum cuts and Gomory-Hu trees for all-pairs cuts.
+//   - matching  - bipartite matching (Hopcroft-Karp), min-cost assignment, and
+//     weighted general matching (Blossom).
 //   - matrix    - dense row-major graph algebra, APSP, statistics, sanitation.
 //   - dtw       - deterministic Dynamic Time Warping for scalar, cost-matrix,
 //     and multivariate sequence alignment.
@@ -98,6 +107,48 @@
 //	disabled path tracking, and no-path states. +Inf is the canonical distance
 //	for known unreachable vertices under the active policy.
 //
+// bellmanford
+//
+//	Computes single-source shortest paths when edge weights may be negative,
+//	using SPFA by default or classic Bellman-Ford passes. A reachable negative
+//	cycle is reported as ErrNegativeCycle together with a Cycle witness of
+//	vertex and edge IDs. Potentials provides virtual-source reweighting
+//	potentials.
+//
+// johnson
+//
+//	Computes all-pairs shortest paths on sparse graphs: Bellman-Ford
+//	reweighting followed by per-source Dijkstra on a worker pool. The distance
+//	table is a matrix.Dense in g.Vertices() order, the same order used by
+//	matrix.NewAdjacencyMatrix.
+//
+// rcsp
+//
+//	Computes the cheapest route whose consumption of a second per-edge
+//	resource stays within a budget. Label setting with dominance and a reverse
+//	resource lower bound keeps the search small; WithParetoFront returns every
+//	non-dominated (cost, resource) route.
+//
+// ch
+//
+//	Preprocesses a static weighted graph into a contraction hierarchy and
+//	answers point-to-point distance and path queries by upward bidirectional
+//	search, unpacking shortcuts to original vertex and edge IDs. Hierarchies
+//	serialize to a versioned binary format and load back with full validation.
+//
+// alt
+//
+//	Selects landmarks (farthest, random, or planar), stores forward and
+//	backward landmark distances, and turns them into a consistent
+//	triangle-inequality heuristic for dijkstra.AStar. Refresh recomputes the
+//	tables after weight changes without reselecting landmarks.
+//
+// tdsp
+//
+//	Routes over FIFO piecewise-linear travel-time functions of the departure
+//	time. EarliestArrival is Dijkstra on arrival times; ArrivalProfile returns
+//	the exact travel time as a function of the departure over an interval.
+//
 // mst
 //
 //	Computes minimum spanning trees and explicit minimum spanning forests over
@@ -111,7 +162,25 @@
 //	Provides max-flow / min-cut workflows through Ford-Fulkerson,
 //	Edmonds-Karp, and Dinic-style algorithms. Weights represent capacities in
 //	this package. Results expose residual-network state, which is part of the
-//	algorithm artifact rather than incidental debug data.
+//	algorithm artifact rather than incidental debug data. Unit-capacity flows
+//	also yield edge- and vertex-disjoint paths with their Menger cuts, graph
+//	connectivity, and minimum-weight disjoint paths (Suurballe). MaxFlow can
+//	warm-start from a previous result after capacities change.
+//
+// mincut
+//
+//	Finds the global minimum cut of an undirected weighted graph through
+//	Stoer-Wagner or seeded Karger-Stein, and builds Gomory-Hu trees from V-1
+//	flow.MaxFlow runs to answer the minimum cut of any vertex pair.
+//
+// matching
+//
+//	Computes maximum-cardinality bipartite matchings with Hopcroft-Karp, using
+//	an explicit or two-coloured bipartition and publishing a Konig vertex
+//	cover, and minimum-cost assignments over matrix.Matrix cost tables with
+//	dual potentials as the optimality certificate. Maximum-weight and
+//	minimum-weight perfect matchings of general graphs run the Blossom engine
+//	shared with tsp.Christofides and publish vertex and blossom duals.
 //
 // matrix
 //
@@ -163,12 +232,33 @@
 //   - dijkstra: O((V+E) log V) style priority-queue routing over non-negative
 //     weights. Runtime walls and max-distance cutoffs are policy gates, not
 //     graph mutations.
+//   - bellmanford: O(VE) worst case for both SPFA and classic passes; SPFA is
+//     typically close to O(E). Witness extraction replays classic passes.
+//   - johnson: O(VE + V (V+E) log V) time and O(V^2) table memory; it beats
+//     Floyd-Warshall when E << V^2 and parallelizes across sources.
+//   - rcsp: NP-hard in general; label count is exponential in the worst case.
+//     Dominance and the resource lower bound prune most labels in practice, and
+//     WithMaxLabels bounds memory.
+//   - ch: preprocessing is superlinear and dominated by witness searches;
+//     queries touch only the two upward search spaces and beat Dijkstra by
+//     orders of magnitude on road-like graphs.
+//   - alt: preprocessing is 2k Dijkstra runs and O(kV) memory; each heuristic
+//     evaluation costs O(k).
+//   - tdsp: earliest arrival is one Dijkstra plus O(log k) per arc evaluation;
+//     profiles are label correcting over functions and grow with the number
+//     of routes that are optimal at some departure.
 //   - mst: Kruskal is O(E log E + E·α(V)); Prim is O(E log E) for the current
 //     edge-frontier heap implementation. Do not document Prim as O(E log V)
 //     unless the implementation changes to a vertex-key decrease-key heap.
 //   - flow: Edmonds-Karp is simpler and easier to audit; Dinic is typically
 //     faster on larger layered networks. Residual graph construction is part of
 //     the real cost.
+//   - mincut: Stoer-Wagner is O(V (V+E) log V) with no flows at all; a
+//     Gomory-Hu tree costs V-1 max flows and answers each pair in O(V).
+//   - matching: Hopcroft-Karp is O(E sqrt(V)); assignment is O(n^2 m) over a
+//     dense cost table, so sparse huge instances belong in flow.MinCostFlow.
+//     General weighted matching is polynomial but correctness-first: O(V^2)
+//     dual phases over O(E) edge scans.
 //   - matrix: dense algorithms trade memory O(R*C) for predictable row-major
 //     kernels and graph-algebra convenience. Zero-shape matrices are valid
 //     structural results.
@@ -194,11 +284,24 @@
 //     simple-cycle enumeration.
 //   - dijkstra: finite negative weights are rejected by design. Use a different
 //     shortest-path algorithm for negative-weight domains.
+//   - bellmanford: distances are undefined under a reachable negative cycle; the
+//     package publishes one witness cycle, not every negative cycle.
+//   - rcsp: one resource dimension only; costs and resources must be
+//     non-negative.
+//   - ch: the hierarchy is a static snapshot; graph edits require a rebuild.
+//   - alt: landmark tables must be refreshed after weight changes; stale tables
+//     may overestimate and break optimality.
+//   - tdsp: travel times must be FIFO; non-FIFO waiting models and periodic
+//     functions are out of scope.
 //   - mst: directed optimum branching/arborescence and Steiner tree optimization
 //     are out of scope. Strict MST does not silently downgrade to forest mode;
 //     callers must request forest mode explicitly.
 //   - flow: capacities are finite non-negative values. Generalized flows,
 //     min-cost flow, and multi-commodity flow are out of scope.
+//   - mincut: undirected graphs with non-negative weights only; directed
+//     global cuts and k-way partitioning are out of scope.
+//   - matching: Assignment minimizes a sum; bottleneck assignment and
+//     stable matching are out of scope.
 //   - matrix: dense storage is not a sparse-matrix engine. Metric closure is a
 //     distance artifact and must not be exported as original topology.
 //   - dtw: exact DTW is not generally a metric and does not imply triangle
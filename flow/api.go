@@ -23,7 +23,8 @@ import (
 // Implementation:
 //   - Stage 1: Apply and validate Option values before touching graph topology.
 //   - Stage 2: Validate graph, terminals, and weighted-capacity policy.
-//   - Stage 3: Build a deterministic residualNetwork from core.Edges().
+//   - Stage 3: Build a deterministic residualNetwork from core.Edges() and seed it
+//     with the previous flow when WithWarmStart is set.
 //   - Stage 4: Dispatch to the selected kernel without duplicating validation.
 //   - Stage 5: Publish MaxFlowResult through the selected kernel finalization path.
 //
@@ -52,6 +53,8 @@ import (
 //   - ErrInvalidCapacity, ErrNegativeCapacity, ErrNaNInf from residual construction.
 //   - context cancellation errors from cfg.ctx.
 //   - ErrObserverFailure if an observer rejects an augmentation event.
+//   - ErrWarmStartMismatch, ErrNoEdgeFlows, ErrFlowNotConserved when the
+//     WithWarmStart result does not belong to this graph and terminal pair.
 //
 // Determinism:
 //   - Vertex order comes from core.Vertices().
@@ -62,7 +65,8 @@ import (
 // Complexity:
 //   - Facade overhead is O(V + E + A log A) before kernel execution,
 //     where A is the residual adjacency-entry count.
-//   - Kernel complexity depends on Algorithm: Dinic, Edmonds-Karp, or Ford-Fulkerson.
+//   - Kernel complexity depends on Algorithm: Dinic, Edmonds-Karp, Ford-Fulkerson,
+//     or push-relabel.
 //
 // Notes:
 //   - AlgorithmDinic is the default because it is usually the strongest general-purpose
@@ -70,6 +74,11 @@ import (
 //   - Use AlgorithmEdmondsKarp when shortest augmenting paths are desired for easier
 //     reasoning/debugging despite weaker asymptotic performance.
 //   - Use AlgorithmFordFulkerson for small, simple, integral-like networks or compatibility.
+//   - Use AlgorithmPushRelabel for dense networks with varied capacities, where
+//     Dinic needs many phases; it reports sink deliveries instead of full
+//     augmenting paths.
+//   - With WithWarmStart, Value includes the seeded flow while Augmentations and
+//     observer events count only the work of this run.
 //
 // AI-Hints:
 //   - Do not move validation into individual public wrappers; MaxFlow is the contract gate.
@@ -82,8 +91,23 @@ func MaxFlow(g *core.Graph, source, sink string, opts ...Option) (*MaxFlowResult
 		return nil, err
 	}
 
+	return runMaxFlow(g, source, sink, cfg)
+}
+
+// runMaxFlow is the MaxFlow pipeline after option assembly.
+// MultiMaxFlow reuses it on its auxiliary super-terminal network.
+//
+// Implementation:
+//   - Stage 1: Validate graph and terminals.
+//   - Stage 2: Build the residual network, seed it from a warm start, and
+//     dispatch to the selected kernel.
+//   - Stage 3: Attribute pair flows to original edge IDs on complete runs.
+//
+// Complexity:
+//   - Same as MaxFlow.
+func runMaxFlow(g *core.Graph, source, sink string, cfg options) (*MaxFlowResult, error) {
 	// Validate graph and terminal laws before any residual network is allocated.
-	if err = validateFlowInput(g, source, sink, cfg); err != nil {
+	if err := validateFlowInput(g, source, sink, cfg); err != nil {
 		return nil, err
 	}
 
@@ -93,28 +117,53 @@ func MaxFlow(g *core.Graph, source, sink string, opts ...Option) (*MaxFlowResult
 		return nil, err
 	}
 
+	// Seed the residual network with the surviving part of a previous flow.
+	seeded := 0.0
+	if cfg.warmStart != nil {
+		if seeded, err = seedWarmStart(g, rn, source, sink, cfg); err != nil {
+			return nil, err
+		}
+	}
+
 	// Dispatch to exactly one kernel. The switch changes algorithmic strategy,
 	// but not input validation, residual construction, or result publication laws.
+	var result *MaxFlowResult
 	switch cfg.algorithm {
 	case AlgorithmDinic:
 		// Dinic builds BFS level graphs and pushes blocking flows through them.
 		// Prefer it for larger networks where repeated shortest-path BFS is expensive.
-		return runDinic(source, sink, rn, cfg)
+		result, err = runDinic(source, sink, rn, cfg)
 
 	case AlgorithmEdmondsKarp:
 		// Edmonds-Karp uses BFS to choose the shortest augmenting path each round.
 		// Prefer it for auditability, deterministic path witnesses, and simpler proofs.
-		return runEdmondsKarp(source, sink, rn, cfg)
+		result, err = runEdmondsKarp(source, sink, rn, cfg)
 
 	case AlgorithmFordFulkerson:
 		// Ford-Fulkerson uses deterministic DFS augmenting paths.
 		// Prefer it only for small or compatibility-oriented networks.
-		return runFordFulkerson(source, sink, rn, cfg)
+		result, err = runFordFulkerson(source, sink, rn, cfg)
+
+	case AlgorithmPushRelabel:
+		// Push-relabel discharges vertex excess with gap and global-relabel heuristics.
+		// Prefer it for dense networks with varied capacities, where Dinic runs many phases.
+		result, err = runPushRelabel(source, sink, rn, cfg)
 
 	default:
 		// This branch is unreachable after applyOptions, but it protects internal misuse.
 		return nil, ErrInvalidOptions
 	}
+	if result != nil {
+		result.Value += seeded
+	}
+	if err != nil || result.Partial {
+		return result, err
+	}
+
+	// Keep the residual network so EdgeFlows can attribute it on demand.
+	result.network, result.epsilon = rn, cfg.epsilon
+
+	return result, nil
 }
 
 // Dinic computes maximum flow through the legacy tuple-return API.
@@ -394,7 +443,8 @@ func CapacityMatrix(g *core.Graph, opts ...Option) (*matrix.Dense, []string, err
 //
 // Notes:
 //   - This helper is intentionally shared by all kernels to keep publication semantics
-//     identical across AlgorithmDinic, AlgorithmEdmondsKarp, and AlgorithmFordFulkerson.
+//     identical across AlgorithmDinic, AlgorithmEdmondsKarp, AlgorithmFordFulkerson,
+//     and AlgorithmPushRelabel.
 //
 // AI-Hints:
 //   - Do not call core.CloneEmpty here; residual networks are mathematically directed.
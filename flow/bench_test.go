@@ -170,6 +170,62 @@ func buildDenseBipartiteBenchmarkGraph(
 	return g
 }
 
+// buildVariedBipartiteBenchmarkGraph builds a dense bipartite network whose
+// middle capacities vary, so the middle layer is the min cut.
+//
+// Implementation:
+//   - Stage 1: Connect S to every left vertex and every right vertex to T with
+//     capacity 50*size, more than any vertex can route through the middle.
+//   - Stage 2: Give L_i->R_j the capacity 1 + (31*i + 17*j) mod 97.
+//
+// Behavior highlights:
+//   - Varied capacities make Dinic run many blocking-flow phases, the regime
+//     push-relabel is meant for; unit capacities keep both kernels on par.
+//
+// Inputs:
+//   - b: benchmark handle.
+//   - size: number of vertices on each side.
+//
+// Returns:
+//   - *core.Graph: deterministic dense bipartite capacity graph.
+//
+// Errors:
+//   - Setup failures call b.Fatalf immediately.
+//
+// Complexity:
+//   - Time O(size^2), Space O(size^2).
+func buildVariedBipartiteBenchmarkGraph(b *testing.B, size int) *core.Graph {
+	b.Helper()
+
+	g, err := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+	if err != nil {
+		b.Fatalf("NewGraph: %v", err)
+	}
+
+	terminal := float64(50 * size)
+	for i := 0; i < size; i++ {
+		leftID, rightID := "L"+strconv.Itoa(i), "R"+strconv.Itoa(i)
+		if _, err = g.AddEdge("S", leftID, terminal); err != nil {
+			b.Fatalf("AddEdge(S,%s): %v", leftID, err)
+		}
+		if _, err = g.AddEdge(rightID, "T", terminal); err != nil {
+			b.Fatalf("AddEdge(%s,T): %v", rightID, err)
+		}
+	}
+
+	for i := 0; i < size; i++ {
+		leftID := "L" + strconv.Itoa(i)
+		for j := 0; j < size; j++ {
+			rightID := "R" + strconv.Itoa(j)
+			if _, err = g.AddEdge(leftID, rightID, float64(1+(31*i+17*j)%97)); err != nil {
+				b.Fatalf("AddEdge(%s,%s): %v", leftID, rightID, err)
+			}
+		}
+	}
+
+	return g
+}
+
 // benchmarkMaxFlow runs MaxFlow in one named algorithm regime.
 // It validates one warm-up run before measuring hot-loop performance.
 //
@@ -283,6 +339,42 @@ func BenchmarkMaxFlow_Dinic_DenseBipartite(b *testing.B) {
 	)
 }
 
+func BenchmarkMaxFlow_PushRelabel_DenseBipartite(b *testing.B) {
+	g := buildDenseBipartiteBenchmarkGraph(b, 64, 64, 1)
+
+	benchmarkMaxFlow(
+		b,
+		g,
+		"S",
+		"T",
+		flow.WithAlgorithm(flow.AlgorithmPushRelabel),
+	)
+}
+
+func BenchmarkMaxFlow_Dinic_VariedBipartite(b *testing.B) {
+	g := buildVariedBipartiteBenchmarkGraph(b, 64)
+
+	benchmarkMaxFlow(
+		b,
+		g,
+		"S",
+		"T",
+		flow.WithAlgorithm(flow.AlgorithmDinic),
+	)
+}
+
+func BenchmarkMaxFlow_PushRelabel_VariedBipartite(b *testing.B) {
+	g := buildVariedBipartiteBenchmarkGraph(b, 64)
+
+	benchmarkMaxFlow(
+		b,
+		g,
+		"S",
+		"T",
+		flow.WithAlgorithm(flow.AlgorithmPushRelabel),
+	)
+}
+
 func BenchmarkCapacityMatrix_DenseBipartite(b *testing.B) {
 	g := buildDenseBipartiteBenchmarkGraph(b, 64, 64, 1)
 
@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package flow implements feasible circulations with lower bounds and demands.
+//
+// The lower bounds are shipped up front, which leaves every vertex with an
+// imbalance. MultiMaxFlow then tries to repair all imbalances at once over the
+// remaining capacities; its min cut is the certificate when it cannot.
+package flow
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// circulationBounds holds the resolved bounds and imbalances of a circulation.
+//
+// AI-Hints:
+//   - excess[v] = demand(v) - (lower-bound inflow - lower-bound outflow): what
+//     v still needs to receive once every lower bound is shipped.
+type circulationBounds struct {
+	lower  map[string]float64
+	upper  map[string]float64
+	demand map[string]float64
+	excess map[string]float64
+}
+
+// Circulation finds a flow meeting every edge bound and vertex demand, or
+// proves that none exists.
+//
+// Implementation:
+//   - Stage 1: Validate options, graph, bounds, and balanced demands.
+//   - Stage 2: Ship every lower bound and record the resulting vertex excesses.
+//   - Stage 3: Run MultiMaxFlow from vertices with surplus to vertices with
+//     deficit over the capacities upper - lower.
+//   - Stage 4: If every deficit is filled, add the lower bounds back to the
+//     edge flows; otherwise turn the min cut into a violated cut.
+//
+// Behavior highlights:
+//   - Upper bounds come from WithCapacity or Edge.Weight; lower bounds from
+//     WithLowerBound (default zero); demands from WithVertexDemands (default
+//     zero, so the default problem is a plain circulation).
+//   - A feasible flow satisfies lower <= flow <= upper on every edge and
+//     inflow - outflow = demand at every vertex.
+//   - Undirected edges carry signed From->To flow within +/-upper and take no
+//     lower bound: |flow| >= lower is not a convex constraint, and splitting the
+//     edge into two arcs would silently pick a direction for the caller. Model
+//     a forced undirected link as a directed edge in the required direction.
+//   - Infeasibility comes with a CirculationCut whose Demand exceeds its
+//     Capacity (Hoffman's condition), which no flow can satisfy.
+//
+// Inputs:
+//   - g: weighted capacity graph, or any graph together with WithCapacity.
+//   - opts: WithLowerBound, WithVertexDemands, and every MaxFlow option.
+//
+// Returns:
+//   - *CirculationResult: EdgeFlows when Feasible, Violation otherwise.
+//
+// Errors:
+//   - ErrInvalidOptions, ErrInvalidEpsilon, ErrNilGraph, ErrUnweightedGraph.
+//   - Capacity errors as in MaxFlow; ErrInvalidBound for bad lower bounds,
+//     including any positive lower bound on an undirected edge.
+//   - ErrInvalidLimit for a demand on a missing vertex; ErrUnbalancedDemands.
+//   - ErrInfeasibleCirculation together with the result carrying Violation.
+//   - ErrAugmentationLimit, ErrObserverFailure, or ctx.Err() together with a
+//     Partial result.
+//
+// Determinism:
+//   - Same as MultiMaxFlow; the cut lists follow core.Vertices() and core.Edges().
+//
+// Complexity:
+//   - O(V + E) around one MultiMaxFlow run.
+//
+// AI-Hints:
+//   - To check supply and demand only, leave WithLowerBound out; to check
+//     minimum staffing only, leave WithVertexDemands out.
+func Circulation(g *core.Graph, opts ...Option) (*CirculationResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateFlowGraphOnly(g, cfg); err != nil {
+		return nil, err
+	}
+
+	b, err := newCirculationBounds(g, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources, sinks []string
+	supplies := make(map[string]float64)
+	demands := make(map[string]float64)
+	required := 0.0
+	for _, vertexID := range g.Vertices() {
+		switch excess := b.excess[vertexID]; {
+		case excess < -cfg.epsilon:
+			sources = append(sources, vertexID)
+			supplies[vertexID] = -excess
+		case excess > cfg.epsilon:
+			sinks = append(sinks, vertexID)
+			demands[vertexID] = excess
+			required += excess
+		}
+	}
+
+	result := &CirculationResult{Algorithm: cfg.algorithm}
+	if len(sources) == 0 || len(sinks) == 0 {
+		// The lower bounds alone already balance every vertex.
+		result.Feasible = true
+		result.EdgeFlows = b.flows(g, nil)
+		return result, nil
+	}
+
+	multiCfg := cfg
+	multiCfg.capacity = func(edge core.Edge) float64 { return b.upper[edge.ID] - b.lower[edge.ID] }
+	multiCfg.supplies = supplies
+	multiCfg.demands = demands
+	multiCfg.vertexCapacities = nil
+
+	multi, err := runMultiMaxFlow(g, sources, sinks, multiCfg)
+	if multi == nil {
+		return nil, err
+	}
+	result.Augmentations = multi.Augmentations
+	result.Partial = multi.Partial
+	if err != nil || multi.Partial {
+		return result, err
+	}
+
+	if required-multi.Value > cfg.epsilon*(1+required) {
+		result.Violation = b.violatedCut(g, multi.CutSinkSide)
+		return result, fmt.Errorf("%w: vertices %v demand %g but can receive at most %g",
+			ErrInfeasibleCirculation, result.Violation.Vertices, result.Violation.Demand, result.Violation.Capacity)
+	}
+
+	result.Feasible = true
+	result.EdgeFlows = b.flows(g, multi.EdgeFlows)
+
+	return result, nil
+}
+
+// newCirculationBounds resolves and validates bounds and demands.
+//
+// Errors:
+//   - Capacity errors from edgeCapacity; ErrInvalidBound; ErrInvalidLimit;
+//     ErrUnbalancedDemands; ctx.Err() on cancellation.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func newCirculationBounds(g *core.Graph, cfg options) (*circulationBounds, error) {
+	edges := g.Edges()
+	b := &circulationBounds{
+		lower:  make(map[string]float64, len(edges)),
+		upper:  make(map[string]float64, len(edges)),
+		demand: make(map[string]float64, len(cfg.vertexDemands)),
+		excess: make(map[string]float64),
+	}
+
+	total, scale := 0.0, 1.0
+	for vertexID, demand := range cfg.vertexDemands {
+		if !g.HasVertex(vertexID) {
+			return nil, fmt.Errorf("flow: demand for missing vertex %q: %w", vertexID, ErrInvalidLimit)
+		}
+		b.demand[vertexID] = demand
+		b.excess[vertexID] = demand
+		total += demand
+		scale += math.Abs(demand)
+	}
+	if math.Abs(total) > cfg.epsilon*scale {
+		return nil, fmt.Errorf("flow: demands sum to %g: %w", total, ErrUnbalancedDemands)
+	}
+
+	for _, edge := range edges {
+		if err := cfg.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		upper, err := edgeCapacity(edge, cfg)
+		if err != nil {
+			return nil, err
+		}
+		lower := 0.0
+		if cfg.lowerBound != nil {
+			lower = cfg.lowerBound(*edge)
+		}
+		if math.IsNaN(lower) || math.IsInf(lower, 0) || lower < -cfg.epsilon || lower > upper+cfg.epsilon {
+			return nil, errors.Join(ErrInvalidBound,
+				fmt.Errorf("flow: edge %q %q->%q has bounds [%v, %v]", edge.ID, edge.From, edge.To, lower, upper))
+		}
+		lower = math.Min(math.Max(lower, 0), upper)
+		if lower <= cfg.epsilon {
+			lower = 0
+		}
+		if lower > 0 && !edge.Directed {
+			return nil, errors.Join(ErrInvalidBound,
+				fmt.Errorf("flow: undirected edge %q has lower bound %v", edge.ID, lower))
+		}
+
+		b.lower[edge.ID] = lower
+		b.upper[edge.ID] = upper
+		if edge.From != edge.To {
+			b.excess[edge.To] -= lower
+			b.excess[edge.From] += lower
+		}
+	}
+
+	return b, nil
+}
+
+// flows adds the lower bounds to the flows found over the remaining capacities.
+// A nil extra means no flow was needed beyond the lower bounds.
+//
+// Complexity:
+//   - Time O(E), Space O(E).
+func (b *circulationBounds) flows(g *core.Graph, extra map[string]float64) map[string]float64 {
+	flows := make(map[string]float64, len(b.lower))
+	for _, edge := range g.Edges() {
+		flows[edge.ID] = b.lower[edge.ID] + extra[edge.ID]
+	}
+
+	return flows
+}
+
+// violatedCut describes why the vertices in inside cannot receive their demand.
+//
+// Implementation:
+//   - Stage 1: Sum the demands of inside.
+//   - Stage 2: Add the upper bounds of edges that can bring flow in.
+//   - Stage 3: Subtract the lower bounds of edges forced to carry flow out.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V).
+func (b *circulationBounds) violatedCut(g *core.Graph, inside []string) *CirculationCut {
+	cut := &CirculationCut{Vertices: append([]string(nil), inside...)}
+	isInside := make(map[string]bool, len(inside))
+	for _, vertexID := range inside {
+		isInside[vertexID] = true
+		cut.Demand += b.demand[vertexID]
+	}
+
+	for _, edge := range g.Edges() {
+		fromInside, toInside := isInside[edge.From], isInside[edge.To]
+		if fromInside == toInside {
+			continue
+		}
+		if toInside || !edge.Directed {
+			if b.upper[edge.ID] > 0 {
+				cut.Capacity += b.upper[edge.ID]
+				cut.InEdges = append(cut.InEdges, edge.ID)
+			}
+			continue
+		}
+		if b.lower[edge.ID] > 0 {
+			cut.Capacity -= b.lower[edge.ID]
+			cut.OutEdges = append(cut.OutEdges, edge.ID)
+		}
+	}
+
+	return cut
+}
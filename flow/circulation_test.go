@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// AI-HINTS (file):
+//   - Both outcomes are checked independently of the solver: a feasible flow
+//     against every bound and demand, a violation by recomputing its Demand
+//     and Capacity from the graph and asserting Demand > Capacity.
+//   - The random test asserts that both outcomes occur, so neither branch is
+//     tested vacuously.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+)
+
+// mustFeasibleCirculation checks bounds and demands of result.EdgeFlows.
+func mustFeasibleCirculation(t *testing.T, g *core.Graph, lower, demand map[string]float64, result *flow.CirculationResult) {
+	t.Helper()
+
+	if !result.Feasible || result.Violation != nil {
+		t.Fatalf("want a feasible result, got %+v", result)
+	}
+	net := make(map[string]float64)
+	for _, edge := range g.Edges() {
+		f := result.EdgeFlows[edge.ID]
+		min := lower[edge.ID]
+		if !edge.Directed {
+			min = -edge.Weight
+		}
+		if f < min-1e-9 || f > edge.Weight+1e-9 {
+			t.Fatalf("edge %s: flow %g outside [%g, %g]", edge.ID, f, min, edge.Weight)
+		}
+		if edge.From != edge.To {
+			net[edge.To] += f
+			net[edge.From] -= f
+		}
+	}
+	for _, vertexID := range g.Vertices() {
+		mustEqualFloat(t, net[vertexID], demand[vertexID], "demand of "+vertexID)
+	}
+}
+
+// mustViolatedCut recomputes the certificate from the graph and checks it.
+func mustViolatedCut(t *testing.T, g *core.Graph, lower, demand map[string]float64, result *flow.CirculationResult) {
+	t.Helper()
+
+	if result.Feasible || result.EdgeFlows != nil || result.Violation == nil {
+		t.Fatalf("want an infeasible result with a violation, got %+v", result)
+	}
+	inside := make(map[string]bool)
+	want := 0.0
+	for _, vertexID := range result.Violation.Vertices {
+		inside[vertexID] = true
+		want += demand[vertexID]
+	}
+	capacity := 0.0
+	for _, edge := range g.Edges() {
+		switch {
+		case inside[edge.From] == inside[edge.To]:
+		case inside[edge.To] || !edge.Directed:
+			capacity += edge.Weight
+		default:
+			capacity -= lower[edge.ID]
+		}
+	}
+
+	mustEqualFloat(t, result.Violation.Demand, want, "violation demand")
+	mustEqualFloat(t, result.Violation.Capacity, capacity, "violation capacity")
+	if want <= capacity+1e-9 {
+		t.Fatalf("cut %v is not violated: demand %g, capacity %g", result.Violation.Vertices, want, capacity)
+	}
+}
+
+func TestCirculation_StaffingRota(t *testing.T) {
+	// Staff flow from the pool through three shifts and back; each shift needs
+	// a minimum and caps a maximum, the pool supplies 7 and the office takes 7.
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	lower := map[string]float64{}
+	add := func(from, to string, min, max float64) {
+		id, err := g.AddEdge(from, to, max)
+		mustNoError(t, err, "AddEdge")
+		lower[id] = min
+	}
+	add("Pool", "Early", 2, 4)
+	add("Pool", "Late", 2, 3)
+	add("Pool", "Night", 1, 2)
+	add("Early", "Office", 0, 4)
+	add("Late", "Office", 0, 3)
+	add("Night", "Office", 0, 2)
+	demand := map[string]float64{"Pool": -7, "Office": 7}
+
+	result, err := flow.Circulation(g,
+		flow.WithLowerBound(func(edge core.Edge) float64 { return lower[edge.ID] }),
+		flow.WithVertexDemands(demand))
+	mustNoError(t, err, "Circulation")
+	mustFeasibleCirculation(t, g, lower, demand, result)
+
+	demand = map[string]float64{"Pool": -10, "Office": 10}
+	result, err = flow.Circulation(g,
+		flow.WithLowerBound(func(edge core.Edge) float64 { return lower[edge.ID] }),
+		flow.WithVertexDemands(demand))
+	mustErrorIs(t, err, flow.ErrInfeasibleCirculation, "over capacity")
+	mustViolatedCut(t, g, lower, demand, result)
+}
+
+func TestCirculation_LowerBoundsAloneCanBeInfeasible(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	lower := map[string]float64{}
+	forced, err := g.AddEdge("A", "B", 10)
+	mustNoError(t, err, "AddEdge")
+	lower[forced] = 5
+	_, err = g.AddEdge("B", "A", 3)
+	mustNoError(t, err, "AddEdge")
+
+	result, err := flow.Circulation(g, flow.WithLowerBound(func(edge core.Edge) float64 { return lower[edge.ID] }))
+	mustErrorIs(t, err, flow.ErrInfeasibleCirculation, "forced cycle")
+	mustViolatedCut(t, g, lower, nil, result)
+	if len(result.Violation.OutEdges) != 1 || result.Violation.OutEdges[0] != forced {
+		t.Fatalf("want the forced edge as the out edge, got %+v", result.Violation)
+	}
+
+	plain, err := flow.Circulation(g)
+	mustNoError(t, err, "without bounds")
+	mustFeasibleCirculation(t, g, nil, nil, plain)
+}
+
+func TestCirculation_UndirectedEdgesTakeNoLowerBound(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted(), core.WithMixedEdges(), core.WithMultiEdges())
+	lower := map[string]float64{}
+	forced, err := g.AddEdge("A", "B", 4)
+	mustNoError(t, err, "AddEdge")
+	lower[forced] = 2
+	back, err := g.AddEdge("A", "B", 4, core.WithEdgeDirected(false))
+	mustNoError(t, err, "AddEdge")
+
+	// A zero bound on the undirected edge is accepted; it carries the return flow From->To negatively.
+	result, err := flow.Circulation(g, flow.WithLowerBound(func(edge core.Edge) float64 { return lower[edge.ID] }))
+	mustNoError(t, err, "directed lower bound only")
+	mustFeasibleCirculation(t, g, lower, nil, result)
+	mustEqualFloat(t, result.EdgeFlows[back], -2, "undirected return flow")
+
+	// A positive bound on the undirected edge is rejected, not split into arcs.
+	lower[back] = 1
+	result, err = flow.Circulation(g, flow.WithLowerBound(func(edge core.Edge) float64 { return lower[edge.ID] }))
+	mustErrorIs(t, err, flow.ErrInvalidBound, "undirected lower bound")
+	if result != nil {
+		t.Fatalf("want no result for an invalid bound, got %+v", result)
+	}
+}
+
+func TestCirculation_RandomNetworksCertifyBothOutcomes(t *testing.T) {
+	rng := rand.New(rand.NewSource(46))
+	feasible, infeasible := 0, 0
+	for trial := 0; trial < 200; trial++ {
+		vertices := 2 + rng.Intn(8)
+		g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), trial%5 != 0)
+
+		lower := make(map[string]float64)
+		for _, edge := range g.Edges() {
+			if edge.Directed && rng.Intn(3) == 0 {
+				lower[edge.ID] = float64(rng.Intn(int(edge.Weight) + 1))
+			}
+		}
+		demand := make(map[string]float64)
+		total := 0.0
+		for v := 0; v < vertices-1; v++ {
+			d := float64(rng.Intn(7) - 3)
+			demand[fmt.Sprintf("v%02d", v)] = d
+			total += d
+		}
+		demand[fmt.Sprintf("v%02d", vertices-1)] = -total
+
+		result, err := flow.Circulation(g,
+			flow.WithLowerBound(func(edge core.Edge) float64 { return lower[edge.ID] }),
+			flow.WithVertexDemands(demand))
+		if errors.Is(err, flow.ErrInfeasibleCirculation) {
+			mustViolatedCut(t, g, lower, demand, result)
+			infeasible++
+			continue
+		}
+		mustNoError(t, err, fmt.Sprintf("trial %d", trial))
+		mustFeasibleCirculation(t, g, lower, demand, result)
+		feasible++
+	}
+	if feasible == 0 || infeasible == 0 {
+		t.Fatalf("want both outcomes, got %d feasible and %d infeasible", feasible, infeasible)
+	}
+}
+
+func TestCirculation_Validation(t *testing.T) {
+	g := buildEnterpriseBackboneProofNetwork(t)
+	undirected := mustGraph(t, core.WithWeighted())
+	mustAddEdge(t, undirected, "A", "B", 4)
+	one := flow.WithLowerBound(func(core.Edge) float64 { return 1 })
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "NilGraph", err: flow.ErrNilGraph, run: func() error {
+			_, err := flow.Circulation(nil)
+			return err
+		}},
+		{name: "NilLowerBound", err: flow.ErrInvalidOptions, run: func() error {
+			_, err := flow.Circulation(g, flow.WithLowerBound(nil))
+			return err
+		}},
+		{name: "NilDemands", err: flow.ErrInvalidOptions, run: func() error {
+			_, err := flow.Circulation(g, flow.WithVertexDemands(nil))
+			return err
+		}},
+		{name: "NaNDemand", err: flow.ErrInvalidLimit, run: func() error {
+			_, err := flow.Circulation(g, flow.WithVertexDemands(map[string]float64{"S": math.NaN()}))
+			return err
+		}},
+		{name: "DemandOnMissingVertex", err: flow.ErrInvalidLimit, run: func() error {
+			_, err := flow.Circulation(g, flow.WithVertexDemands(map[string]float64{"missing": 0}))
+			return err
+		}},
+		{name: "Unbalanced", err: flow.ErrUnbalancedDemands, run: func() error {
+			_, err := flow.Circulation(g, flow.WithVertexDemands(map[string]float64{"S": -2, "T": 1}))
+			return err
+		}},
+		{name: "LowerAboveUpper", err: flow.ErrInvalidBound, run: func() error {
+			_, err := flow.Circulation(g, flow.WithLowerBound(func(core.Edge) float64 { return 1e6 }))
+			return err
+		}},
+		{name: "NegativeLower", err: flow.ErrInvalidBound, run: func() error {
+			_, err := flow.Circulation(g, flow.WithLowerBound(func(core.Edge) float64 { return -1 }))
+			return err
+		}},
+		{name: "UndirectedLower", err: flow.ErrInvalidBound, run: func() error {
+			_, err := flow.Circulation(undirected, one)
+			return err
+		}},
+		{name: "AugmentationLimit", err: flow.ErrAugmentationLimit, run: func() error {
+			result, err := flow.Circulation(g, flow.WithVertexDemands(map[string]float64{"S": -20, "T": 20}),
+				flow.WithAlgorithm(flow.AlgorithmFordFulkerson), flow.WithMaxAugmentations(1))
+			if result == nil || !result.Partial || result.EdgeFlows != nil {
+				return errors.New("want partial result without flows")
+			}
+			return err
+		}},
+		{name: "Canceled", err: context.Canceled, run: func() error {
+			_, err := flow.Circulation(g, flow.WithContext(ctx))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mustErrorIs(t, tt.run(), tt.err, tt.name)
+		})
+	}
+}
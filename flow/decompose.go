@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package flow maps residual flow back onto original edges and decomposes it.
+//
+// Kernels work on aggregated vertex pairs; this file turns their result into
+// per-edge-ID flows and into path and cycle components.
+package flow
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// edgeFlows attributes the final residual network to original edge IDs.
+//
+// Implementation:
+//   - Stage 1: Aggregate the recorded edge capacities per ordered vertex pair.
+//   - Stage 2: The net flow of a pair is its capacity minus its residual.
+//   - Stage 3: Fill the edges able to carry each positive net flow in
+//     core.Edges() order, each up to its own capacity.
+//
+// Behavior highlights:
+//   - Only net flow is attributed: opposite flows on one pair cancel, which
+//     leaves value, conservation, and the cut unchanged.
+//   - The capacity of a pair equals the sum of its edge capacities, so every
+//     net flow is fully attributed.
+//   - Reads rn.edges only; the input graph is not walked again.
+//
+// Inputs:
+//   - epsilon: net flows at or below it are treated as zero.
+//
+// Returns:
+//   - map[string]float64: a fresh caller-owned map with every edge ID.
+//
+// Errors:
+//   - None; capacities were validated by buildResidualNetwork.
+//
+// Determinism:
+//   - Edges are filled in core.Edges() order.
+//
+// Complexity:
+//   - Time O(E), Space O(E).
+func (rn *residualNetwork) edgeFlows(epsilon float64) map[string]float64 {
+	pairCapacity := make(map[[2]string]float64)
+	for _, edge := range rn.edges {
+		if edge.capacity == 0 {
+			continue
+		}
+		pairCapacity[[2]string{edge.from, edge.to}] += edge.capacity
+		if !edge.directed {
+			pairCapacity[[2]string{edge.to, edge.from}] += edge.capacity
+		}
+	}
+
+	remaining := make(map[[2]string]float64, len(pairCapacity))
+	for pair, capacity := range pairCapacity {
+		if net := capacity - rn.cap[pair[0]][pair[1]]; net > epsilon {
+			remaining[pair] = net
+		}
+	}
+
+	flows := make(map[string]float64, len(rn.edges))
+	for _, edge := range rn.edges {
+		flows[edge.id] = 0
+		if edge.capacity == 0 {
+			continue
+		}
+
+		forward := [2]string{edge.from, edge.to}
+		if take := math.Min(edge.capacity, remaining[forward]); take > 0 {
+			flows[edge.id] = take
+			remaining[forward] -= take
+			continue
+		}
+		if edge.directed {
+			continue
+		}
+		backward := [2]string{edge.to, edge.from}
+		if take := math.Min(edge.capacity, remaining[backward]); take > 0 {
+			flows[edge.id] = -take
+			remaining[backward] -= take
+		}
+	}
+
+	return flows
+}
+
+// Decompose splits the result's EdgeFlows into source-sink paths and cycles.
+//
+// Implementation:
+//   - Stage 1: Build the per-edge flows through EdgeFlows.
+//   - Stage 2: Match them against g's edges and delegate to decomposeFlow.
+//
+// Inputs:
+//   - g: the graph passed to MaxFlow.
+//
+// Returns:
+//   - *FlowDecomposition: paths summing to Value, and any cycles.
+//
+// Errors:
+//   - ErrNilResult, ErrNilGraph, ErrNoEdgeFlows (partial results).
+//   - ErrFlowNotConserved if g is not the graph the flow was computed on.
+//
+// Complexity:
+//   - Time O(E * V), Space O(V + E).
+//
+// AI-Hints:
+//   - Paths of a maximum flow are the routes to provision, with their rates.
+func (r *MaxFlowResult) Decompose(g *core.Graph) (*FlowDecomposition, error) {
+	flows, err := r.EdgeFlows()
+	if err != nil {
+		return nil, err
+	}
+
+	return decomposeFlow(g, r.Source, r.Sink, flows)
+}
+
+// Decompose splits the result's EdgeFlows into source-sink paths and cycles.
+//
+// Inputs:
+//   - g: the graph passed to MinCostFlow.
+//
+// Returns:
+//   - *FlowDecomposition: paths summing to Value, and any cycles.
+//
+// Errors:
+//   - ErrNilResult, ErrNilGraph, ErrNoEdgeFlows.
+//   - ErrFlowNotConserved if g is not the graph the flow was computed on.
+//
+// Complexity:
+//   - Time O(E * V), Space O(V + E).
+func (r *MinCostFlowResult) Decompose(g *core.Graph) (*FlowDecomposition, error) {
+	if r == nil {
+		return nil, ErrNilResult
+	}
+
+	return decomposeFlow(g, r.Source, r.Sink, r.EdgeFlows)
+}
+
+// decomposeArc is one loaded direction of an edge during decomposition.
+type decomposeArc struct {
+	edgeID string
+	to     int
+	amount float64
+}
+
+// decomposer holds the loaded arcs and the walk state.
+type decomposer struct {
+	ids       []string
+	arcs      []decomposeArc
+	outgoing  [][]int
+	next      []int
+	tolerance float64
+	result    *FlowDecomposition
+}
+
+// decomposeFlow splits per-edge flows into paths and cycles.
+//
+// Implementation:
+//   - Stage 1: Turn every edge flow above the tolerance into a loaded arc.
+//   - Stage 2: Walk from the source along loaded arcs; reaching the sink closes
+//     a path, revisiting a vertex on the walk closes a cycle. Subtract the
+//     bottleneck and repeat until the source is empty.
+//   - Stage 3: Walk from every other vertex to peel off the remaining cycles.
+//
+// Behavior highlights:
+//   - Each component empties at least one arc, so there are at most E of them.
+//   - The tolerance scales with the largest edge flow to absorb rounding.
+//
+// Errors:
+//   - ErrNilGraph, ErrNoEdgeFlows.
+//   - ErrFlowNotConserved for missing or foreign edge IDs, negative flow on a
+//     directed edge, or a walk stuck at a vertex other than the sink.
+//
+// Complexity:
+//   - Time O(E * V), Space O(V + E).
+func decomposeFlow(g *core.Graph, source, sink string, flows map[string]float64) (*FlowDecomposition, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if flows == nil {
+		return nil, ErrNoEdgeFlows
+	}
+
+	edges := g.Edges()
+	if len(flows) != len(edges) {
+		return nil, fmt.Errorf("%w: %d edge flows for %d edges", ErrFlowNotConserved, len(flows), len(edges))
+	}
+
+	ids := g.Vertices()
+	index := make(map[string]int, len(ids))
+	for position, vertexID := range ids {
+		index[vertexID] = position
+	}
+	from, ok := index[source]
+	if !ok {
+		return nil, fmt.Errorf("%w: source %q not in graph", ErrFlowNotConserved, source)
+	}
+	if _, ok = index[sink]; !ok {
+		return nil, fmt.Errorf("%w: sink %q not in graph", ErrFlowNotConserved, sink)
+	}
+
+	d := &decomposer{
+		ids:      ids,
+		outgoing: make([][]int, len(ids)),
+		next:     make([]int, len(ids)),
+		result:   &FlowDecomposition{},
+	}
+	largest := 0.0
+	for _, edge := range edges {
+		amount, found := flows[edge.ID]
+		if !found {
+			return nil, fmt.Errorf("%w: no flow for edge %q", ErrFlowNotConserved, edge.ID)
+		}
+		largest = math.Max(largest, math.Abs(amount))
+	}
+	d.tolerance = defaultEpsilon * (1 + largest)
+
+	for _, edge := range edges {
+		amount := flows[edge.ID]
+		if edge.From == edge.To || math.Abs(amount) <= d.tolerance {
+			continue
+		}
+		tail, head := index[edge.From], index[edge.To]
+		if amount < 0 {
+			if edge.Directed {
+				return nil, fmt.Errorf("%w: negative flow %g on directed edge %q", ErrFlowNotConserved, amount, edge.ID)
+			}
+			tail, head, amount = head, tail, -amount
+		}
+		d.outgoing[tail] = append(d.outgoing[tail], len(d.arcs))
+		d.arcs = append(d.arcs, decomposeArc{edgeID: edge.ID, to: head, amount: amount})
+	}
+
+	if err := d.walk(from, index[sink]); err != nil {
+		return nil, err
+	}
+	for start := range ids {
+		if err := d.walk(start, -1); err != nil {
+			return nil, err
+		}
+	}
+
+	return d.result, nil
+}
+
+// firstArc returns the first loaded arc leaving v, or -1.
+func (d *decomposer) firstArc(v int) int {
+	for ; d.next[v] < len(d.outgoing[v]); d.next[v]++ {
+		if a := d.outgoing[v][d.next[v]]; d.arcs[a].amount > d.tolerance {
+			return a
+		}
+	}
+
+	return -1
+}
+
+// walk peels components off the arcs reachable from start until start has no
+// loaded arc left. With sink >= 0, reaching sink closes a path.
+func (d *decomposer) walk(start, sink int) error {
+	onWalk := make(map[int]int)
+	vertices := []int{start}
+	var arcs []int
+	onWalk[start] = 0
+
+	for {
+		v := vertices[len(vertices)-1]
+		if v == sink {
+			d.result.Paths = append(d.result.Paths, d.peel(vertices, arcs))
+			vertices, arcs = vertices[:1], arcs[:0]
+			onWalk = map[int]int{start: 0}
+			continue
+		}
+
+		a := d.firstArc(v)
+		if a < 0 {
+			if len(arcs) == 0 {
+				return nil
+			}
+			return fmt.Errorf("%w: flow enters %q but does not leave it", ErrFlowNotConserved, d.ids[v])
+		}
+
+		w := d.arcs[a].to
+		at, seen := onWalk[w]
+		if !seen {
+			onWalk[w] = len(vertices)
+			vertices = append(vertices, w)
+			arcs = append(arcs, a)
+			continue
+		}
+
+		cycleVertices := append(append([]int(nil), vertices[at:]...), w)
+		cycleArcs := append(append([]int(nil), arcs[at:]...), a)
+		d.result.Cycles = append(d.result.Cycles, d.peel(cycleVertices, cycleArcs))
+		for _, dropped := range vertices[at+1:] {
+			delete(onWalk, dropped)
+		}
+		vertices, arcs = vertices[:at+1], arcs[:at]
+	}
+}
+
+// peel subtracts the bottleneck of arcs and returns the component.
+func (d *decomposer) peel(vertices, arcs []int) FlowPath {
+	amount := math.Inf(1)
+	for _, a := range arcs {
+		amount = math.Min(amount, d.arcs[a].amount)
+	}
+
+	component := FlowPath{
+		Vertices: make([]string, len(vertices)),
+		EdgeIDs:  make([]string, len(arcs)),
+		Amount:   amount,
+	}
+	for position, v := range vertices {
+		component.Vertices[position] = d.ids[v]
+	}
+	for position, a := range arcs {
+		component.EdgeIDs[position] = d.arcs[a].edgeID
+		d.arcs[a].amount -= amount
+	}
+
+	return component
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// AI-HINTS (file):
+//   - EdgeFlows is checked edge by edge: bounds, conservation, and the value.
+//     MaxFlowResult builds it on demand, so mustEdgeFlows reads it once.
+//   - Decompositions are replayed: every component must follow real edges, and
+//     the component amounts must rebuild EdgeFlows exactly.
+
+package flow_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+)
+
+// mustEdgeFlows returns the per-edge flows of a complete MaxFlow result.
+func mustEdgeFlows(t *testing.T, result *flow.MaxFlowResult) map[string]float64 {
+	t.Helper()
+
+	flows, err := result.EdgeFlows()
+	mustNoError(t, err, "EdgeFlows")
+
+	return flows
+}
+
+// mustValidEdgeFlows checks that flows is a feasible flow of the given value.
+func mustValidEdgeFlows(t *testing.T, g *core.Graph, flows map[string]float64, source, sink string, value float64) {
+	t.Helper()
+
+	if len(flows) != len(g.Edges()) {
+		t.Fatalf("EdgeFlows has %d entries for %d edges", len(flows), len(g.Edges()))
+	}
+	net := make(map[string]float64)
+	for _, edge := range g.Edges() {
+		f, ok := flows[edge.ID]
+		if !ok {
+			t.Fatalf("EdgeFlows misses edge %s", edge.ID)
+		}
+		if edge.From == edge.To {
+			mustEqualFloat(t, f, 0, "loop "+edge.ID)
+			continue
+		}
+		if (edge.Directed && f < -1e-9) || math.Abs(f) > edge.Weight+1e-9 {
+			t.Fatalf("edge %s (%s->%s, cap %g, directed %v): flow %g", edge.ID, edge.From, edge.To, edge.Weight, edge.Directed, f)
+		}
+		net[edge.From] += f
+		net[edge.To] -= f
+	}
+	for _, vertexID := range g.Vertices() {
+		want := 0.0
+		switch vertexID {
+		case source:
+			want = value
+		case sink:
+			want = -value
+		}
+		mustEqualFloat(t, net[vertexID], want, "net outflow of "+vertexID)
+	}
+}
+
+// mustReplayDecomposition checks every component against g and rebuilds the
+// absolute edge flows from the component amounts.
+func mustReplayDecomposition(t *testing.T, g *core.Graph, d *flow.FlowDecomposition, flows map[string]float64, source, sink string, value float64) {
+	t.Helper()
+
+	edgesByID := make(map[string]*core.Edge)
+	for _, edge := range g.Edges() {
+		edgesByID[edge.ID] = edge
+	}
+	used := make(map[string]float64)
+	replay := func(component flow.FlowPath, kind string) {
+		if component.Amount <= 0 || len(component.Vertices) != len(component.EdgeIDs)+1 {
+			t.Fatalf("%s: malformed %+v", kind, component)
+		}
+		for position, edgeID := range component.EdgeIDs {
+			edge := edgesByID[edgeID]
+			from, to := component.Vertices[position], component.Vertices[position+1]
+			forward := edge.From == from && edge.To == to
+			backward := !edge.Directed && edge.From == to && edge.To == from
+			if !forward && !backward {
+				t.Fatalf("%s: edge %s does not join %s->%s", kind, edgeID, from, to)
+			}
+			used[edgeID] += component.Amount
+		}
+	}
+
+	total := 0.0
+	for _, path := range d.Paths {
+		if path.Vertices[0] != source || path.Vertices[len(path.Vertices)-1] != sink {
+			t.Fatalf("path %v does not join %s to %s", path.Vertices, source, sink)
+		}
+		replay(path, "path")
+		total += path.Amount
+	}
+	for _, cycle := range d.Cycles {
+		if cycle.Vertices[0] != cycle.Vertices[len(cycle.Vertices)-1] {
+			t.Fatalf("cycle %v is not closed", cycle.Vertices)
+		}
+		replay(cycle, "cycle")
+	}
+
+	mustEqualFloat(t, total, value, "path amounts")
+	for edgeID, f := range flows {
+		mustEqualFloat(t, used[edgeID], math.Abs(f), "replayed flow on "+edgeID)
+	}
+}
+
+func TestMaxFlow_AllAlgorithms_EdgeFlowsAndDecomposition(t *testing.T) {
+	for _, tt := range allAlgorithms() {
+		t.Run(tt.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(43))
+			for trial := 0; trial < 40; trial++ {
+				vertices := 2 + rng.Intn(10)
+				g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), trial%3 != 0)
+				source, sink := "v00", fmt.Sprintf("v%02d", vertices-1)
+
+				result, err := flow.MaxFlow(g, source, sink, flow.WithAlgorithm(tt.algorithm))
+				mustNoError(t, err, "MaxFlow")
+				flows := mustEdgeFlows(t, result)
+				mustValidEdgeFlows(t, g, flows, source, sink, result.Value)
+
+				d, err := result.Decompose(g)
+				mustNoError(t, err, "Decompose")
+				mustReplayDecomposition(t, g, d, flows, source, sink, result.Value)
+			}
+		})
+	}
+}
+
+func TestMaxFlow_EdgeFlowsSplitParallelAndUndirectedEdges(t *testing.T) {
+	g := mustGraph(t, core.WithWeighted(), core.WithMultiEdges(), core.WithMixedEdges())
+	first, err := g.AddEdge("S", "A", 3, core.WithEdgeDirected(true))
+	mustNoError(t, err, "AddEdge")
+	second, err := g.AddEdge("S", "A", 4, core.WithEdgeDirected(true))
+	mustNoError(t, err, "AddEdge")
+	undirected, err := g.AddEdge("T", "A", 10)
+	mustNoError(t, err, "AddEdge")
+
+	result, err := flow.MaxFlow(g, "S", "T")
+	mustNoError(t, err, "MaxFlow")
+	mustEqualFloat(t, result.Value, 7, "value")
+	flows := mustEdgeFlows(t, result)
+	mustEqualFloat(t, flows[first], 3, "first parallel edge")
+	mustEqualFloat(t, flows[second], 4, "second parallel edge")
+	mustEqualFloat(t, flows[undirected], -7, "undirected edge runs To->From")
+
+	// Every call builds a fresh map, so callers may mutate what they receive.
+	flows[first] = 0
+	mustEqualFloat(t, mustEdgeFlows(t, result)[first], 3, "first parallel edge after caller mutation")
+}
+
+func TestMinCostFlow_DecompositionReplaysEdgeFlows(t *testing.T) {
+	rng := rand.New(rand.NewSource(44))
+	for trial := 0; trial < 30; trial++ {
+		vertices := 2 + rng.Intn(10)
+		g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), true)
+		source, sink := "v00", fmt.Sprintf("v%02d", vertices-1)
+
+		result, err := flow.MinCostFlow(g, source, sink, flow.WithCost(func(edge core.Edge) float64 { return float64(len(edge.ID) % 3) }))
+		mustNoError(t, err, "MinCostFlow")
+		d, err := result.Decompose(g)
+		mustNoError(t, err, "Decompose")
+		mustReplayDecomposition(t, g, d, result.EdgeFlows, source, sink, result.Value)
+	}
+}
+
+func TestMaxFlow_DecompositionKeepsCycles(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	flows := map[string]float64{}
+	for _, e := range []struct {
+		from, to string
+		amount   float64
+	}{{"S", "A", 2}, {"A", "T", 2}, {"A", "B", 1}, {"B", "C", 1}, {"C", "A", 1}} {
+		id, err := g.AddEdge(e.from, e.to, 5)
+		mustNoError(t, err, "AddEdge")
+		flows[id] = e.amount
+	}
+
+	result := &flow.MinCostFlowResult{Value: 2, Source: "S", Sink: "T", EdgeFlows: flows}
+	d, err := result.Decompose(g)
+	mustNoError(t, err, "Decompose")
+	if len(d.Paths) != 1 || len(d.Cycles) != 1 {
+		t.Fatalf("want one path and one cycle, got %+v", d)
+	}
+	mustReplayDecomposition(t, g, d, flows, "S", "T", 2)
+}
+
+func TestMaxFlow_EdgeFlowsValidation(t *testing.T) {
+	g := buildEnterpriseBackboneProofNetwork(t)
+
+	var nilResult *flow.MaxFlowResult
+	_, err := nilResult.EdgeFlows()
+	mustErrorIs(t, err, flow.ErrNilResult, "nil result")
+
+	partial, _ := flow.MaxFlow(g, "S", "T", flow.WithAlgorithm(flow.AlgorithmFordFulkerson), flow.WithMaxAugmentations(1))
+	if partial == nil || !partial.Partial {
+		t.Fatalf("want a partial result, got %+v", partial)
+	}
+	_, err = partial.EdgeFlows()
+	mustErrorIs(t, err, flow.ErrNoEdgeFlows, "partial result")
+
+	_, err = (&flow.MaxFlowResult{Source: "S", Sink: "T", Value: 1}).EdgeFlows()
+	mustErrorIs(t, err, flow.ErrNoEdgeFlows, "hand-built result")
+}
+
+func TestMaxFlow_DecomposeValidation(t *testing.T) {
+	g := buildEnterpriseBackboneProofNetwork(t)
+	complete, err := flow.MaxFlow(g, "S", "T")
+	mustNoError(t, err, "MaxFlow")
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "NilResult", err: flow.ErrNilResult, run: func() error {
+			var result *flow.MaxFlowResult
+			_, err := result.Decompose(g)
+			return err
+		}},
+		{name: "NilGraph", err: flow.ErrNilGraph, run: func() error {
+			_, err := complete.Decompose(nil)
+			return err
+		}},
+		{name: "Partial", err: flow.ErrNoEdgeFlows, run: func() error {
+			partial, _ := flow.MaxFlow(g, "S", "T", flow.WithAlgorithm(flow.AlgorithmFordFulkerson), flow.WithMaxAugmentations(1))
+			_, err := partial.Decompose(g)
+			return err
+		}},
+		{name: "ForeignGraph", err: flow.ErrFlowNotConserved, run: func() error {
+			_, err := complete.Decompose(mustGraph(t, core.WithDirected(true), core.WithWeighted()))
+			return err
+		}},
+		{name: "Unbalanced", err: flow.ErrFlowNotConserved, run: func() error {
+			broken := &flow.MinCostFlowResult{Source: "S", Sink: "T", EdgeFlows: map[string]float64{}}
+			for edgeID := range mustEdgeFlows(t, complete) {
+				broken.EdgeFlows[edgeID] = 1
+			}
+			_, err := broken.Decompose(g)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mustErrorIs(t, tt.run(), tt.err, tt.name)
+		})
+	}
+}
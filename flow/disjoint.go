@@ -0,0 +1,579 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package flow finds disjoint paths and graph connectivity with unit-capacity flows.
+//
+// By Menger's theorem the largest number of edge- (vertex-) disjoint paths
+// between two vertices equals the smallest edge (vertex) cut separating them.
+// Every function here reuses the MultiMaxFlow network, which already splits
+// capacitated vertices, and reads the paths back through decomposeFlow.
+package flow
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// defaultMinWeightPathCount is Suurballe's setting: a primary route and its backup.
+const defaultMinWeightPathCount = 2
+
+// EdgeDisjointPaths finds paths from source to sink that share no edge.
+//
+// Implementation:
+//   - Stage 1: Validate options, graph, and terminals.
+//   - Stage 2: Give every edge capacity 1 and run the selected MaxFlow kernel.
+//   - Stage 3: Decompose the unit flow into paths and publish the min cut.
+//
+// Behavior highlights:
+//   - Weights are ignored and unweighted graphs are accepted; every edge
+//     carries one path. Directed edges are followed along their direction,
+//     undirected edges either way, and parallel edges count separately.
+//   - Without WithPathCount every disjoint path is returned.
+//   - The input graph is never mutated.
+//
+// Inputs:
+//   - g: any graph.
+//   - source, sink: distinct existing vertex IDs.
+//   - opts: WithPathCount, WithAlgorithm, WithContext, WithEpsilon,
+//     WithObserver, WithMaxAugmentations. Capacity, supply, and demand options
+//     are replaced by unit capacities.
+//
+// Returns:
+//   - *DisjointPathsResult: the paths, MaxPaths, and a cut of MaxPaths edges.
+//
+// Errors:
+//   - ErrInvalidOptions, ErrInvalidEpsilon, ErrNilGraph.
+//   - ErrEmptyTerminal, ErrSameTerminal, ErrSourceNotFound, ErrSinkNotFound.
+//   - ErrInsufficientPaths together with every available path when fewer than
+//     WithPathCount exist.
+//   - ErrAugmentationLimit, ErrObserverFailure, or ctx.Err(); no result.
+//
+// Determinism:
+//   - Fixed by core.Vertices(), core.Edges(), and the kernel's tie-breaking.
+//
+// Complexity:
+//   - One unit-capacity max flow: O(E * min(V^(2/3), sqrt(E))) with Dinic,
+//     plus O(E * V) for the decomposition.
+//
+// AI-Hints:
+//   - MaxPaths == 1 means CutEdges lists a bridge every route depends on.
+func EdgeDisjointPaths(g *core.Graph, source, sink string, opts ...Option) (*DisjointPathsResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runDisjointPaths(g, source, sink, false, cfg)
+}
+
+// VertexDisjointPaths finds paths from source to sink that share no vertex
+// other than the terminals.
+//
+// Implementation:
+//   - Stage 1: Validate options, graph, and terminals.
+//   - Stage 2: Split every inner vertex with throughput 1, leave edges
+//     unbounded except direct source-sink edges, which carry 1 each, and run
+//     the selected MaxFlow kernel.
+//   - Stage 3: Decompose the unit flow into paths and publish the min cut.
+//
+// Behavior highlights:
+//   - As EdgeDisjointPaths for weights, directions, and options.
+//   - Each direct source-sink edge is a path of its own; all others pass
+//     through distinct inner vertices.
+//   - Unbounded edges keep edges out of the cut, so the cut names vertices.
+//
+// Inputs:
+//   - g: any graph.
+//   - source, sink: distinct existing vertex IDs.
+//   - opts: as EdgeDisjointPaths.
+//
+// Returns:
+//   - *DisjointPathsResult: the paths, MaxPaths, and a cut of inner vertices
+//     plus the direct source-sink edges.
+//
+// Errors:
+//   - As EdgeDisjointPaths.
+//
+// Determinism:
+//   - Fixed by core.Vertices(), core.Edges(), and the kernel's tie-breaking.
+//
+// Complexity:
+//   - One max flow on at most 2V+2 vertices and 2E+V arcs, plus O(E * V) for
+//     the decomposition.
+//
+// AI-Hints:
+//   - MaxPaths == 1 means CutVertices names a single point of failure.
+func VertexDisjointPaths(g *core.Graph, source, sink string, opts ...Option) (*DisjointPathsResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runDisjointPaths(g, source, sink, true, cfg)
+}
+
+// runDisjointPaths is the shared pipeline of EdgeDisjointPaths and VertexDisjointPaths.
+//
+// Complexity:
+//   - Same as the callers.
+func runDisjointPaths(g *core.Graph, source, sink string, vertex bool, cfg options) (*DisjointPathsResult, error) {
+	local, err := localConnectivity(g, source, sink, vertex, cfg)
+	if err != nil {
+		return nil, err
+	}
+	decomposition, err := decomposeFlow(g, source, sink, local.EdgeFlows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DisjointPathsResult{
+		Source:      source,
+		Sink:        sink,
+		Paths:       decomposition.Paths,
+		MaxPaths:    int(math.Round(local.Value)),
+		CutEdges:    local.CutEdges,
+		CutVertices: local.CutVertices,
+	}
+	if cfg.pathCount > result.MaxPaths {
+		return result, fmt.Errorf("%w: %d of %d", ErrInsufficientPaths, result.MaxPaths, cfg.pathCount)
+	}
+	if cfg.pathCount > 0 {
+		result.Paths = result.Paths[:cfg.pathCount]
+	}
+
+	return result, nil
+}
+
+// localConnectivity runs the unit-capacity MultiMaxFlow between two vertices.
+//
+// Errors:
+//   - Validation and kernel errors of MultiMaxFlow; a Partial run is dropped.
+//
+// Complexity:
+//   - One max flow, as in MultiMaxFlow.
+func localConnectivity(g *core.Graph, source, sink string, vertex bool, cfg options) (*MultiFlowResult, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+
+	result, err := runMultiMaxFlow(g, []string{source}, []string{sink}, unitNetworkOptions(g, source, sink, vertex, cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// unitNetworkOptions replaces the capacity policy of cfg with the Menger network.
+//
+// Implementation:
+//   - Stage 1: Drop supplies, demands, bounds, and caller capacities.
+//   - Stage 2: Edge-disjoint: every edge has capacity 1.
+//   - Stage 3: Vertex-disjoint: every inner vertex has throughput 1, direct
+//     source-sink edges capacity 1, and every other edge one more than the
+//     edge count, which no cut can afford.
+//
+// Complexity:
+//   - Time O(V) for vertex-disjoint, O(1) otherwise.
+func unitNetworkOptions(g *core.Graph, source, sink string, vertex bool, cfg options) options {
+	unit := cfg
+	unit.supplies, unit.demands, unit.vertexCapacities = nil, nil, nil
+	unit.lowerBound, unit.vertexDemands = nil, nil
+	unit.capacity = func(core.Edge) float64 { return 1 }
+	if !vertex {
+		return unit
+	}
+
+	unlimited := float64(len(g.Edges()) + 1)
+	unit.capacity = func(edge core.Edge) float64 {
+		if edge.From == source && edge.To == sink || !edge.Directed && edge.From == sink && edge.To == source {
+			return 1
+		}
+		return unlimited
+	}
+	unit.vertexCapacities = make(map[string]float64)
+	for _, vertexID := range g.Vertices() {
+		if vertexID != source && vertexID != sink {
+			unit.vertexCapacities[vertexID] = 1
+		}
+	}
+
+	return unit
+}
+
+// EdgeConnectivity computes the edge connectivity of the whole graph: the
+// fewest edges whose removal disconnects it.
+//
+// Implementation:
+//   - Stage 1: Validate options and graph.
+//   - Stage 2: Fix the first vertex r; every cut separates r from some v, so
+//     the minimum over v of the local connectivity r->v (and v->r when some
+//     edge is directed) is the answer.
+//   - Stage 3: Publish the first minimum pair and its cut; stop early at 0.
+//
+// Behavior highlights:
+//   - Mixed and directed graphs give strong edge connectivity.
+//   - Weights are ignored; parallel edges count separately; loops never matter.
+//
+// Inputs:
+//   - g: any graph.
+//   - opts: as EdgeDisjointPaths, without WithPathCount.
+//
+// Returns:
+//   - *ConnectivityResult: Value, the witness pair, and CutEdges.
+//
+// Errors:
+//   - ErrInvalidOptions, ErrInvalidEpsilon, ErrNilGraph.
+//   - ErrAugmentationLimit, ErrObserverFailure, or ctx.Err(); no result.
+//
+// Determinism:
+//   - Pairs are tried in core.Vertices() order.
+//
+// Complexity:
+//   - V-1 unit-capacity max flows, twice that for directed edges.
+//
+// AI-Hints:
+//   - For weighted undirected cuts use mincut.StoerWagner instead.
+func EdgeConnectivity(g *core.Graph, opts ...Option) (*ConnectivityResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+
+	ids := g.Vertices()
+	result := &ConnectivityResult{}
+	if len(ids) < 2 {
+		return result, nil
+	}
+	directed := false
+	for _, edge := range g.Edges() {
+		directed = directed || edge.Directed && edge.From != edge.To
+	}
+
+	found := false
+	for _, other := range ids[1:] {
+		pairs := [][2]string{{ids[0], other}}
+		if directed {
+			pairs = append(pairs, [2]string{other, ids[0]})
+		}
+		for _, pair := range pairs {
+			local, err := localConnectivity(g, pair[0], pair[1], false, cfg)
+			if err != nil {
+				return nil, err
+			}
+			if value := int(math.Round(local.Value)); !found || value < result.Value {
+				found = true
+				result = &ConnectivityResult{Value: value, Source: pair[0], Sink: pair[1], CutEdges: local.CutEdges}
+			}
+			if result.Value == 0 {
+				return result, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// VertexConnectivity computes the vertex connectivity of the whole graph:
+// the fewest vertices whose removal disconnects it.
+//
+// Implementation:
+//   - Stage 1: Validate options and graph; record which ordered pairs are adjacent.
+//   - Stage 2: Even's algorithm: start from k = V-1 and, for i = 0, 1, ... while
+//     i <= k, lower k to the local vertex connectivity between v_i and every
+//     later non-adjacent v_j (both directions when some edge is directed).
+//     A minimum cut misses one of v_0..v_k, so one of these pairs meets it.
+//   - Stage 3: Publish the first minimum pair and its cut; stop early at 0.
+//
+// Behavior highlights:
+//   - A complete graph has connectivity V-1 and no witness.
+//   - Mixed and directed graphs give strong vertex connectivity.
+//   - Weights, loops, and parallel edges are ignored.
+//
+// Inputs:
+//   - g: any graph.
+//   - opts: as EdgeDisjointPaths, without WithPathCount.
+//
+// Returns:
+//   - *ConnectivityResult: Value, the witness pair, and CutVertices.
+//
+// Errors:
+//   - ErrInvalidOptions, ErrInvalidEpsilon, ErrNilGraph.
+//   - ErrAugmentationLimit, ErrObserverFailure, or ctx.Err(); no result.
+//
+// Determinism:
+//   - Pairs are tried in core.Vertices() order.
+//
+// Complexity:
+//   - O(k * V) max flows for the final connectivity k, each on the split
+//     network of VertexDisjointPaths.
+//
+// AI-Hints:
+//   - Value == 1 means CutVertices is an articulation point.
+func VertexConnectivity(g *core.Graph, opts ...Option) (*ConnectivityResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+
+	ids := g.Vertices()
+	if len(ids) < 2 {
+		return &ConnectivityResult{}, nil
+	}
+	adjacent := make(map[[2]string]bool)
+	directed := false
+	for _, edge := range g.Edges() {
+		if edge.From == edge.To {
+			continue
+		}
+		adjacent[[2]string{edge.From, edge.To}] = true
+		if edge.Directed {
+			directed = true
+			continue
+		}
+		adjacent[[2]string{edge.To, edge.From}] = true
+	}
+
+	result := &ConnectivityResult{Value: len(ids) - 1}
+	for i := 0; i < len(ids) && i <= result.Value; i++ {
+		for j := i + 1; j < len(ids); j++ {
+			pairs := [][2]string{{ids[i], ids[j]}}
+			if directed {
+				pairs = append(pairs, [2]string{ids[j], ids[i]})
+			}
+			for _, pair := range pairs {
+				if adjacent[pair] {
+					continue
+				}
+				local, err := localConnectivity(g, pair[0], pair[1], true, cfg)
+				if err != nil {
+					return nil, err
+				}
+				if value := int(math.Round(local.Value)); value < result.Value {
+					result = &ConnectivityResult{Value: value, Source: pair[0], Sink: pair[1], CutVertices: local.CutVertices}
+				}
+				if result.Value == 0 {
+					return result, nil
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// MinWeightEdgeDisjointPaths finds edge-disjoint source-sink paths of minimum
+// total weight: two by default, as in Suurballe's algorithm.
+//
+// Implementation:
+//   - Stage 1: Validate options, graph, terminals, and edge costs.
+//   - Stage 2: Ship k units of flow at minimum cost over unit capacities.
+//     Successive shortest paths with reduced costs is Suurballe's algorithm
+//     for k = 2: the second Dijkstra may cancel part of the first path.
+//   - Stage 3: Decompose the flow into paths and order them by weight.
+//
+// Behavior highlights:
+//   - Costs come from WithCost, or Edge.Weight for weighted graphs, or 1 per
+//     edge (fewest hops) for unweighted ones.
+//   - Directed edges are followed along their direction, undirected edges
+//     either way, and parallel edges count separately.
+//
+// Inputs:
+//   - g: any graph.
+//   - source, sink: distinct existing vertex IDs.
+//   - opts: WithPathCount (default 2), WithCost, WithContext, WithEpsilon,
+//     WithMaxAugmentations.
+//
+// Returns:
+//   - *MinWeightPathsResult: the paths, their weights, and the total.
+//
+// Errors:
+//   - ErrInvalidOptions, ErrInvalidEpsilon, ErrNilGraph.
+//   - ErrEmptyTerminal, ErrSameTerminal, ErrSourceNotFound, ErrSinkNotFound.
+//   - ErrInvalidCost for NaN or Inf costs.
+//   - ErrNegativeCycle for a negative-cost cycle, including any undirected
+//     edge of negative cost.
+//   - ErrInsufficientPaths together with the cheapest set of every available
+//     path when fewer than k exist.
+//   - ErrAugmentationLimit or ctx.Err(); no result.
+//
+// Determinism:
+//   - Fixed by core.Vertices(), core.Edges(), and MinCostFlow's tie-breaking.
+//
+// Complexity:
+//   - k Dijkstra runs, O(k * (V + E) log V), plus O(V * E) when some cost is
+//     negative and O(E * V) for the decomposition.
+//
+// AI-Hints:
+//   - The cheapest pair often avoids the single shortest path; do not build
+//     the backup by deleting the primary and searching again.
+func MinWeightEdgeDisjointPaths(g *core.Graph, source, sink string, opts ...Option) (*MinWeightPathsResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runMinWeightDisjointPaths(g, source, sink, false, cfg)
+}
+
+// MinWeightVertexDisjointPaths finds source-sink paths of minimum total weight
+// that share no vertex other than the terminals: two by default, as in
+// Suurballe's algorithm with split vertices.
+//
+// Implementation:
+//   - Stage 1: Validate options, graph, terminals, and edge costs.
+//   - Stage 2: Split every inner vertex with throughput 1 and ship k units of
+//     flow at minimum cost.
+//   - Stage 3: Decompose the flow into paths and order them by weight.
+//
+// Behavior highlights:
+//   - As MinWeightEdgeDisjointPaths for costs, directions, and options.
+//   - Each direct source-sink edge is a path of its own.
+//
+// Inputs:
+//   - g: any graph.
+//   - source, sink: distinct existing vertex IDs.
+//   - opts: as MinWeightEdgeDisjointPaths.
+//
+// Returns:
+//   - *MinWeightPathsResult: the paths, their weights, and the total.
+//
+// Errors:
+//   - As MinWeightEdgeDisjointPaths.
+//
+// Determinism:
+//   - Fixed by core.Vertices(), core.Edges(), and MinCostFlow's tie-breaking.
+//
+// Complexity:
+//   - As MinWeightEdgeDisjointPaths on at most 2V+2 vertices and 2E+V arcs.
+//
+// AI-Hints:
+//   - Use it for physically diverse routes, where a shared router is as
+//     fatal as a shared link.
+func MinWeightVertexDisjointPaths(g *core.Graph, source, sink string, opts ...Option) (*MinWeightPathsResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runMinWeightDisjointPaths(g, source, sink, true, cfg)
+}
+
+// runMinWeightDisjointPaths is the shared pipeline of the MinWeight variants.
+//
+// Implementation:
+//   - Stage 1: Build the MultiMaxFlow network of unitNetworkOptions.
+//   - Stage 2: Cost every arc by its original edge; auxiliary arcs cost 0.
+//   - Stage 3: Run MinCostFlow's kernel between the super-terminals for k units.
+//   - Stage 4: Fold the arc flows onto original edges and decompose them.
+//
+// Complexity:
+//   - Same as the callers.
+func runMinWeightDisjointPaths(g *core.Graph, source, sink string, vertex bool, cfg options) (*MinWeightPathsResult, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	unit := unitNetworkOptions(g, source, sink, vertex, cfg)
+	if err := validateFlowGraphOnly(g, unit); err != nil {
+		return nil, err
+	}
+	if err := validateTerminalSets(g, []string{source}, []string{sink}, unit); err != nil {
+		return nil, err
+	}
+
+	cost := cfg.cost
+	if cost == nil {
+		cost = func(edge core.Edge) float64 {
+			if g.Weighted() {
+				return edge.Weight
+			}
+			return 1
+		}
+	}
+	n, err := buildMultiNetwork(g, []string{source}, []string{sink}, unit)
+	if err != nil {
+		return nil, err
+	}
+	costs := make(map[string]float64, len(n.carried))
+	for _, edge := range g.Edges() {
+		if !n.carried[edge.ID] {
+			continue
+		}
+		value := cost(*edge)
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, fmt.Errorf("%w: edge %q has cost %v", ErrInvalidCost, edge.ID, value)
+		}
+		costs[edge.ID] = value
+	}
+
+	k := cfg.pathCount
+	if k == 0 {
+		k = defaultMinWeightPathCount
+	}
+	auxCfg := unit
+	auxCfg.capacity = nil
+	auxCfg.cost = func(edge core.Edge) float64 {
+		if arc, ok := n.arcs[edge.ID]; ok {
+			return costs[arc.edgeID]
+		}
+		return 0
+	}
+	auxCfg.flowAmount, auxCfg.hasFlowAmount = float64(k), true
+
+	network, err := newCostNetwork(n.aux, auxCfg)
+	if err != nil {
+		return nil, err
+	}
+	aux, err := network.run(network.index[multiSuperSource], network.index[multiSuperSink], auxCfg)
+	insufficient := errors.Is(err, ErrInsufficientCapacity)
+	if err != nil && !insufficient {
+		return nil, err
+	}
+
+	flows := make(map[string]float64, len(g.Edges()))
+	for _, edge := range g.Edges() {
+		flows[edge.ID] = 0
+	}
+	for auxID, arc := range n.arcs {
+		flows[arc.edgeID] += arc.sign * aux.EdgeFlows[auxID]
+	}
+	decomposition, err := decomposeFlow(g, source, sink, flows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MinWeightPathsResult{Source: source, Sink: sink, Paths: decomposition.Paths}
+	result.Weights = make([]float64, len(result.Paths))
+	for position, path := range result.Paths {
+		for _, edgeID := range path.EdgeIDs {
+			result.Weights[position] += costs[edgeID]
+		}
+	}
+	order := make([]int, len(result.Paths))
+	for position := range order {
+		order[position] = position
+	}
+	sort.SliceStable(order, func(a, b int) bool { return result.Weights[order[a]] < result.Weights[order[b]] })
+	paths, weights := make([]FlowPath, len(order)), make([]float64, len(order))
+	for position, from := range order {
+		paths[position], weights[position] = result.Paths[from], result.Weights[from]
+		result.Weight += weights[position]
+	}
+	result.Paths, result.Weights = paths, weights
+
+	if insufficient {
+		return result, fmt.Errorf("%w: %d of %d", ErrInsufficientPaths, len(result.Paths), k)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,440 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// AI-HINTS (file):
+//   - Disjoint paths are checked by Menger duality: the paths are valid and
+//     disjoint, the published cut separates the terminals, and both have
+//     MaxPaths elements.
+//   - Connectivity and minimum-weight pairs are compared with brute force over
+//     edge subsets, vertex subsets, and simple-path pairs on small graphs.
+
+package flow_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+)
+
+// buildRandomSimple returns a random graph on n vertices with multi-edges.
+func buildRandomSimple(t *testing.T, rng *rand.Rand, n, edges int, directed bool) *core.Graph {
+	t.Helper()
+
+	g := mustGraph(t, core.WithDirected(directed), core.WithWeighted(), core.WithMultiEdges())
+	for v := 0; v < n; v++ {
+		mustAddVertex(t, g, string(rune('a'+v)))
+	}
+	for i := 0; i < edges; i++ {
+		u, v := rng.Intn(n), rng.Intn(n)
+		if u == v {
+			continue
+		}
+		mustAddEdge(t, g, string(rune('a'+u)), string(rune('a'+v)), float64(1+rng.Intn(9)))
+	}
+
+	return g
+}
+
+// connected reports whether sink is reachable from source once the removed
+// edges and vertices are deleted.
+func connected(g *core.Graph, source, sink string, removedEdges, removedVertices map[string]bool) bool {
+	seen := map[string]bool{source: true}
+	queue := []string{source}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		if v == sink {
+			return true
+		}
+		for _, edge := range g.Edges() {
+			if removedEdges[edge.ID] {
+				continue
+			}
+			next := ""
+			switch {
+			case edge.From == v:
+				next = edge.To
+			case !edge.Directed && edge.To == v:
+				next = edge.From
+			}
+			if next == "" || seen[next] || removedVertices[next] {
+				continue
+			}
+			seen[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	return false
+}
+
+// mustDisjointPaths checks that paths are unit source-sink paths along g's
+// edges that share no edge and, for vertex, no inner vertex.
+func mustDisjointPaths(t *testing.T, g *core.Graph, vertex bool, source, sink string, paths []flow.FlowPath) {
+	t.Helper()
+
+	edges := make(map[string]*core.Edge)
+	for _, edge := range g.Edges() {
+		edges[edge.ID] = edge
+	}
+	usedEdges, usedVertices := make(map[string]bool), make(map[string]bool)
+	for _, path := range paths {
+		if path.Amount != 1 || path.Vertices[0] != source || path.Vertices[len(path.Vertices)-1] != sink {
+			t.Fatalf("bad path %+v", path)
+		}
+		for i, edgeID := range path.EdgeIDs {
+			edge, from, to := edges[edgeID], path.Vertices[i], path.Vertices[i+1]
+			if !(edge.From == from && edge.To == to) && (edge.Directed || edge.From != to || edge.To != from) {
+				t.Fatalf("edge %s does not join %s -> %s", edgeID, from, to)
+			}
+			if usedEdges[edgeID] {
+				t.Fatalf("edge %s used twice", edgeID)
+			}
+			usedEdges[edgeID] = true
+		}
+		for _, inner := range path.Vertices[1 : len(path.Vertices)-1] {
+			if vertex && usedVertices[inner] {
+				t.Fatalf("vertex %s used twice", inner)
+			}
+			usedVertices[inner] = true
+		}
+	}
+}
+
+// mustDisjointCertificate checks the paths and the Menger cut of result.
+func mustDisjointCertificate(t *testing.T, g *core.Graph, vertex bool, result *flow.DisjointPathsResult) {
+	t.Helper()
+
+	mustDisjointPaths(t, g, vertex, result.Source, result.Sink, result.Paths)
+	cut := len(result.CutEdges)
+	removedEdges, removedVertices := make(map[string]bool), make(map[string]bool)
+	for _, edgeID := range result.CutEdges {
+		removedEdges[edgeID] = true
+	}
+	for _, vertexID := range result.CutVertices {
+		removedVertices[vertexID] = true
+		cut++
+	}
+	if !vertex && len(result.CutVertices) > 0 {
+		t.Fatalf("edge-disjoint cut names vertices %v", result.CutVertices)
+	}
+	if connected(g, result.Source, result.Sink, removedEdges, removedVertices) {
+		t.Fatalf("cut %v %v does not separate %s from %s", result.CutEdges, result.CutVertices, result.Source, result.Sink)
+	}
+	if len(result.Paths) != result.MaxPaths || cut != result.MaxPaths {
+		t.Fatalf("%d paths, cut of %d, MaxPaths %d", len(result.Paths), cut, result.MaxPaths)
+	}
+}
+
+func TestDisjointPaths_MengerCertificates(t *testing.T) {
+	rng := rand.New(rand.NewSource(49))
+	for trial := 0; trial < 300; trial++ {
+		n := 2 + rng.Intn(8)
+		g := buildRandomSimple(t, rng, n, rng.Intn(4*n), trial%2 == 0)
+		source, sink := "a", string(rune('a'+n-1))
+
+		edgeResult, err := flow.EdgeDisjointPaths(g, source, sink)
+		mustNoError(t, err, "EdgeDisjointPaths")
+		mustDisjointCertificate(t, g, false, edgeResult)
+
+		vertexResult, err := flow.VertexDisjointPaths(g, source, sink, flow.WithAlgorithm(flow.AlgorithmPushRelabel))
+		mustNoError(t, err, "VertexDisjointPaths")
+		mustDisjointCertificate(t, g, true, vertexResult)
+		if vertexResult.MaxPaths > edgeResult.MaxPaths {
+			t.Fatalf("trial %d: %d vertex-disjoint paths but %d edge-disjoint", trial, vertexResult.MaxPaths, edgeResult.MaxPaths)
+		}
+	}
+}
+
+func TestDisjointPaths_PathCount(t *testing.T) {
+	g := mustGraph(t, core.WithWeighted())
+	for _, e := range [][2]string{{"S", "A"}, {"A", "T"}, {"S", "B"}, {"B", "T"}, {"S", "C"}, {"C", "T"}} {
+		mustAddEdge(t, g, e[0], e[1], 1)
+	}
+
+	two, err := flow.VertexDisjointPaths(g, "S", "T", flow.WithPathCount(2))
+	mustNoError(t, err, "VertexDisjointPaths")
+	if len(two.Paths) != 2 || two.MaxPaths != 3 {
+		t.Fatalf("got %d paths of %d", len(two.Paths), two.MaxPaths)
+	}
+
+	four, err := flow.EdgeDisjointPaths(g, "S", "T", flow.WithPathCount(4))
+	mustErrorIs(t, err, flow.ErrInsufficientPaths, "EdgeDisjointPaths")
+	if four == nil || len(four.Paths) != 3 {
+		t.Fatalf("expected every available path with the error, got %+v", four)
+	}
+}
+
+func TestDisjointPaths_DirectEdgesAndSinglePointOfFailure(t *testing.T) {
+	g := mustGraph(t, core.WithWeighted(), core.WithMultiEdges())
+	for _, e := range [][2]string{{"S", "T"}, {"S", "T"}, {"S", "X"}, {"S", "Y"}, {"X", "Hub"}, {"Y", "Hub"}, {"Hub", "T"}} {
+		mustAddEdge(t, g, e[0], e[1], 1)
+	}
+
+	result, err := flow.VertexDisjointPaths(g, "S", "T")
+	mustNoError(t, err, "VertexDisjointPaths")
+	mustDisjointCertificate(t, g, true, result)
+	if result.MaxPaths != 3 || len(result.CutVertices) != 1 || result.CutVertices[0] != "Hub" {
+		t.Fatalf("got MaxPaths %d, cut %v %v", result.MaxPaths, result.CutEdges, result.CutVertices)
+	}
+}
+
+// bruteConnectivity removes every subset of edges (or vertices) in increasing
+// size and returns the first size that breaks strong connectivity.
+func bruteConnectivity(g *core.Graph, vertex bool) int {
+	ids := g.Vertices()
+	var items []string
+	if vertex {
+		items = ids
+	} else {
+		for _, edge := range g.Edges() {
+			items = append(items, edge.ID)
+		}
+	}
+
+	best := len(items)
+	if vertex {
+		best = len(ids) - 1
+	}
+	for mask := 0; mask < 1<<len(items); mask++ {
+		removed, size := make(map[string]bool), 0
+		for i, item := range items {
+			if mask&(1<<i) != 0 {
+				removed[item] = true
+				size++
+			}
+		}
+		if size >= best {
+			continue
+		}
+		for _, u := range ids {
+			for _, v := range ids {
+				if u == v || removed[u] || removed[v] {
+					continue
+				}
+				var broken bool
+				if vertex {
+					broken = !connected(g, u, v, nil, removed)
+				} else {
+					broken = !connected(g, u, v, removed, nil)
+				}
+				if broken {
+					best = size
+				}
+			}
+		}
+	}
+	if len(ids) < 2 {
+		return 0
+	}
+
+	return best
+}
+
+func TestConnectivity_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(50))
+	for trial := 0; trial < 200; trial++ {
+		n := 1 + rng.Intn(6)
+		g := buildRandomSimple(t, rng, n, rng.Intn(3*n+1), trial%3 == 0)
+		if len(g.Edges()) > 12 {
+			continue
+		}
+
+		edge, err := flow.EdgeConnectivity(g)
+		mustNoError(t, err, "EdgeConnectivity")
+		if want := bruteConnectivity(g, false); edge.Value != want {
+			t.Fatalf("trial %d: edge connectivity %d, want %d", trial, edge.Value, want)
+		}
+		if edge.Source != "" {
+			removed := make(map[string]bool)
+			for _, edgeID := range edge.CutEdges {
+				removed[edgeID] = true
+			}
+			if len(edge.CutEdges) != edge.Value || connected(g, edge.Source, edge.Sink, removed, nil) {
+				t.Fatalf("trial %d: edge cut %v does not witness %d", trial, edge.CutEdges, edge.Value)
+			}
+		}
+
+		vertex, err := flow.VertexConnectivity(g)
+		mustNoError(t, err, "VertexConnectivity")
+		if want := bruteConnectivity(g, true); vertex.Value != want {
+			t.Fatalf("trial %d: vertex connectivity %d, want %d", trial, vertex.Value, want)
+		}
+		if vertex.Source != "" {
+			removed := make(map[string]bool)
+			for _, vertexID := range vertex.CutVertices {
+				removed[vertexID] = true
+			}
+			if len(vertex.CutVertices) != vertex.Value || connected(g, vertex.Source, vertex.Sink, nil, removed) {
+				t.Fatalf("trial %d: vertex cut %v does not witness %d", trial, vertex.CutVertices, vertex.Value)
+			}
+		}
+	}
+}
+
+// simplePaths lists every simple source-sink path as edge IDs and vertices.
+func simplePaths(g *core.Graph, source, sink string) []flow.FlowPath {
+	var paths []flow.FlowPath
+	var extend func(vertices, edgeIDs []string)
+	extend = func(vertices, edgeIDs []string) {
+		v := vertices[len(vertices)-1]
+		if v == sink {
+			paths = append(paths, flow.FlowPath{
+				Vertices: append([]string(nil), vertices...),
+				EdgeIDs:  append([]string(nil), edgeIDs...),
+			})
+			return
+		}
+		for _, edge := range g.Edges() {
+			next := ""
+			switch {
+			case edge.From == v:
+				next = edge.To
+			case !edge.Directed && edge.To == v:
+				next = edge.From
+			}
+			onPath := next == ""
+			for _, seen := range vertices {
+				onPath = onPath || seen == next
+			}
+			if !onPath {
+				extend(append(vertices, next), append(edgeIDs, edge.ID))
+			}
+		}
+	}
+	extend([]string{source}, nil)
+
+	return paths
+}
+
+// bruteMinWeightPair returns the cheapest weight of two disjoint simple paths,
+// or +Inf when no pair exists.
+func bruteMinWeightPair(g *core.Graph, source, sink string, vertex bool) float64 {
+	weight := make(map[string]float64)
+	for _, edge := range g.Edges() {
+		weight[edge.ID] = edge.Weight
+	}
+	paths := simplePaths(g, source, sink)
+	best := math.Inf(1)
+	for i := range paths {
+		for j := i + 1; j < len(paths); j++ {
+			shared := make(map[string]bool)
+			for _, edgeID := range paths[i].EdgeIDs {
+				shared[edgeID] = true
+			}
+			if vertex {
+				for _, v := range paths[i].Vertices[1 : len(paths[i].Vertices)-1] {
+					shared[v] = true
+				}
+			}
+			disjoint, total := true, 0.0
+			for _, edgeID := range paths[j].EdgeIDs {
+				disjoint = disjoint && !shared[edgeID]
+			}
+			if vertex {
+				for _, v := range paths[j].Vertices[1 : len(paths[j].Vertices)-1] {
+					disjoint = disjoint && !shared[v]
+				}
+			}
+			for _, path := range []flow.FlowPath{paths[i], paths[j]} {
+				for _, edgeID := range path.EdgeIDs {
+					total += weight[edgeID]
+				}
+			}
+			if disjoint {
+				best = math.Min(best, total)
+			}
+		}
+	}
+
+	return best
+}
+
+func TestMinWeightDisjointPaths_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(51))
+	for trial := 0; trial < 300; trial++ {
+		n := 2 + rng.Intn(6)
+		g := buildRandomSimple(t, rng, n, rng.Intn(3*n), trial%2 == 0)
+		source, sink := "a", string(rune('a'+n-1))
+
+		for _, vertex := range []bool{false, true} {
+			run := flow.MinWeightEdgeDisjointPaths
+			if vertex {
+				run = flow.MinWeightVertexDisjointPaths
+			}
+			result, err := run(g, source, sink)
+			want := bruteMinWeightPair(g, source, sink, vertex)
+			if math.IsInf(want, 1) {
+				mustErrorIs(t, err, flow.ErrInsufficientPaths, "MinWeight")
+				continue
+			}
+			mustNoError(t, err, "MinWeight")
+			mustDisjointPaths(t, g, vertex, source, sink, result.Paths)
+			if len(result.Paths) != 2 {
+				t.Fatalf("trial %d vertex=%v: %d paths", trial, vertex, len(result.Paths))
+			}
+			if math.Abs(result.Weight-want) > 1e-9 || result.Weights[0] > result.Weights[1] {
+				t.Fatalf("trial %d vertex=%v: weight %g %v, want %g", trial, vertex, result.Weight, result.Weights, want)
+			}
+		}
+	}
+}
+
+func TestDisjointPaths_Validation(t *testing.T) {
+	g := mustGraph(t, core.WithWeighted())
+	mustAddEdge(t, g, "A", "B", 1)
+	negative := mustGraph(t, core.WithWeighted())
+	mustAddEdge(t, negative, "A", "B", -1)
+	nan := func(core.Edge) float64 { return math.NaN() }
+
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "NilGraph", err: flow.ErrNilGraph, run: func() error {
+			_, err := flow.EdgeDisjointPaths(nil, "A", "B")
+			return err
+		}},
+		{name: "NilGraphConnectivity", err: flow.ErrNilGraph, run: func() error {
+			_, err := flow.VertexConnectivity(nil)
+			return err
+		}},
+		{name: "SameTerminal", err: flow.ErrSameTerminal, run: func() error {
+			_, err := flow.VertexDisjointPaths(g, "A", "A")
+			return err
+		}},
+		{name: "MissingSink", err: flow.ErrSinkNotFound, run: func() error {
+			_, err := flow.MinWeightVertexDisjointPaths(g, "A", "Z")
+			return err
+		}},
+		{name: "ZeroPathCount", err: flow.ErrInvalidOptions, run: func() error {
+			_, err := flow.EdgeDisjointPaths(g, "A", "B", flow.WithPathCount(0))
+			return err
+		}},
+		{name: "NaNCost", err: flow.ErrInvalidCost, run: func() error {
+			_, err := flow.MinWeightEdgeDisjointPaths(g, "A", "B", flow.WithCost(nan))
+			return err
+		}},
+		{name: "NegativeUndirectedCost", err: flow.ErrNegativeCycle, run: func() error {
+			_, err := flow.MinWeightEdgeDisjointPaths(negative, "A", "B")
+			return err
+		}},
+		{name: "OnePathOnly", err: flow.ErrInsufficientPaths, run: func() error {
+			_, err := flow.MinWeightEdgeDisjointPaths(g, "A", "B")
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -111,6 +111,13 @@
 // DFS path choice can be a poor practical strategy, so WithMaxAugmentations can
 // be used as a safety valve.
 //
+// Push-relabel discharges vertex excess along admissible arcs with highest-label
+// selection, the gap heuristic, and periodic global relabels. It pays off on
+// dense networks with varied capacities, where Dinic needs many blocking-flow
+// phases; on unit-capacity networks the two kernels are close, and residual
+// construction and result publication dominate either run. Its observer events
+// report each push into the sink instead of a full augmenting path.
+//
 // # Complexity
 //
 // Let V be the number of vertices and A be the residual adjacency-entry count.
@@ -125,6 +132,22 @@
 // Ford-Fulkerson has O(A * F) behavior in integral-like regimes, where F is the
 // number of successful augmenting pushes under the chosen capacities.
 //
+// Push-relabel with highest-label selection has O(V^2 * sqrt(A)) worst-case
+// behavior.
+//
+// MinCostFlow costs O(F * (V + A) log V) for F augmenting paths, plus O(V * A)
+// for the Bellman-Ford start when costs are negative.
+//
+// MultiMaxFlow adds O(V + E) for the reduction; its kernel runs on at most
+// 2V + 2 vertices.
+//
+// The disjoint-path functions run one unit-capacity flow. EdgeConnectivity runs
+// V-1 of them (twice that with directed edges); VertexConnectivity runs
+// O(k * V) for connectivity k.
+//
+// WithWarmStart adds O(E * V) to decompose the previous flow; the kernel then
+// pays only for the flow that the changed capacities add back.
+//
 // CapacityMatrix allocates a dense V x V matrix and therefore costs O(V^2) space.
 // It is intended for diagnostics and downstream algebra, not for inner residual
 // update loops.
@@ -142,7 +165,16 @@
 //   - ErrInvalidEpsilon: invalid numeric threshold;
 //   - ErrInvalidCapacity / ErrNegativeCapacity / ErrNaNInf: bad edge capacity;
 //   - ErrAugmentationLimit: configured augmentation limit interrupted a run;
-//   - ErrObserverFailure: observer rejected an augmentation event.
+//   - ErrObserverFailure: observer rejected an augmentation event;
+//   - ErrMissingCost / ErrInvalidCost / ErrInvalidFlowAmount: bad min-cost policy;
+//   - ErrNegativeCycle: negative-cost residual cycle in MinCostFlow;
+//   - ErrInsufficientCapacity: the requested amount exceeds the maximum flow;
+//   - ErrNoEdgeFlows / ErrFlowNotConserved: a result cannot be decomposed;
+//   - ErrDuplicateTerminal / ErrInvalidLimit: bad MultiMaxFlow terminals or limits;
+//   - ErrInvalidBound / ErrUnbalancedDemands: bad Circulation input;
+//   - ErrInfeasibleCirculation: no flow meets the bounds and demands;
+//   - ErrInsufficientPaths: fewer disjoint paths than WithPathCount requested;
+//   - ErrWarmStartMismatch: a WithWarmStart result is partial or has other terminals.
 //
 // Lower-level core errors are preserved with errors.Join where applicable.
 //
@@ -161,9 +193,84 @@
 //	    Min-cut certificate. Summing original capacities crossing from source side
 //	    to sink side yields result.Value.
 //
+//	result.EdgeFlows()
+//	    Flow per original edge ID, built from the final residual network on
+//	    each call. Parallel edges keep separate values and undirected edges
+//	    report the signed net flow From->To.
+//
 //	result.Partial
 //	    True when cancellation, observer failure, or augmentation limit stopped
-//	    the run before optimality was proven.
+//	    the run before optimality was proven. EdgeFlows returns ErrNoEdgeFlows
+//	    in that case.
+//
+// Decompose splits EdgeFlows into Source->Sink paths and closed cycles, each
+// with its amount. Replaying the components rebuilds the absolute edge flows,
+// and the path amounts sum to Value. MinCostFlowResult offers the same method.
+//
+// # Minimum-cost flow
+//
+// MinCostFlow ships a requested amount (WithFlowAmount), or the maximum flow,
+// at minimum total cost. Capacities come from WithCapacity or Edge.Weight and
+// per-unit costs from the required WithCost function, so one graph can carry
+// both attributes. It runs successive shortest paths with Dijkstra on reduced
+// costs; Bellman-Ford initializes the potentials when costs are negative and
+// reports a negative-cost residual cycle as ErrNegativeCycle.
+//
+// MinCostFlowResult publishes the flow per original edge ID, the total cost, and
+// the final potentials, which certify optimality: every residual arc has a
+// non-negative reduced cost.
+//
+// # Multiple terminals and vertex capacities
+//
+// MultiMaxFlow takes a set of sources and a set of sinks. WithSupplies and
+// WithDemands bound what each terminal may emit or absorb, and
+// WithVertexCapacities bounds the throughput of any vertex. The network is
+// reduced to a single-terminal one: a super-source and a super-sink join the
+// terminal sets through their supply and demand arcs, and each capacitated
+// vertex is split into an entry and an exit joined by its throughput arc.
+//
+// MultiFlowResult reports everything in original IDs: per-terminal and per-edge
+// flows, and a min cut made of saturated edges (CutEdges), exhausted vertices
+// (CutVertices), exhausted supplies (CutSupplies), and met demands (CutDemands).
+//
+// # Circulations with bounds and demands
+//
+// Circulation decides whether some flow keeps every edge within
+// [lower, upper] (WithLowerBound, capacity) and gives every vertex exactly
+// its net demand (WithVertexDemands). It ships the lower bounds first and asks
+// MultiMaxFlow to repair the resulting imbalances. When that fails, the min
+// cut becomes a CirculationCut: a vertex set whose demand exceeds the upper
+// bounds entering it minus the lower bounds forced out of it, which proves
+// that no feasible flow exists.
+//
+// # Disjoint paths and connectivity
+//
+// EdgeDisjointPaths and VertexDisjointPaths return the actual paths, as vertex
+// and edge ID lists, between two vertices: every path when WithPathCount is
+// absent. Edges get capacity 1, or, for vertex-disjoint paths, inner vertices
+// get throughput 1 through the MultiMaxFlow vertex split. By Menger's theorem
+// the published min cut has exactly as many edges (vertices) as there are
+// paths, which certifies that no more exist.
+//
+// EdgeConnectivity and VertexConnectivity give the same numbers for the whole
+// graph, with the separating cut of a witness pair. MinWeightEdgeDisjointPaths
+// and MinWeightVertexDisjointPaths find the cheapest k disjoint paths, two by
+// default: successive shortest paths over unit capacities, which for k = 2 is
+// Suurballe's algorithm.
+//
+// # Warm starts
+//
+// WithWarmStart(prev) lets MaxFlow resume from an earlier result on the same
+// edges after capacities change. The previous EdgeFlows are decomposed into
+// Source->Sink paths, each path is kept at the smallest of its amount and the
+// capacity left on its edges, and the kept flow seeds the residual network.
+// Decreases therefore trim only the paths that crossed reduced edges, and
+// increases keep the whole old flow; the selected kernel augments from there.
+// Value counts the seeded flow, while Augmentations counts only new work.
+//
+// To drop an edge, set its capacity to zero instead of removing it: EdgeFlows
+// must still name every edge of the graph, or the run fails with
+// ErrFlowNotConserved.
 //
 // # Matrix integration
 //
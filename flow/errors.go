@@ -51,4 +51,55 @@ var (
 
 	// ErrObserverFailure is returned when an augmentation observer rejects an event.
 	ErrObserverFailure = errors.New("flow: observer failure")
+
+	// ErrMissingCost is returned by MinCostFlow when WithCost was not supplied.
+	ErrMissingCost = errors.New("flow: cost function is required")
+
+	// ErrInvalidCost is returned when an edge cost is NaN or Inf.
+	ErrInvalidCost = errors.New("flow: invalid cost")
+
+	// ErrInvalidFlowAmount is returned when a requested flow amount is negative, NaN, or Inf.
+	ErrInvalidFlowAmount = errors.New("flow: invalid flow amount")
+
+	// ErrInsufficientCapacity is returned when the network cannot carry the requested amount.
+	ErrInsufficientCapacity = errors.New("flow: requested amount exceeds maximum flow")
+
+	// ErrNoEdgeFlows is returned when a result does not carry per-edge flows.
+	ErrNoEdgeFlows = errors.New("flow: per-edge flows are unavailable")
+
+	// ErrFlowNotConserved is returned when per-edge flows do not match the graph
+	// or violate conservation, so they cannot be decomposed.
+	ErrFlowNotConserved = errors.New("flow: edge flows are not a valid flow")
+
+	// ErrDuplicateTerminal is returned when MultiMaxFlow lists a vertex twice in
+	// the same terminal set.
+	ErrDuplicateTerminal = errors.New("flow: duplicate terminal vertex")
+
+	// ErrInvalidLimit is returned when a supply, demand, or vertex capacity is
+	// negative, NaN, Inf, or refers to a vertex it cannot apply to.
+	ErrInvalidLimit = errors.New("flow: invalid supply, demand, or vertex capacity")
+
+	// ErrInvalidBound is returned when an edge lower bound is negative, NaN, Inf,
+	// above the edge capacity, or positive on an undirected edge.
+	ErrInvalidBound = errors.New("flow: invalid lower bound")
+
+	// ErrUnbalancedDemands is returned when Circulation vertex demands do not
+	// sum to zero.
+	ErrUnbalancedDemands = errors.New("flow: vertex demands do not sum to zero")
+
+	// ErrInfeasibleCirculation is returned together with a violated cut when no
+	// flow meets every bound and demand.
+	ErrInfeasibleCirculation = errors.New("flow: no feasible circulation")
+
+	// ErrNegativeCycle is returned when the residual network contains a cycle of
+	// negative total cost, so no finite shortest-path potentials exist.
+	ErrNegativeCycle = errors.New("flow: negative-cost residual cycle")
+
+	// ErrInsufficientPaths is returned together with the best available paths
+	// when fewer disjoint paths exist than WithPathCount requested.
+	ErrInsufficientPaths = errors.New("flow: fewer disjoint paths than requested")
+
+	// ErrWarmStartMismatch is returned when a WithWarmStart result was computed
+	// for other terminals or is partial.
+	ErrWarmStartMismatch = errors.New("flow: warm-start result does not match the run")
 )
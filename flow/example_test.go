@@ -247,3 +247,262 @@ func ExampleCapacityMatrix_regionalBackboneDiagnostics() {
 	// Warsaw->Frankfurt=160
 	// Kyiv->Tbilisi=55
 }
+
+func ExampleMinCostFlow_transitPricing() {
+	// Scenario:
+	// A carrier must ship 150 Gbps from Paris to Vienna. Every link has a
+	// capacity (Edge.Weight, Gbps) and a transit price per Gbps kept in a
+	// separate table, as contracts change independently of the fabric.
+	g, err := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	links := []struct {
+		from     string
+		to       string
+		capacity float64
+		price    float64
+	}{
+		{from: "Paris", to: "Frankfurt", capacity: 100, price: 2},
+		{from: "Paris", to: "Zurich", capacity: 80, price: 3},
+		{from: "Frankfurt", to: "Vienna", capacity: 90, price: 2},
+		{from: "Zurich", to: "Vienna", capacity: 80, price: 4},
+		{from: "Frankfurt", to: "Zurich", capacity: 40, price: 1},
+	}
+
+	price := make(map[string]float64, len(links))
+	for _, link := range links {
+		edgeID, addErr := g.AddEdge(link.from, link.to, link.capacity)
+		if addErr != nil {
+			fmt.Println(addErr)
+			return
+		}
+		price[edgeID] = link.price
+	}
+
+	result, err := flow.MinCostFlow(
+		g,
+		"Paris",
+		"Vienna",
+		flow.WithCost(func(edge core.Edge) float64 { return price[edge.ID] }),
+		flow.WithFlowAmount(150),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("shipped=%g cost=%g\n", result.Value, result.Cost)
+	for _, edge := range g.Edges() {
+		fmt.Printf("%s->%s=%g\n", edge.From, edge.To, result.EdgeFlows[edge.ID])
+	}
+
+	// Output:
+	// shipped=150 cost=780
+	// Paris->Frankfurt=90
+	// Paris->Zurich=60
+	// Frankfurt->Vienna=90
+	// Zurich->Vienna=60
+	// Frankfurt->Zurich=0
+}
+
+func ExampleMultiMaxFlow_cdnOriginsAndCaches() {
+	// Scenario:
+	// Two origin clusters serve three viewer regions through two edge caches.
+	// Origins have a bounded egress budget, each cache has a throughput limit
+	// of its own, and every region has a peak demand (all in Gbps).
+	g, err := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	links := []struct {
+		from     string
+		to       string
+		capacity float64
+	}{
+		{from: "OriginEU", to: "CacheFRA", capacity: 120},
+		{from: "OriginEU", to: "CacheNYC", capacity: 40},
+		{from: "OriginUS", to: "CacheNYC", capacity: 120},
+		{from: "OriginUS", to: "CacheFRA", capacity: 30},
+		{from: "CacheFRA", to: "ViewersEU", capacity: 100},
+		{from: "CacheFRA", to: "ViewersAPAC", capacity: 40},
+		{from: "CacheNYC", to: "ViewersUS", capacity: 100},
+		{from: "CacheNYC", to: "ViewersAPAC", capacity: 40},
+	}
+	for _, link := range links {
+		if _, err = g.AddEdge(link.from, link.to, link.capacity); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+
+	result, err := flow.MultiMaxFlow(
+		g,
+		[]string{"OriginEU", "OriginUS"},
+		[]string{"ViewersEU", "ViewersUS", "ViewersAPAC"},
+		flow.WithSupplies(map[string]float64{"OriginEU": 100, "OriginUS": 150}),
+		flow.WithDemands(map[string]float64{"ViewersEU": 90, "ViewersUS": 80, "ViewersAPAC": 60}),
+		flow.WithVertexCapacities(map[string]float64{"CacheFRA": 100, "CacheNYC": 120}),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("delivered=%g\n", result.Value)
+	for _, region := range result.Sinks {
+		fmt.Printf("%s=%g\n", region, result.SinkFlows[region])
+	}
+	// Demand totals 230, but the two caches pass at most 100 + 120: the min cut
+	// runs through the cache throughput limits, not through any link.
+	fmt.Println("bottleneck caches:", result.CutVertices, "links:", result.CutEdges)
+
+	// Output:
+	// delivered=220
+	// ViewersEU=80
+	// ViewersUS=80
+	// ViewersAPAC=60
+	// bottleneck caches: [CacheFRA CacheNYC] links: []
+}
+
+func ExampleVertexDisjointPaths_redundancyAudit() {
+	// Scenario:
+	// A checkout service talks to its database through a mesh of routers.
+	// The audit wants every independent route and, if there are fewer than
+	// two, the router whose failure takes the service down.
+	g, err := core.NewGraph()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, link := range [][2]string{
+		{"Checkout", "R1"}, {"Checkout", "R2"},
+		{"R1", "Core"}, {"R2", "Core"},
+		{"Core", "R3"}, {"Core", "R4"},
+		{"R3", "DB"}, {"R4", "DB"},
+	} {
+		if _, err = g.AddEdge(link[0], link[1], 0); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+
+	edges, err := flow.EdgeDisjointPaths(g, "Checkout", "DB")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	routers, err := flow.VertexDisjointPaths(g, "Checkout", "DB")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println("link-disjoint routes:", edges.MaxPaths)
+	for _, path := range edges.Paths {
+		fmt.Println(" ", path.Vertices)
+	}
+	fmt.Println("router-disjoint routes:", routers.MaxPaths, "single point of failure:", routers.CutVertices)
+
+	// Output:
+	// link-disjoint routes: 2
+	//   [Checkout R1 Core R3 DB]
+	//   [Checkout R2 Core R4 DB]
+	// router-disjoint routes: 1 single point of failure: [Core]
+}
+
+func ExampleMinWeightEdgeDisjointPaths_primaryAndBackup() {
+	// Scenario:
+	// A carrier needs a primary fibre route and a backup that shares no span
+	// with it (lengths in km). The single shortest route S-A-B-T (3 km) leaves
+	// no backup at all, yet a disjoint pair exists.
+	g, err := core.NewGraph(core.WithWeighted())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, span := range []struct {
+		from, to string
+		km       float64
+	}{
+		{"S", "A", 1}, {"A", "B", 1}, {"B", "T", 1},
+		{"S", "B", 3}, {"A", "T", 3},
+	} {
+		if _, err = g.AddEdge(span.from, span.to, span.km); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+
+	pair, err := flow.MinWeightEdgeDisjointPaths(g, "S", "T")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for i, path := range pair.Paths {
+		fmt.Println(path.Vertices, pair.Weights[i])
+	}
+	fmt.Println("total:", pair.Weight)
+
+	// Output:
+	// [S A T] 4
+	// [S B T] 4
+	// total: 8
+}
+
+func ExampleWithWarmStart_linkDegradation() {
+	// Scenario:
+	// A backbone ships traffic from DC to Edge over two transit routers. When
+	// the DC-R1 link degrades from 8 to 3 Gbps, the previous flow is repaired
+	// instead of recomputed: only the lost traffic is re-routed.
+	backbone := func(dcR1 float64) (*core.Graph, error) {
+		g, err := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range []struct {
+			from, to string
+			gbps     float64
+		}{
+			{"DC", "R1", dcR1}, {"DC", "R2", 10},
+			{"R1", "Edge", 8}, {"R2", "Edge", 6}, {"R2", "R1", 4},
+		} {
+			if _, err = g.AddEdge(link.from, link.to, link.gbps); err != nil {
+				return nil, err
+			}
+		}
+		return g, nil
+	}
+
+	before, err := backbone(8)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	prev, err := flow.MaxFlow(before, "DC", "Edge")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	after, err := backbone(3)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	next, err := flow.MaxFlow(after, "DC", "Edge", flow.WithWarmStart(prev))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("before:", prev.Value)
+	fmt.Println("after:", next.Value, "new augmentations:", next.Augmentations)
+
+	// Output:
+	// before: 14
+	// after: 13 new augmentations: 1
+}
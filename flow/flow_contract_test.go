@@ -23,6 +23,7 @@ func allAlgorithms() []struct {
 		{name: "Dinic", algorithm: flow.AlgorithmDinic},
 		{name: "EdmondsKarp", algorithm: flow.AlgorithmEdmondsKarp},
 		{name: "FordFulkerson", algorithm: flow.AlgorithmFordFulkerson},
+		{name: "PushRelabel", algorithm: flow.AlgorithmPushRelabel},
 	}
 }
 
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package flow benchmarks the private max-flow kernels without the work they share.
+//
+// These benchmarks live in package flow rather than flow_test, because the
+// kernels run on the unexported residualNetwork. MaxFlow benchmarks also time
+// residual construction and result publication, which are the same for every
+// kernel and hide the difference between them.
+package flow
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+var benchmarkKernelValue float64
+
+// benchmarkKernelGraph builds a dense S -> L_i -> R_j -> T network with size
+// vertices per side, terminal capacity on the S and T arcs, and middle(i, j)
+// on L_i->R_j. It mirrors the graphs of the MaxFlow benchmarks.
+func benchmarkKernelGraph(b *testing.B, size int, terminal float64, middle func(i, j int) float64) *core.Graph {
+	b.Helper()
+
+	g, err := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+	if err != nil {
+		b.Fatalf("NewGraph: %v", err)
+	}
+	for i := 0; i < size; i++ {
+		leftID := "L" + strconv.Itoa(i)
+		if _, err = g.AddEdge("S", leftID, terminal); err != nil {
+			b.Fatalf("AddEdge(S,%s): %v", leftID, err)
+		}
+		if _, err = g.AddEdge("R"+strconv.Itoa(i), "T", terminal); err != nil {
+			b.Fatalf("AddEdge(R%d,T): %v", i, err)
+		}
+		for j := 0; j < size; j++ {
+			if _, err = g.AddEdge(leftID, "R"+strconv.Itoa(j), middle(i, j)); err != nil {
+				b.Fatalf("AddEdge(%s,R%d): %v", leftID, j, err)
+			}
+		}
+	}
+
+	return g
+}
+
+// benchmarkKernel times one kernel on a fresh residual network per iteration.
+//
+// Implementation:
+//   - Stage 1: Build the residual network and collect garbage with the timer stopped.
+//   - Stage 2: Time the kernel, which ends by publishing its result.
+//   - Stage 3: Repeat the publication on the final network with the timer
+//     stopped and subtract it, which leaves the kernel-ns/op metric.
+//
+// Behavior highlights:
+//   - ns/op still includes publication; kernel-ns/op is the kernel alone.
+//   - The collector is off while timing, so both measured spans are free of
+//     GC pauses and the subtraction stays stable.
+//
+// AI-Hints:
+//   - Compare kernel-ns/op across kernels; ns/op differences are diluted.
+func benchmarkKernel(b *testing.B, g *core.Graph, algorithm Algorithm) {
+	b.Helper()
+
+	cfg, err := applyOptions(WithAlgorithm(algorithm))
+	if err != nil {
+		b.Fatalf("applyOptions: %v", err)
+	}
+	run := runDinic
+	if algorithm == AlgorithmPushRelabel {
+		run = runPushRelabel
+	}
+
+	defer debug.SetGCPercent(debug.SetGCPercent(-1))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var kernel time.Duration
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		rn, err := buildResidualNetwork(g, cfg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		runtime.GC()
+		b.StartTimer()
+
+		start := time.Now()
+		result, err := run("S", "T", rn, cfg)
+		elapsed := time.Since(start)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.StopTimer()
+		start = time.Now()
+		if _, err = finalizeResult("S", "T", rn, cfg, algorithm, result.Value, result.Augmentations, false); err != nil {
+			b.Fatal(err)
+		}
+		kernel += elapsed - time.Since(start)
+		benchmarkKernelValue = result.Value
+		b.StartTimer()
+	}
+
+	b.ReportMetric(float64(kernel.Nanoseconds())/float64(b.N), "kernel-ns/op")
+}
+
+// unitKernelCapacity matches buildDenseBipartiteBenchmarkGraph(b, 64, 64, 1).
+func unitKernelCapacity(_, _ int) float64 { return 1 }
+
+// variedKernelCapacity matches buildVariedBipartiteBenchmarkGraph(b, 64).
+func variedKernelCapacity(i, j int) float64 { return float64(1 + (31*i+17*j)%97) }
+
+func BenchmarkKernel_Dinic_DenseBipartite(b *testing.B) {
+	benchmarkKernel(b, benchmarkKernelGraph(b, 64, 1, unitKernelCapacity), AlgorithmDinic)
+}
+
+func BenchmarkKernel_PushRelabel_DenseBipartite(b *testing.B) {
+	benchmarkKernel(b, benchmarkKernelGraph(b, 64, 1, unitKernelCapacity), AlgorithmPushRelabel)
+}
+
+func BenchmarkKernel_Dinic_VariedBipartite(b *testing.B) {
+	benchmarkKernel(b, benchmarkKernelGraph(b, 64, 50*64, variedKernelCapacity), AlgorithmDinic)
+}
+
+func BenchmarkKernel_PushRelabel_VariedBipartite(b *testing.B) {
+	benchmarkKernel(b, benchmarkKernelGraph(b, 64, 50*64, variedKernelCapacity), AlgorithmPushRelabel)
+}
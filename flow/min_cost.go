@@ -0,0 +1,428 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package flow implements minimum-cost flow by successive shortest paths.
+//
+// Unlike the max-flow kernels, costs distinguish parallel edges, so this file
+// keeps one arc pair per original edge instead of the aggregated residualNetwork.
+package flow
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// costArc is one residual arc of the min-cost network. Arcs come in pairs:
+// arcs[a^1] is the reverse of arcs[a], with zero capacity and negated cost.
+type costArc struct {
+	to       int
+	capacity float64
+	cost     float64
+}
+
+// costNetwork is the per-edge residual network used by MinCostFlow.
+//
+// AI-Hints:
+//   - edgeArcs[i] lists the forward arcs of edges[i]: one for a directed edge,
+//     From->To then To->From for an undirected edge, none for ignored edges.
+//   - arcEdge[a/2] is the position in edges of the edge behind arc pair a/2.
+type costNetwork struct {
+	ids      []string
+	index    map[string]int
+	arcs     []costArc
+	outgoing [][]int
+	edges    []*core.Edge
+	edgeArcs [][]int
+	arcEdge  []int
+	epsilon  float64
+}
+
+// costItem is one Dijkstra queue entry.
+type costItem struct {
+	dist   float64
+	vertex int
+}
+
+// costQueue is a min-heap of costItem ordered by (dist, vertex).
+type costQueue []costItem
+
+func (q costQueue) Len() int { return len(q) }
+
+func (q costQueue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+
+	return q[i].vertex < q[j].vertex
+}
+
+func (q costQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *costQueue) Push(x any) { *q = append(*q, x.(costItem)) }
+
+func (q *costQueue) Pop() any {
+	old := *q
+	entry := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return entry
+}
+
+// MinCostFlow ships flow from source to sink at minimum total cost.
+//
+// Implementation:
+//   - Stage 1: Validate graph, terminals, and options; WithCost is required.
+//   - Stage 2: Build one residual arc pair per edge direction with its capacity and cost.
+//   - Stage 3: Initialize potentials by Bellman-Ford when any cost is negative,
+//     reporting a negative-cost cycle as ErrNegativeCycle.
+//   - Stage 4: Repeatedly augment along a cheapest path found by Dijkstra on
+//     reduced costs, then shift the potentials by the distances.
+//   - Stage 5: Publish per-edge flows, total cost, and the potentials.
+//
+// Behavior highlights:
+//   - Without WithFlowAmount the result is a minimum-cost maximum flow.
+//   - Capacities come from WithCapacity, or Edge.Weight by default; costs from WithCost.
+//   - Undirected edges are two arcs with the same capacity and cost, as in MaxFlow.
+//   - Loops are ignored: they cannot carry s-t flow.
+//
+// Inputs:
+//   - g: the network; unweighted graphs need WithCapacity.
+//   - source, sink: distinct existing vertices.
+//   - opts: WithCost (required), WithCapacity, WithFlowAmount, WithEpsilon,
+//     WithContext, WithMaxAugmentations. Kernel-selection options are ignored.
+//
+// Returns:
+//   - *MinCostFlowResult: flows, cost, and potentials.
+//
+// Errors:
+//   - ErrNilGraph, ErrEmptyTerminal, ErrSameTerminal, ErrSourceNotFound,
+//     ErrSinkNotFound, ErrUnweightedGraph, ErrInvalidOptions, ErrMissingCost.
+//   - ErrInvalidCapacity with ErrNaNInf or ErrNegativeCapacity; ErrInvalidCost.
+//   - ErrNegativeCycle, wrapped with the cycle's vertex and edge IDs.
+//   - ErrInsufficientCapacity together with the cheapest maximum flow when the
+//     requested amount cannot be shipped.
+//   - ErrAugmentationLimit or ctx.Err() together with a Partial result.
+//
+// Determinism:
+//   - Arcs follow g.Edges() order; Dijkstra ties are broken by vertex order.
+//
+// Complexity:
+//   - Time O(F * (V + A) log V) for F augmentations plus O(V * A) for negative
+//     costs, Space O(V + A), where A is the number of residual arcs.
+//
+// AI-Hints:
+//   - Use MaxFlow first when only feasibility matters; costs never change Value.
+func MinCostFlow(g *core.Graph, source, sink string, opts ...Option) (*MinCostFlowResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateFlowInput(g, source, sink, cfg); err != nil {
+		return nil, err
+	}
+	if cfg.cost == nil {
+		return nil, ErrMissingCost
+	}
+
+	n, err := newCostNetwork(g, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.run(n.index[source], n.index[sink], cfg)
+}
+
+// newCostNetwork snapshots g into paired residual arcs.
+//
+// Errors:
+//   - Capacity errors from edgeCapacity; ErrInvalidCost for NaN or Inf costs.
+//   - ctx.Err() on cancellation.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func newCostNetwork(g *core.Graph, cfg options) (*costNetwork, error) {
+	ids := g.Vertices()
+	n := &costNetwork{
+		ids:      ids,
+		index:    make(map[string]int, len(ids)),
+		outgoing: make([][]int, len(ids)),
+		edges:    g.Edges(),
+		epsilon:  cfg.epsilon,
+	}
+	for position, vertexID := range ids {
+		n.index[vertexID] = position
+	}
+	n.edgeArcs = make([][]int, len(n.edges))
+
+	for position, edge := range n.edges {
+		if err := cfg.ctx.Err(); err != nil {
+			return nil, err
+		}
+		if edge.From == edge.To {
+			continue
+		}
+
+		capacity, err := edgeCapacity(edge, cfg)
+		if err != nil {
+			return nil, err
+		}
+		cost := cfg.cost(*edge)
+		if math.IsNaN(cost) || math.IsInf(cost, 0) {
+			return nil, fmt.Errorf("%w: edge %q has cost %v", ErrInvalidCost, edge.ID, cost)
+		}
+		if capacity == 0 {
+			continue
+		}
+
+		from, to := n.index[edge.From], n.index[edge.To]
+		n.edgeArcs[position] = append(n.edgeArcs[position], n.addArc(from, to, capacity, cost))
+		n.arcEdge = append(n.arcEdge, position)
+		if !edge.Directed {
+			n.edgeArcs[position] = append(n.edgeArcs[position], n.addArc(to, from, capacity, cost))
+			n.arcEdge = append(n.arcEdge, position)
+		}
+	}
+
+	return n, nil
+}
+
+// addArc appends the arc from->to and its reverse and returns the forward index.
+func (n *costNetwork) addArc(from, to int, capacity, cost float64) int {
+	forward := len(n.arcs)
+	n.arcs = append(n.arcs, costArc{to: to, capacity: capacity, cost: cost}, costArc{to: from, cost: -cost})
+	n.outgoing[from] = append(n.outgoing[from], forward)
+	n.outgoing[to] = append(n.outgoing[to], forward+1)
+
+	return forward
+}
+
+// run executes successive shortest paths from source to sink.
+//
+// Implementation:
+//   - Stage 1: Initial potentials: zero, or Bellman-Ford distances with negative costs.
+//   - Stage 2: Dijkstra on reduced costs; stop when the sink is unreachable or
+//     the requested amount is shipped.
+//   - Stage 3: Raise each potential by min(dist[v], dist[sink]), which keeps every
+//     residual reduced cost non-negative, and push the bottleneck.
+//
+// Errors:
+//   - ErrNegativeCycle; ErrInsufficientCapacity; ErrAugmentationLimit; ctx.Err().
+//
+// Complexity:
+//   - Time O(F * (V + A) log V), Space O(V + A).
+func (n *costNetwork) run(source, sink int, cfg options) (*MinCostFlowResult, error) {
+	potential, err := n.initialPotentials()
+	if err != nil {
+		return nil, err
+	}
+
+	target := math.Inf(1)
+	if cfg.hasFlowAmount {
+		target = cfg.flowAmount
+	}
+
+	value, augmentations := 0.0, 0
+	dist := make([]float64, len(n.ids))
+	parent := make([]int, len(n.ids))
+	for target-value > n.epsilon {
+		if err = cfg.ctx.Err(); err != nil {
+			return n.publish(source, sink, value, augmentations, potential, true), err
+		}
+
+		n.shortestPaths(source, potential, dist, parent)
+		if math.IsInf(dist[sink], 1) {
+			break
+		}
+		for v := range potential {
+			potential[v] += math.Min(dist[v], dist[sink])
+		}
+
+		if err = checkAugmentationLimit(augmentations, cfg.maxAugmentations); err != nil {
+			return n.publish(source, sink, value, augmentations, potential, true), err
+		}
+		delta := target - value
+		for v := sink; v != source; v = n.arcs[parent[v]^1].to {
+			delta = math.Min(delta, n.arcs[parent[v]].capacity)
+		}
+		for v := sink; v != source; v = n.arcs[parent[v]^1].to {
+			n.push(parent[v], delta)
+		}
+		value += delta
+		augmentations++
+	}
+
+	result := n.publish(source, sink, value, augmentations, potential, false)
+	if cfg.hasFlowAmount && target-value > n.epsilon {
+		return result, fmt.Errorf("%w: requested %g, maximum %g", ErrInsufficientCapacity, target, value)
+	}
+
+	return result, nil
+}
+
+// push moves delta along arc a, clamping epsilon-sized residues to zero.
+func (n *costNetwork) push(a int, delta float64) {
+	n.arcs[a].capacity -= delta
+	if n.arcs[a].capacity <= n.epsilon {
+		n.arcs[a].capacity = 0
+	}
+	n.arcs[a^1].capacity += delta
+}
+
+// shortestPaths runs Dijkstra from source over residual arcs with reduced
+// costs cost + potential[u] - potential[v], clamped at zero against rounding.
+// parent[v] is the arc entering v on the shortest path.
+//
+// Complexity:
+//   - Time O((V + A) log V), Space O(V + A).
+func (n *costNetwork) shortestPaths(source int, potential, dist []float64, parent []int) {
+	for v := range dist {
+		dist[v] = math.Inf(1)
+		parent[v] = -1
+	}
+	settled := make([]bool, len(dist))
+
+	dist[source] = 0
+	queue := costQueue{{vertex: source}}
+	for queue.Len() > 0 {
+		entry := heap.Pop(&queue).(costItem)
+		u := entry.vertex
+		if settled[u] {
+			continue
+		}
+		settled[u] = true
+
+		for _, a := range n.outgoing[u] {
+			arc := n.arcs[a]
+			if arc.capacity <= n.epsilon || settled[arc.to] {
+				continue
+			}
+			reduced := math.Max(arc.cost+potential[u]-potential[arc.to], 0)
+			if candidate := dist[u] + reduced; candidate < dist[arc.to] {
+				dist[arc.to] = candidate
+				parent[arc.to] = a
+				heap.Push(&queue, costItem{dist: candidate, vertex: arc.to})
+			}
+		}
+	}
+}
+
+// initialPotentials returns zero potentials when no residual arc has a
+// negative cost, and Bellman-Ford distances from a virtual root otherwise.
+//
+// Errors:
+//   - ErrNegativeCycle, wrapped with the cycle's vertex and edge IDs.
+//
+// Complexity:
+//   - Time O(A) without negative costs, O(V * A) with them; Space O(V).
+func (n *costNetwork) initialPotentials() ([]float64, error) {
+	potential := make([]float64, len(n.ids))
+	negative := false
+	for _, arc := range n.arcs {
+		if arc.capacity > n.epsilon && arc.cost < 0 {
+			negative = true
+			break
+		}
+	}
+	if !negative {
+		return potential, nil
+	}
+
+	parent := make([]int, len(n.ids))
+	for v := range parent {
+		parent[v] = -1
+	}
+	for round := 0; round < len(n.ids); round++ {
+		changed := -1
+		for u := range n.outgoing {
+			for _, a := range n.outgoing[u] {
+				arc := n.arcs[a]
+				if arc.capacity <= n.epsilon {
+					continue
+				}
+				if candidate := potential[u] + arc.cost; candidate < potential[arc.to] {
+					potential[arc.to] = candidate
+					parent[arc.to] = a
+					changed = arc.to
+				}
+			}
+		}
+		if changed < 0 {
+			return potential, nil
+		}
+		if round == len(n.ids)-1 {
+			return nil, n.negativeCycle(changed, parent)
+		}
+	}
+
+	return potential, nil
+}
+
+// negativeCycle walks parent arcs back from a vertex relaxed in the last
+// Bellman-Ford round until it enters the cycle, and reports that cycle.
+func (n *costNetwork) negativeCycle(start int, parent []int) error {
+	v := start
+	for range n.ids {
+		v = n.arcs[parent[v]^1].to
+	}
+
+	var vertexIDs, edgeIDs []string
+	for u := v; ; {
+		a := parent[u]
+		vertexIDs = append(vertexIDs, n.ids[u])
+		edgeIDs = append(edgeIDs, n.edges[n.arcEdge[a/2]].ID)
+		u = n.arcs[a^1].to
+		if u == v {
+			break
+		}
+	}
+	vertexIDs = append(vertexIDs, n.ids[v])
+	reverse(vertexIDs)
+	reverse(edgeIDs)
+
+	return fmt.Errorf("%w: vertices %v edges %v", ErrNegativeCycle, vertexIDs, edgeIDs)
+}
+
+// publish assembles a MinCostFlowResult from the current arc state.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func (n *costNetwork) publish(source, sink int, value float64, augmentations int, potential []float64, partial bool) *MinCostFlowResult {
+	result := &MinCostFlowResult{
+		Value:         value,
+		Source:        n.ids[source],
+		Sink:          n.ids[sink],
+		EdgeFlows:     make(map[string]float64, len(n.edges)),
+		Potentials:    make(map[string]float64, len(n.ids)),
+		Augmentations: augmentations,
+		Partial:       partial,
+	}
+
+	for position, edge := range n.edges {
+		flowOnEdge := 0.0
+		for direction, a := range n.edgeArcs[position] {
+			carried := n.arcs[a^1].capacity
+			result.Cost += carried * n.arcs[a].cost
+			if direction == 0 {
+				flowOnEdge += carried
+			} else {
+				flowOnEdge -= carried
+			}
+		}
+		result.EdgeFlows[edge.ID] = flowOnEdge
+	}
+	for position, vertexID := range n.ids {
+		result.Potentials[vertexID] = potential[position]
+	}
+
+	return result
+}
+
+// reverse reverses ids in place.
+func reverse(ids []string) {
+	for left, right := 0, len(ids)-1; left < right; left, right = left+1, right-1 {
+		ids[left], ids[right] = ids[right], ids[left]
+	}
+}
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// AI-HINTS (file):
+//   - Optimality is checked by certificate, not by a second solver: the flow
+//     must be feasible, carry the Dinic value, and leave no negative-cost cycle
+//     in its residual network (Bellman-Ford in mustMinCostCertificate).
+//   - Costs are attached per edge ID through WithCost so parallel edges differ.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+)
+
+// costByID returns a WithCost function reading costs from a map keyed by edge ID.
+func costByID(costs map[string]float64) func(core.Edge) float64 {
+	return func(edge core.Edge) float64 { return costs[edge.ID] }
+}
+
+// mustMinCostCertificate checks feasibility, conservation, the reported cost,
+// and the absence of negative-cost residual cycles.
+func mustMinCostCertificate(t *testing.T, g *core.Graph, costs map[string]float64, result *flow.MinCostFlowResult) {
+	t.Helper()
+
+	type arc struct {
+		from, to       string
+		residual, cost float64
+	}
+	var arcs []arc
+	net := make(map[string]float64)
+	total := 0.0
+
+	for _, edge := range g.Edges() {
+		f, c := result.EdgeFlows[edge.ID], costs[edge.ID]
+		if edge.From == edge.To {
+			mustEqualFloat(t, f, 0, "loop flow "+edge.ID)
+			continue
+		}
+		if edge.Directed {
+			if f < -1e-9 || f > edge.Weight+1e-9 {
+				t.Fatalf("edge %s: flow %g outside [0, %g]", edge.ID, f, edge.Weight)
+			}
+			arcs = append(arcs, arc{edge.From, edge.To, edge.Weight - f, c}, arc{edge.To, edge.From, f, -c})
+		} else {
+			if math.Abs(f) > edge.Weight+1e-9 {
+				t.Fatalf("edge %s: |flow| %g above %g", edge.ID, f, edge.Weight)
+			}
+			forward := math.Max(f, 0)
+			backward := math.Max(-f, 0)
+			arcs = append(arcs,
+				arc{edge.From, edge.To, edge.Weight - forward, c}, arc{edge.To, edge.From, forward, -c},
+				arc{edge.To, edge.From, edge.Weight - backward, c}, arc{edge.From, edge.To, backward, -c})
+		}
+		net[edge.From] += f
+		net[edge.To] -= f
+		total += math.Abs(f) * c
+	}
+
+	mustEqualFloat(t, total, result.Cost, "reported cost")
+	for _, vertexID := range g.Vertices() {
+		switch vertexID {
+		case result.Source:
+			mustEqualFloat(t, net[vertexID], result.Value, "source outflow")
+		case result.Sink:
+			mustEqualFloat(t, net[vertexID], -result.Value, "sink inflow")
+		default:
+			mustEqualFloat(t, net[vertexID], 0, "conservation at "+vertexID)
+		}
+	}
+
+	dist := make(map[string]float64)
+	for round := 0; round <= len(g.Vertices()); round++ {
+		changed := false
+		for _, a := range arcs {
+			if a.residual > 1e-9 && dist[a.from]+a.cost < dist[a.to]-1e-9 {
+				dist[a.to] = dist[a.from] + a.cost
+				changed = true
+			}
+		}
+		if !changed {
+			return
+		}
+	}
+	t.Fatalf("residual network of the reported flow has a negative-cost cycle")
+}
+
+func TestMinCostFlow_PicksCheapestRoutes(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted(), core.WithMultiEdges())
+	costs := map[string]float64{}
+	add := func(from, to string, capacity, cost float64) {
+		id, err := g.AddEdge(from, to, capacity)
+		mustNoError(t, err, "AddEdge")
+		costs[id] = cost
+	}
+	add("S", "A", 4, 1)
+	add("S", "A", 4, 5) // parallel, pricier
+	add("S", "B", 2, 2)
+	add("A", "T", 5, 1)
+	add("B", "T", 3, 1)
+	add("A", "B", 3, 0)
+
+	result, err := flow.MinCostFlow(g, "S", "T", flow.WithCost(costByID(costs)))
+	mustNoError(t, err, "MinCostFlow")
+
+	// Max flow 8 = A->T 5 + B->T 3, routed S-A-T 4 (cost 2 each), S-B-T 2 (3 each),
+	// then the pricier parallel edge: S-A'-T 1 (6) and S-A'-B-T 1 (6).
+	mustEqualFloat(t, result.Value, 8, "value")
+	mustEqualFloat(t, result.Cost, 4*2+2*3+6+6, "cost")
+	mustMinCostCertificate(t, g, costs, result)
+
+	amount, err := flow.MinCostFlow(g, "S", "T", flow.WithCost(costByID(costs)), flow.WithFlowAmount(5))
+	mustNoError(t, err, "MinCostFlow amount")
+	mustEqualFloat(t, amount.Value, 5, "amount value")
+	mustEqualFloat(t, amount.Cost, 4*2+1*3, "amount cost")
+	mustMinCostCertificate(t, g, costs, amount)
+}
+
+func TestMinCostFlow_CancelsFlowThroughReverseArcs(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	costs := map[string]float64{}
+	for _, e := range []struct {
+		from, to string
+		cost     float64
+	}{{"S", "A", 0}, {"A", "B", 5}, {"B", "T", 0}, {"S", "B", 10}, {"A", "T", 10}, {"S", "C", 0}, {"C", "T", 17}} {
+		id, err := g.AddEdge(e.from, e.to, 1)
+		mustNoError(t, err, "AddEdge")
+		costs[id] = e.cost
+	}
+
+	// The second unit is cheapest as S-B-A-T, undoing A->B: 10 - 5 + 10 = 15 < 17.
+	result, err := flow.MinCostFlow(g, "S", "T", flow.WithCost(costByID(costs)), flow.WithFlowAmount(2))
+	mustNoError(t, err, "MinCostFlow")
+	mustEqualFloat(t, result.Cost, 5+15, "cost")
+	mustMinCostCertificate(t, g, costs, result)
+}
+
+func TestMinCostFlow_MatchesMaxFlowAndCertifiesOptimality(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for trial := 0; trial < 60; trial++ {
+		directed := trial%4 != 0
+		vertices := 2 + rng.Intn(10)
+		g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), directed)
+		costs := make(map[string]float64)
+		for _, edge := range g.Edges() {
+			costs[edge.ID] = float64(rng.Intn(10))
+			if directed && rng.Intn(4) == 0 {
+				costs[edge.ID] = -float64(rng.Intn(5)) // may form negative cycles
+			}
+		}
+		source, sink := "v00", fmt.Sprintf("v%02d", vertices-1)
+
+		result, err := flow.MinCostFlow(g, source, sink, flow.WithCost(costByID(costs)))
+		if errors.Is(err, flow.ErrNegativeCycle) {
+			continue
+		}
+		mustNoError(t, err, fmt.Sprintf("trial %d", trial))
+
+		want, err := flow.MaxFlow(g, source, sink)
+		mustNoError(t, err, "MaxFlow oracle")
+		mustEqualFloat(t, result.Value, want.Value, fmt.Sprintf("trial %d value", trial))
+		mustMinCostCertificate(t, g, costs, result)
+	}
+}
+
+func TestMinCostFlow_ReportsNegativeCycle(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	costs := map[string]float64{}
+	for _, e := range []struct {
+		from, to string
+		cost     float64
+	}{{"S", "T", 1}, {"A", "B", -3}, {"B", "C", 1}, {"C", "A", 1}} {
+		id, err := g.AddEdge(e.from, e.to, 1)
+		mustNoError(t, err, "AddEdge")
+		costs[id] = e.cost
+	}
+
+	_, err := flow.MinCostFlow(g, "S", "T", flow.WithCost(costByID(costs)))
+	mustErrorIs(t, err, flow.ErrNegativeCycle, "negative cycle")
+}
+
+func TestMinCostFlow_CapacityAttributeAndShortfall(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true))
+	_, err := g.AddEdge("S", "A", 0)
+	mustNoError(t, err, "AddEdge")
+	_, err = g.AddEdge("A", "T", 0)
+	mustNoError(t, err, "AddEdge")
+
+	capacity := flow.WithCapacity(func(edge core.Edge) float64 {
+		if edge.From == "S" {
+			return 3
+		}
+		return 2
+	})
+	result, err := flow.MinCostFlow(g, "S", "T", capacity,
+		flow.WithCost(func(core.Edge) float64 { return 1 }), flow.WithFlowAmount(5))
+	mustErrorIs(t, err, flow.ErrInsufficientCapacity, "shortfall")
+	if result == nil || result.Partial {
+		t.Fatalf("shortfall must return the complete cheapest maximum flow: %+v", result)
+	}
+	mustEqualFloat(t, result.Value, 2, "shortfall value")
+	mustEqualFloat(t, result.Cost, 4, "shortfall cost")
+}
+
+func TestMinCostFlow_Validation(t *testing.T) {
+	g := buildEnterpriseBackboneProofNetwork(t)
+	unit := flow.WithCost(func(core.Edge) float64 { return 1 })
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "NilGraph", err: flow.ErrNilGraph, run: func() error {
+			_, err := flow.MinCostFlow(nil, "S", "T", unit)
+			return err
+		}},
+		{name: "SameTerminal", err: flow.ErrSameTerminal, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "S", unit)
+			return err
+		}},
+		{name: "MissingCost", err: flow.ErrMissingCost, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "T")
+			return err
+		}},
+		{name: "NilCost", err: flow.ErrInvalidOptions, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "T", flow.WithCost(nil))
+			return err
+		}},
+		{name: "NilCapacity", err: flow.ErrInvalidOptions, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "T", unit, flow.WithCapacity(nil))
+			return err
+		}},
+		{name: "NaNCost", err: flow.ErrInvalidCost, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "T", flow.WithCost(func(core.Edge) float64 { return math.NaN() }))
+			return err
+		}},
+		{name: "NegativeCapacity", err: flow.ErrNegativeCapacity, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "T", unit, flow.WithCapacity(func(core.Edge) float64 { return -1 }))
+			return err
+		}},
+		{name: "BadAmount", err: flow.ErrInvalidFlowAmount, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "T", unit, flow.WithFlowAmount(-1))
+			return err
+		}},
+		{name: "AugmentationLimit", err: flow.ErrAugmentationLimit, run: func() error {
+			result, err := flow.MinCostFlow(g, "S", "T", unit, flow.WithMaxAugmentations(1))
+			if result == nil || !result.Partial {
+				return errors.New("want partial result")
+			}
+			return err
+		}},
+		{name: "Canceled", err: context.Canceled, run: func() error {
+			_, err := flow.MinCostFlow(g, "S", "T", unit, flow.WithContext(ctx))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mustErrorIs(t, tt.run(), tt.err, tt.name)
+		})
+	}
+}
@@ -0,0 +1,443 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package flow implements multi-source, multi-sink flow with vertex capacities.
+//
+// The generalized network is reduced to an ordinary s-t network: a super-source
+// feeds every source through its supply arc, every sink drains into a super-sink
+// through its demand arc, and each capacitated vertex is split into an entry and
+// an exit joined by its throughput arc. The reduced network runs through the
+// shared MaxFlow pipeline, and the result is mapped back to original IDs.
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// Auxiliary vertex IDs. Original vertices are always prefixed, so the bare
+// super-terminal IDs cannot collide with them.
+const (
+	multiSuperSource = "s"
+	multiSuperSink   = "t"
+	multiPlainPrefix = "v:"
+	multiEntryPrefix = "i:"
+	multiExitPrefix  = "o:"
+)
+
+// multiArc maps one auxiliary arc back to the original edge it carries.
+// Sign is -1 for the To->From arc of an undirected edge.
+type multiArc struct {
+	edgeID string
+	sign   float64
+}
+
+// multiNetwork is the auxiliary s-t network behind MultiMaxFlow.
+//
+// AI-Hints:
+//   - entry[v] receives every arc into v; exit[v] emits every arc out of v.
+//     Both are the same plain vertex unless v has a vertex capacity.
+//   - carried marks original edges with a positive capacity, the only ones
+//     that can cross the cut.
+//   - sourceArcs, sinkArcs, and vertexArcs hold auxiliary edge IDs, so their
+//     flows can be read from the auxiliary MaxFlowResult.EdgeFlows().
+type multiNetwork struct {
+	aux *core.Graph
+
+	entry map[string]string
+	exit  map[string]string
+
+	arcs       map[string]multiArc
+	carried    map[string]bool
+	sourceArcs map[string]string
+	sinkArcs   map[string]string
+	vertexArcs map[string]string
+}
+
+// MultiMaxFlow computes the maximum flow from a set of sources to a set of sinks.
+//
+// Implementation:
+//   - Stage 1: Validate options, graph, terminal sets, and limit keys.
+//   - Stage 2: Build the auxiliary network: split capacitated vertices, copy
+//     every edge between exit and entry vertices, and attach super-terminals
+//     through supply and demand arcs.
+//   - Stage 3: Run the selected MaxFlow kernel from super-source to super-sink.
+//   - Stage 4: Map edge flows, terminal flows, and the min cut back to original IDs.
+//
+// Behavior highlights:
+//   - Supplies (WithSupplies), demands (WithDemands), and vertex throughputs
+//     (WithVertexCapacities) are optional; missing entries are unlimited.
+//   - Unlimited arcs get one more than the total edge capacity, which no flow
+//     can saturate, so the numeric policy never sees an infinite capacity.
+//   - With one source, one sink, and no limits, Value equals MaxFlow's.
+//   - Observer events carry original vertex IDs; super-terminals are omitted and
+//     a split vertex appears once.
+//   - The input graph is never mutated.
+//
+// Inputs:
+//   - g: weighted capacity graph, or any graph together with WithCapacity.
+//   - sources, sinks: non-empty, disjoint sets of existing vertex IDs.
+//   - opts: WithSupplies, WithDemands, WithVertexCapacities, and every MaxFlow option.
+//
+// Returns:
+//   - *MultiFlowResult: value, per-terminal and per-edge flows, and the min cut
+//     expressed with original vertices, edges, and limits.
+//
+// Errors:
+//   - ErrInvalidOptions, ErrInvalidEpsilon, ErrNilGraph, ErrUnweightedGraph.
+//   - ErrEmptyTerminal for an empty set or ID; ErrDuplicateTerminal for a vertex
+//     listed twice in one set; ErrSameTerminal for a vertex in both sets.
+//   - ErrSourceNotFound / ErrSinkNotFound for missing terminals.
+//   - ErrInvalidLimit for a supply on a non-source, a demand on a non-sink, or a
+//     vertex capacity on a missing vertex.
+//   - Capacity errors as in MaxFlow; ErrNaNInf also when the capacities of all
+//     edges sum past math.MaxFloat64.
+//   - ErrAugmentationLimit, ErrObserverFailure, or ctx.Err() together with a
+//     Partial result.
+//
+// Determinism:
+//   - Auxiliary vertices follow g.Vertices() and arcs follow g.Edges(); the
+//     kernel's tie-breaking is the same as MaxFlow's.
+//
+// Complexity:
+//   - Building and mapping is O(V + E). The kernel runs on at most 2V+2 vertices
+//     and 2E+2V arcs.
+//
+// AI-Hints:
+//   - A vertex capacity on a terminal bounds its total throughput; a supply or
+//     demand bounds only what the super-terminal arc adds.
+//   - The certificate: Value equals the sum of CutEdges capacities, CutVertices
+//     throughputs, CutSupplies supplies, and CutDemands demands.
+func MultiMaxFlow(g *core.Graph, sources, sinks []string, opts ...Option) (*MultiFlowResult, error) {
+	cfg, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runMultiMaxFlow(g, sources, sinks, cfg)
+}
+
+// runMultiMaxFlow is the MultiMaxFlow pipeline after option assembly.
+// Circulation reuses it with its own capacities, supplies, and demands.
+//
+// Complexity:
+//   - Same as MultiMaxFlow.
+func runMultiMaxFlow(g *core.Graph, sources, sinks []string, cfg options) (*MultiFlowResult, error) {
+	err := validateFlowGraphOnly(g, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateTerminalSets(g, sources, sinks, cfg); err != nil {
+		return nil, err
+	}
+
+	n, err := buildMultiNetwork(g, sources, sinks, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Capacities are already resolved into auxiliary weights, and a warm start
+	// belongs to single-pair runs on the original graph.
+	auxCfg := cfg
+	auxCfg.capacity = nil
+	auxCfg.warmStart = nil
+	if cfg.observer != nil {
+		auxCfg.observer = n.translateObserver(cfg.observer)
+	}
+
+	aux, err := runMaxFlow(n.aux, multiSuperSource, multiSuperSink, auxCfg)
+	if aux == nil {
+		return nil, err
+	}
+	result := &MultiFlowResult{
+		Value:         aux.Value,
+		Sources:       append([]string(nil), sources...),
+		Sinks:         append([]string(nil), sinks...),
+		Algorithm:     aux.Algorithm,
+		Augmentations: aux.Augmentations,
+		Partial:       aux.Partial,
+	}
+	if err != nil || aux.Partial {
+		return result, err
+	}
+
+	flows, err := aux.EdgeFlows()
+	if err != nil {
+		return nil, err
+	}
+	n.publish(g, aux, flows, result)
+
+	return result, nil
+}
+
+// validateTerminalSets checks the terminal sets and the keys of every limit map.
+//
+// Errors:
+//   - ErrEmptyTerminal, ErrDuplicateTerminal, ErrSameTerminal,
+//     ErrSourceNotFound, ErrSinkNotFound, ErrInvalidLimit.
+//
+// Complexity:
+//   - Time O(S + T + k), Space O(S + T).
+func validateTerminalSets(g *core.Graph, sources, sinks []string, cfg options) error {
+	if len(sources) == 0 || len(sinks) == 0 {
+		return ErrEmptyTerminal
+	}
+
+	isSource := make(map[string]bool, len(sources))
+	for _, vertexID := range sources {
+		if vertexID == "" {
+			return errors.Join(ErrEmptyTerminal, core.ErrEmptyVertexID)
+		}
+		if isSource[vertexID] {
+			return fmt.Errorf("flow: source %q: %w", vertexID, ErrDuplicateTerminal)
+		}
+		if !g.HasVertex(vertexID) {
+			return errors.Join(ErrSourceNotFound, core.ErrVertexNotFound, fmt.Errorf("flow: source %q", vertexID))
+		}
+		isSource[vertexID] = true
+	}
+
+	isSink := make(map[string]bool, len(sinks))
+	for _, vertexID := range sinks {
+		if vertexID == "" {
+			return errors.Join(ErrEmptyTerminal, core.ErrEmptyVertexID)
+		}
+		if isSink[vertexID] {
+			return fmt.Errorf("flow: sink %q: %w", vertexID, ErrDuplicateTerminal)
+		}
+		if isSource[vertexID] {
+			return fmt.Errorf("flow: vertex %q is a source and a sink: %w", vertexID, ErrSameTerminal)
+		}
+		if !g.HasVertex(vertexID) {
+			return errors.Join(ErrSinkNotFound, core.ErrVertexNotFound, fmt.Errorf("flow: sink %q", vertexID))
+		}
+		isSink[vertexID] = true
+	}
+
+	for vertexID := range cfg.supplies {
+		if !isSource[vertexID] {
+			return fmt.Errorf("flow: supply for non-source %q: %w", vertexID, ErrInvalidLimit)
+		}
+	}
+	for vertexID := range cfg.demands {
+		if !isSink[vertexID] {
+			return fmt.Errorf("flow: demand for non-sink %q: %w", vertexID, ErrInvalidLimit)
+		}
+	}
+	for vertexID := range cfg.vertexCapacities {
+		if !g.HasVertex(vertexID) {
+			return fmt.Errorf("flow: vertex capacity for missing vertex %q: %w", vertexID, ErrInvalidLimit)
+		}
+	}
+
+	return nil
+}
+
+// buildMultiNetwork builds the auxiliary super-terminal network.
+//
+// Errors:
+//   - Capacity errors from edgeCapacity; core errors from the auxiliary graph.
+//   - ErrNaNInf if the total edge capacity overflows float64, since unlimited
+//     terminal arcs could then no longer outlast every finite cut.
+//   - ctx.Err() on cancellation.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func buildMultiNetwork(g *core.Graph, sources, sinks []string, cfg options) (*multiNetwork, error) {
+	aux, err := core.NewGraph(core.WithDirected(true), core.WithWeighted(), core.WithMultiEdges())
+	if err != nil {
+		return nil, err
+	}
+	n := &multiNetwork{
+		aux:        aux,
+		entry:      make(map[string]string),
+		exit:       make(map[string]string),
+		arcs:       make(map[string]multiArc),
+		carried:    make(map[string]bool),
+		sourceArcs: make(map[string]string, len(sources)),
+		sinkArcs:   make(map[string]string, len(sinks)),
+		vertexArcs: make(map[string]string, len(cfg.vertexCapacities)),
+	}
+
+	for _, vertexID := range g.Vertices() {
+		limit, split := cfg.vertexCapacities[vertexID]
+		if !split {
+			n.entry[vertexID] = multiPlainPrefix + vertexID
+			n.exit[vertexID] = n.entry[vertexID]
+			if err = aux.AddVertex(n.entry[vertexID]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		n.entry[vertexID] = multiEntryPrefix + vertexID
+		n.exit[vertexID] = multiExitPrefix + vertexID
+		if n.vertexArcs[vertexID], err = aux.AddEdge(n.entry[vertexID], n.exit[vertexID], limit); err != nil {
+			return nil, err
+		}
+	}
+
+	// Unlimited terminal arcs must outlast any cut made of finite arcs.
+	unlimited := 1.0
+	for _, edge := range g.Edges() {
+		if err = cfg.ctx.Err(); err != nil {
+			return nil, err
+		}
+		if edge.From == edge.To {
+			continue
+		}
+
+		capacity, err := edgeCapacity(edge, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if capacity == 0 {
+			continue
+		}
+		unlimited += capacity
+		n.carried[edge.ID] = true
+
+		if err = n.addEdgeArc(n.exit[edge.From], n.entry[edge.To], capacity, multiArc{edgeID: edge.ID, sign: 1}); err != nil {
+			return nil, err
+		}
+		if !edge.Directed {
+			if err = n.addEdgeArc(n.exit[edge.To], n.entry[edge.From], capacity, multiArc{edgeID: edge.ID, sign: -1}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if math.IsInf(unlimited, 1) {
+		return nil, fmt.Errorf("flow: total edge capacity overflows float64: %w", ErrNaNInf)
+	}
+
+	for _, vertexID := range sources {
+		supply, limited := cfg.supplies[vertexID]
+		if !limited {
+			supply = unlimited
+		}
+		if n.sourceArcs[vertexID], err = aux.AddEdge(multiSuperSource, n.entry[vertexID], supply); err != nil {
+			return nil, err
+		}
+	}
+	for _, vertexID := range sinks {
+		demand, limited := cfg.demands[vertexID]
+		if !limited {
+			demand = unlimited
+		}
+		if n.sinkArcs[vertexID], err = aux.AddEdge(n.exit[vertexID], multiSuperSink, demand); err != nil {
+			return nil, err
+		}
+	}
+
+	return n, nil
+}
+
+// addEdgeArc adds one auxiliary arc carrying an original edge.
+func (n *multiNetwork) addEdgeArc(from, to string, capacity float64, arc multiArc) error {
+	auxID, err := n.aux.AddEdge(from, to, capacity)
+	if err != nil {
+		return err
+	}
+	n.arcs[auxID] = arc
+
+	return nil
+}
+
+// originalID strips the auxiliary prefix from a non-terminal auxiliary vertex ID.
+func originalID(auxID string) string {
+	return auxID[len(multiPlainPrefix):]
+}
+
+// translateObserver wraps an observer so that event paths use original IDs.
+//
+// Complexity:
+//   - O(path length) per event.
+func (n *multiNetwork) translateObserver(observer AugmentationObserver) AugmentationObserver {
+	return func(ctx context.Context, event AugmentationEvent) error {
+		path := make([]string, 0, len(event.Path))
+		for _, auxID := range event.Path {
+			if auxID == multiSuperSource || auxID == multiSuperSink {
+				continue
+			}
+			vertexID := originalID(auxID)
+			if len(path) > 0 && path[len(path)-1] == vertexID {
+				continue // entry->exit arc of a split vertex
+			}
+			path = append(path, vertexID)
+		}
+		event.Path = path
+
+		return observer(ctx, event)
+	}
+}
+
+// publish maps a complete auxiliary result onto the original graph.
+//
+// Implementation:
+//   - Stage 1: Sum auxiliary arc flows into signed per-edge flows.
+//   - Stage 2: Read terminal flows from the super-terminal arcs.
+//   - Stage 3: Place each vertex by its entry vertex; a vertex whose entry is
+//     on the source side and exit on the sink side is a cut vertex.
+//   - Stage 4: Collect the original edges, supplies, and demands crossing the cut.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func (n *multiNetwork) publish(g *core.Graph, aux *MaxFlowResult, flows map[string]float64, result *MultiFlowResult) {
+	result.EdgeFlows = make(map[string]float64, len(g.Edges()))
+	for _, edge := range g.Edges() {
+		result.EdgeFlows[edge.ID] = 0
+	}
+	for auxID, arc := range n.arcs {
+		result.EdgeFlows[arc.edgeID] += arc.sign * flows[auxID]
+	}
+
+	result.SourceFlows = make(map[string]float64, len(n.sourceArcs))
+	for vertexID, auxID := range n.sourceArcs {
+		result.SourceFlows[vertexID] = flows[auxID]
+	}
+	result.SinkFlows = make(map[string]float64, len(n.sinkArcs))
+	for vertexID, auxID := range n.sinkArcs {
+		result.SinkFlows[vertexID] = flows[auxID]
+	}
+
+	reached := make(map[string]bool, len(aux.CutSourceSide))
+	for _, auxID := range aux.CutSourceSide {
+		reached[auxID] = true
+	}
+	for _, vertexID := range g.Vertices() {
+		if !reached[n.entry[vertexID]] {
+			result.CutSinkSide = append(result.CutSinkSide, vertexID)
+			continue
+		}
+		result.CutSourceSide = append(result.CutSourceSide, vertexID)
+		if !reached[n.exit[vertexID]] {
+			result.CutVertices = append(result.CutVertices, vertexID)
+		}
+	}
+
+	for _, edge := range g.Edges() {
+		if !n.carried[edge.ID] {
+			continue
+		}
+		forward := reached[n.exit[edge.From]] && !reached[n.entry[edge.To]]
+		backward := !edge.Directed && reached[n.exit[edge.To]] && !reached[n.entry[edge.From]]
+		if forward || backward {
+			result.CutEdges = append(result.CutEdges, edge.ID)
+		}
+	}
+
+	for _, vertexID := range result.Sources {
+		if !reached[n.entry[vertexID]] {
+			result.CutSupplies = append(result.CutSupplies, vertexID)
+		}
+	}
+	for _, vertexID := range result.Sinks {
+		if reached[n.exit[vertexID]] {
+			result.CutDemands = append(result.CutDemands, vertexID)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// AI-HINTS (file):
+//   - Optimality is checked by duality: the flow must be feasible under every
+//     edge, vertex, supply, and demand limit, and the reported partition must
+//     be a cut whose capacity equals Value.
+//   - The cut capacity is recomputed from CutSourceSide and CutVertices alone,
+//     then compared with the CutEdges/CutSupplies/CutDemands lists.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+)
+
+// multiLimits holds the limit maps passed to MultiMaxFlow.
+type multiLimits struct {
+	supplies, demands, vertices map[string]float64
+}
+
+// limitOf returns limits[id], or +Inf when id has no limit.
+func limitOf(limits map[string]float64, id string) float64 {
+	if limit, ok := limits[id]; ok {
+		return limit
+	}
+
+	return math.Inf(1)
+}
+
+// mustMultiCertificate checks feasibility of result and that its cut is tight.
+func mustMultiCertificate(t *testing.T, g *core.Graph, limits multiLimits, result *flow.MultiFlowResult) {
+	t.Helper()
+
+	inflow := make(map[string]float64)
+	outflow := make(map[string]float64)
+	for _, edge := range g.Edges() {
+		f := result.EdgeFlows[edge.ID]
+		if (edge.Directed && f < -1e-9) || math.Abs(f) > edge.Weight+1e-9 {
+			t.Fatalf("edge %s: flow %g outside its capacity %g", edge.ID, f, edge.Weight)
+		}
+		from, to := edge.From, edge.To
+		if f < 0 {
+			from, to, f = to, from, -f
+		}
+		outflow[from] += f
+		inflow[to] += f
+	}
+
+	supplied, absorbed := 0.0, 0.0
+	for _, vertexID := range g.Vertices() {
+		supply, demand := result.SourceFlows[vertexID], result.SinkFlows[vertexID]
+		if supply > limitOf(limits.supplies, vertexID)+1e-9 || demand > limitOf(limits.demands, vertexID)+1e-9 {
+			t.Fatalf("vertex %s: supply %g or demand %g above its limit", vertexID, supply, demand)
+		}
+		mustEqualFloat(t, inflow[vertexID]+supply, outflow[vertexID]+demand, "conservation at "+vertexID)
+		if through := inflow[vertexID] + supply; through > limitOf(limits.vertices, vertexID)+1e-9 {
+			t.Fatalf("vertex %s: throughput %g above its capacity", vertexID, through)
+		}
+		supplied += supply
+		absorbed += demand
+	}
+	mustEqualFloat(t, supplied, result.Value, "source flows")
+	mustEqualFloat(t, absorbed, result.Value, "sink flows")
+
+	sourceSide := make(map[string]bool)
+	for _, vertexID := range result.CutSourceSide {
+		sourceSide[vertexID] = true
+	}
+	cutVertex := make(map[string]bool)
+	for _, vertexID := range result.CutVertices {
+		if !sourceSide[vertexID] {
+			t.Fatalf("cut vertex %s is not on the source side", vertexID)
+		}
+		cutVertex[vertexID] = true
+	}
+	if len(result.CutSourceSide)+len(result.CutSinkSide) != len(g.Vertices()) {
+		t.Fatalf("cut sides do not partition the vertices")
+	}
+	// exits reports whether flow can still leave v through its exit.
+	exits := func(v string) bool { return sourceSide[v] && !cutVertex[v] }
+
+	capacity, listed := 0.0, 0.0
+	for _, vertexID := range result.CutVertices {
+		capacity += limitOf(limits.vertices, vertexID)
+	}
+	for _, edge := range g.Edges() {
+		if (exits(edge.From) && !sourceSide[edge.To]) || (!edge.Directed && exits(edge.To) && !sourceSide[edge.From]) {
+			capacity += edge.Weight
+		}
+	}
+	for _, edgeID := range result.CutEdges {
+		for _, edge := range g.Edges() {
+			if edge.ID == edgeID {
+				listed += edge.Weight
+			}
+		}
+	}
+	for _, vertexID := range result.Sources {
+		if !sourceSide[vertexID] {
+			capacity += limitOf(limits.supplies, vertexID)
+		}
+	}
+	for _, vertexID := range result.CutSupplies {
+		listed += limitOf(limits.supplies, vertexID)
+	}
+	for _, vertexID := range result.Sinks {
+		if exits(vertexID) {
+			capacity += limitOf(limits.demands, vertexID)
+		}
+	}
+	for _, vertexID := range result.CutDemands {
+		listed += limitOf(limits.demands, vertexID)
+	}
+	for _, vertexID := range result.CutVertices {
+		listed += limitOf(limits.vertices, vertexID)
+	}
+
+	mustEqualFloat(t, capacity, result.Value, "cut capacity")
+	mustEqualFloat(t, listed, result.Value, "listed cut capacity")
+}
+
+func TestMultiMaxFlow_SingleTerminalsMatchMaxFlow(t *testing.T) {
+	for _, tt := range allAlgorithms() {
+		t.Run(tt.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(44))
+			for trial := 0; trial < 30; trial++ {
+				vertices := 2 + rng.Intn(10)
+				g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), trial%3 != 0)
+				source, sink := "v00", fmt.Sprintf("v%02d", vertices-1)
+
+				want, err := flow.MaxFlow(g, source, sink, flow.WithAlgorithm(tt.algorithm))
+				mustNoError(t, err, "MaxFlow")
+				got, err := flow.MultiMaxFlow(g, []string{source}, []string{sink}, flow.WithAlgorithm(tt.algorithm))
+				mustNoError(t, err, "MultiMaxFlow")
+				mustEqualFloat(t, got.Value, want.Value, fmt.Sprintf("trial %d value", trial))
+				mustMultiCertificate(t, g, multiLimits{}, got)
+			}
+		})
+	}
+}
+
+func TestMultiMaxFlow_RandomLimitsCertifyOptimality(t *testing.T) {
+	rng := rand.New(rand.NewSource(45))
+	for trial := 0; trial < 80; trial++ {
+		vertices := 4 + rng.Intn(10)
+		g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), trial%4 != 0)
+
+		limits := multiLimits{supplies: map[string]float64{}, demands: map[string]float64{}, vertices: map[string]float64{}}
+		var sources, sinks []string
+		for v := 0; v < vertices; v++ {
+			vertexID := fmt.Sprintf("v%02d", v)
+			switch rng.Intn(4) {
+			case 0:
+				sources = append(sources, vertexID)
+				if rng.Intn(2) == 0 {
+					limits.supplies[vertexID] = float64(rng.Intn(8))
+				}
+			case 1:
+				sinks = append(sinks, vertexID)
+				if rng.Intn(2) == 0 {
+					limits.demands[vertexID] = float64(rng.Intn(8))
+				}
+			}
+			if rng.Intn(3) == 0 {
+				limits.vertices[vertexID] = float64(rng.Intn(12))
+			}
+		}
+		if len(sources) == 0 || len(sinks) == 0 {
+			continue
+		}
+
+		result, err := flow.MultiMaxFlow(g, sources, sinks,
+			flow.WithSupplies(limits.supplies), flow.WithDemands(limits.demands), flow.WithVertexCapacities(limits.vertices))
+		mustNoError(t, err, fmt.Sprintf("trial %d", trial))
+		mustMultiCertificate(t, g, limits, result)
+	}
+}
+
+func TestMultiMaxFlow_VertexCapacityIsTheBottleneck(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	mustAddEdge(t, g, "Origin1", "Cache", 50)
+	mustAddEdge(t, g, "Origin2", "Cache", 50)
+	mustAddEdge(t, g, "Origin2", "Edge", 10)
+	mustAddEdge(t, g, "Cache", "Edge", 80)
+	mustAddEdge(t, g, "Edge", "ViewersEU", 40)
+	mustAddEdge(t, g, "Edge", "ViewersUS", 40)
+
+	limits := multiLimits{
+		supplies: map[string]float64{"Origin1": 30},
+		demands:  map[string]float64{"ViewersUS": 15},
+		vertices: map[string]float64{"Cache": 45},
+	}
+	result, err := flow.MultiMaxFlow(g, []string{"Origin1", "Origin2"}, []string{"ViewersEU", "ViewersUS"},
+		flow.WithSupplies(limits.supplies), flow.WithDemands(limits.demands), flow.WithVertexCapacities(limits.vertices))
+	mustNoError(t, err, "MultiMaxFlow")
+
+	// The cache passes 45 and Origin2 bypasses it with 10; ViewersUS takes only 15.
+	mustEqualFloat(t, result.Value, 55, "value")
+	mustEqualFloat(t, result.SinkFlows["ViewersUS"], 15, "ViewersUS")
+	mustEqualFloat(t, result.SinkFlows["ViewersEU"], 40, "ViewersEU")
+	if len(result.CutVertices) != 1 || result.CutVertices[0] != "Cache" {
+		t.Fatalf("want Cache as the cut vertex, got %v", result.CutVertices)
+	}
+	mustMultiCertificate(t, g, limits, result)
+}
+
+func TestMultiMaxFlow_UndirectedEdgesAndCapacityOption(t *testing.T) {
+	g := mustGraph(t)
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"B", "D"}} {
+		_, err := g.AddEdge(e[0], e[1], 0)
+		mustNoError(t, err, "AddEdge")
+	}
+
+	result, err := flow.MultiMaxFlow(g, []string{"A", "C"}, []string{"D"},
+		flow.WithCapacity(func(core.Edge) float64 { return 2 }),
+		flow.WithVertexCapacities(map[string]float64{"B": 1}))
+	mustNoError(t, err, "MultiMaxFlow")
+
+	// A reaches D only through B (1 unit); C reaches D directly (2) and via B,
+	// which is already full.
+	mustEqualFloat(t, result.Value, 3, "value")
+	mustEqualFloat(t, result.SourceFlows["A"], 1, "A supply")
+	mustEqualFloat(t, result.SourceFlows["C"], 2, "C supply")
+}
+
+func TestMultiMaxFlow_ObserverSeesOriginalVertices(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	mustAddEdge(t, g, "A", "B", 3)
+	mustAddEdge(t, g, "B", "C", 3)
+	mustAddEdge(t, g, "D", "C", 2)
+
+	var paths [][]string
+	observer := func(_ context.Context, event flow.AugmentationEvent) error {
+		paths = append(paths, event.Path)
+		return nil
+	}
+	for _, tt := range allAlgorithms() {
+		paths = nil
+		_, err := flow.MultiMaxFlow(g, []string{"A", "D"}, []string{"C"},
+			flow.WithAlgorithm(tt.algorithm), flow.WithObserver(observer),
+			flow.WithVertexCapacities(map[string]float64{"B": 2}))
+		mustNoError(t, err, tt.name)
+		if len(paths) == 0 {
+			t.Fatalf("%s: no events", tt.name)
+		}
+		for _, path := range paths {
+			for position, vertexID := range path {
+				if !g.HasVertex(vertexID) || (position > 0 && path[position-1] == vertexID) {
+					t.Fatalf("%s: path %v is not in original vertex IDs", tt.name, path)
+				}
+			}
+		}
+	}
+}
+
+func TestMultiMaxFlow_Validation(t *testing.T) {
+	g := buildEnterpriseBackboneProofNetwork(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "NilGraph", err: flow.ErrNilGraph, run: func() error {
+			_, err := flow.MultiMaxFlow(nil, []string{"S"}, []string{"T"})
+			return err
+		}},
+		{name: "NoSources", err: flow.ErrEmptyTerminal, run: func() error {
+			_, err := flow.MultiMaxFlow(g, nil, []string{"T"})
+			return err
+		}},
+		{name: "EmptySinkID", err: flow.ErrEmptyTerminal, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{""})
+			return err
+		}},
+		{name: "DuplicateSource", err: flow.ErrDuplicateTerminal, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S", "S"}, []string{"T"})
+			return err
+		}},
+		{name: "SourceIsSink", err: flow.ErrSameTerminal, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T", "S"})
+			return err
+		}},
+		{name: "MissingSource", err: flow.ErrSourceNotFound, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S", "missing"}, []string{"T"})
+			return err
+		}},
+		{name: "MissingSink", err: flow.ErrSinkNotFound, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"missing"})
+			return err
+		}},
+		{name: "NilLimits", err: flow.ErrInvalidOptions, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"}, flow.WithSupplies(nil))
+			return err
+		}},
+		{name: "NegativeDemand", err: flow.ErrInvalidLimit, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"}, flow.WithDemands(map[string]float64{"T": -1}))
+			return err
+		}},
+		{name: "InfVertexCapacity", err: flow.ErrInvalidLimit, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"},
+				flow.WithVertexCapacities(map[string]float64{"S": math.Inf(1)}))
+			return err
+		}},
+		{name: "OverflowingTotalCapacity", err: flow.ErrNaNInf, run: func() error {
+			huge, err := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+			if err != nil {
+				return err
+			}
+			for _, pair := range [][2]string{{"S", "A"}, {"A", "T"}} {
+				if _, err = huge.AddEdge(pair[0], pair[1], math.MaxFloat64); err != nil {
+					return err
+				}
+			}
+			_, err = flow.MultiMaxFlow(huge, []string{"S"}, []string{"T"})
+			return err
+		}},
+		{name: "SupplyOnNonSource", err: flow.ErrInvalidLimit, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"}, flow.WithSupplies(map[string]float64{"T": 1}))
+			return err
+		}},
+		{name: "VertexCapacityOnMissingVertex", err: flow.ErrInvalidLimit, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"},
+				flow.WithVertexCapacities(map[string]float64{"missing": 1}))
+			return err
+		}},
+		{name: "AugmentationLimit", err: flow.ErrAugmentationLimit, run: func() error {
+			result, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"},
+				flow.WithAlgorithm(flow.AlgorithmFordFulkerson), flow.WithMaxAugmentations(1))
+			if result == nil || !result.Partial || result.EdgeFlows != nil {
+				return errors.New("want partial result without flows")
+			}
+			return err
+		}},
+		{name: "Canceled", err: context.Canceled, run: func() error {
+			_, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"}, flow.WithContext(ctx))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mustErrorIs(t, tt.run(), tt.err, tt.name)
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+
+	"github.com/katalvlaran/lvlath/core"
 )
 
 // defaultEpsilon is the default threshold for treating tiny capacities as zero.
@@ -53,6 +55,22 @@ type options struct {
 	verbose  bool
 
 	maxAugmentations int
+
+	capacity      func(core.Edge) float64
+	cost          func(core.Edge) float64
+	flowAmount    float64
+	hasFlowAmount bool
+
+	supplies         map[string]float64
+	demands          map[string]float64
+	vertexCapacities map[string]float64
+
+	lowerBound    func(core.Edge) float64
+	vertexDemands map[string]float64
+
+	pathCount int
+
+	warmStart *MaxFlowResult
 }
 
 // AugmentationEvent describes one successful residual augmentation.
@@ -224,7 +242,7 @@ func applyOptions(user ...Option) (options, error) {
 	}
 
 	switch cfg.algorithm {
-	case AlgorithmDinic, AlgorithmEdmondsKarp, AlgorithmFordFulkerson:
+	case AlgorithmDinic, AlgorithmEdmondsKarp, AlgorithmFordFulkerson, AlgorithmPushRelabel:
 		return cfg, nil
 	default:
 		return options{}, ErrInvalidOptions
@@ -312,7 +330,7 @@ func WithEpsilon(eps float64) Option {
 //   - Legacy wrappers force the corresponding algorithm explicitly.
 //
 // Inputs:
-//   - alg: AlgorithmDinic, AlgorithmEdmondsKarp, or AlgorithmFordFulkerson.
+//   - alg: AlgorithmDinic, AlgorithmEdmondsKarp, AlgorithmFordFulkerson, or AlgorithmPushRelabel.
 //
 // Returns:
 //   - Option: option closure for MaxFlow.
@@ -331,7 +349,7 @@ func WithEpsilon(eps float64) Option {
 func WithAlgorithm(alg Algorithm) Option {
 	return func(o *options) error {
 		switch alg {
-		case AlgorithmDinic, AlgorithmEdmondsKarp, AlgorithmFordFulkerson:
+		case AlgorithmDinic, AlgorithmEdmondsKarp, AlgorithmFordFulkerson, AlgorithmPushRelabel:
 			o.algorithm = alg
 			return nil
 		default:
@@ -348,7 +366,7 @@ func WithAlgorithm(alg Algorithm) Option {
 //
 // Behavior highlights:
 //   - Zero disables augmentation-count-triggered rebuilds.
-//   - The option is ignored by Edmonds-Karp and Ford-Fulkerson.
+//   - The option is ignored by Edmonds-Karp, Ford-Fulkerson, and push-relabel.
 //
 // Inputs:
 //   - n: non-negative augmentation interval.
@@ -442,6 +460,7 @@ func WithObserver(observer AugmentationObserver) Option {
 // Notes:
 //   - This option is most useful for AlgorithmFordFulkerson.
 //   - Dinic and Edmonds-Karp also honor the option for uniform runtime governance.
+//   - Push-relabel counts pushes into the sink against the limit.
 //
 // AI-Hints:
 //   - Do not check the limit before knowing whether another augmenting path exists;
@@ -457,6 +476,385 @@ func WithMaxAugmentations(n int) Option {
 	}
 }
 
+// WithCapacity sets the per-edge capacity function.
+//
+// Implementation:
+//   - Stage 1: Reject a nil function.
+//   - Stage 2: Store the function; the residual adapter calls it once per edge.
+//
+// Behavior highlights:
+//   - Without this option capacities come from Edge.Weight and the graph must
+//     be weighted; with it, unweighted graphs are accepted.
+//   - Capacities obey the same law as weights: finite, and below -epsilon rejected.
+//
+// Inputs:
+//   - capacity: returns a finite, non-negative capacity for an edge.
+//
+// Returns:
+//   - Option: option closure for MaxFlow, CapacityMatrix, and MinCostFlow.
+//
+// Errors:
+//   - ErrInvalidOptions if capacity is nil.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+//
+// AI-Hints:
+//   - Pair with WithCost when Edge.Weight already stores the per-unit cost.
+func WithCapacity(capacity func(edge core.Edge) float64) Option {
+	return func(o *options) error {
+		if capacity == nil {
+			return ErrInvalidOptions
+		}
+		o.capacity = capacity
+		return nil
+	}
+}
+
+// WithCost sets the per-unit cost function required by MinCostFlow.
+//
+// Implementation:
+//   - Stage 1: Reject a nil function.
+//   - Stage 2: Store the function; MinCostFlow calls it once per edge.
+//
+// Behavior highlights:
+//   - Costs may be negative; residual cycles of negative total cost are
+//     reported as ErrNegativeCycle.
+//   - An undirected edge costs the same in both directions, so a negative cost
+//     on an undirected edge is itself a negative cycle.
+//   - MaxFlow ignores this option.
+//
+// Inputs:
+//   - cost: returns a finite per-unit cost for an edge.
+//
+// Returns:
+//   - Option: option closure for MinCostFlow.
+//
+// Errors:
+//   - ErrInvalidOptions if cost is nil.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func WithCost(cost func(edge core.Edge) float64) Option {
+	return func(o *options) error {
+		if cost == nil {
+			return ErrInvalidOptions
+		}
+		o.cost = cost
+		return nil
+	}
+}
+
+// WithFlowAmount sets the amount MinCostFlow must ship from source to sink.
+//
+// Implementation:
+//   - Stage 1: Validate a finite, non-negative amount.
+//   - Stage 2: Store the amount; without it MinCostFlow ships the maximum flow.
+//
+// Inputs:
+//   - amount: requested flow value.
+//
+// Returns:
+//   - Option: option closure for MinCostFlow.
+//
+// Errors:
+//   - ErrInvalidFlowAmount for negative, NaN, or Inf amounts.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+//
+// AI-Hints:
+//   - An amount above the maximum flow yields the cheapest maximum flow together
+//     with ErrInsufficientCapacity.
+func WithFlowAmount(amount float64) Option {
+	return func(o *options) error {
+		if math.IsNaN(amount) || math.IsInf(amount, 0) || amount < 0 {
+			return ErrInvalidFlowAmount
+		}
+		o.flowAmount = amount
+		o.hasFlowAmount = true
+		return nil
+	}
+}
+
+// WithSupplies bounds how much flow each listed source of MultiMaxFlow may emit.
+//
+// Implementation:
+//   - Stage 1: Validate every limit as finite and non-negative.
+//   - Stage 2: Store a copy; later changes to the caller's map have no effect.
+//
+// Behavior highlights:
+//   - Sources missing from the map have unlimited supply.
+//   - MaxFlow and MinCostFlow ignore this option.
+//
+// Inputs:
+//   - limits: source vertex ID -> maximum supply.
+//
+// Returns:
+//   - Option: option closure for MultiMaxFlow.
+//
+// Errors:
+//   - ErrInvalidOptions if limits is nil.
+//   - ErrInvalidLimit for negative, NaN, or Inf limits.
+//
+// Complexity:
+//   - Time O(k), Space O(k), where k is len(limits).
+func WithSupplies(limits map[string]float64) Option {
+	return func(o *options) error {
+		copied, err := copyLimits("supply", limits)
+		if err != nil {
+			return err
+		}
+		o.supplies = copied
+		return nil
+	}
+}
+
+// WithDemands bounds how much flow each listed sink of MultiMaxFlow may absorb.
+//
+// Implementation:
+//   - Stage 1: Validate every limit as finite and non-negative.
+//   - Stage 2: Store a copy; later changes to the caller's map have no effect.
+//
+// Behavior highlights:
+//   - Sinks missing from the map have unlimited demand.
+//   - MaxFlow and MinCostFlow ignore this option.
+//
+// Inputs:
+//   - limits: sink vertex ID -> maximum demand.
+//
+// Returns:
+//   - Option: option closure for MultiMaxFlow.
+//
+// Errors:
+//   - ErrInvalidOptions if limits is nil.
+//   - ErrInvalidLimit for negative, NaN, or Inf limits.
+//
+// Complexity:
+//   - Time O(k), Space O(k), where k is len(limits).
+func WithDemands(limits map[string]float64) Option {
+	return func(o *options) error {
+		copied, err := copyLimits("demand", limits)
+		if err != nil {
+			return err
+		}
+		o.demands = copied
+		return nil
+	}
+}
+
+// WithVertexCapacities bounds the throughput of vertices in MultiMaxFlow.
+//
+// Implementation:
+//   - Stage 1: Validate every limit as finite and non-negative.
+//   - Stage 2: Store a copy; MultiMaxFlow splits each listed vertex into an
+//     entry and an exit joined by one arc of that capacity.
+//
+// Behavior highlights:
+//   - Throughput counts the flow passing through a vertex; for a source it is
+//     the flow it emits, for a sink the flow it absorbs.
+//   - Vertices missing from the map are not split and have unlimited throughput.
+//   - MaxFlow and MinCostFlow ignore this option.
+//
+// Inputs:
+//   - limits: vertex ID -> maximum throughput.
+//
+// Returns:
+//   - Option: option closure for MultiMaxFlow.
+//
+// Errors:
+//   - ErrInvalidOptions if limits is nil.
+//   - ErrInvalidLimit for negative, NaN, or Inf limits.
+//
+// Complexity:
+//   - Time O(k), Space O(k), where k is len(limits).
+//
+// AI-Hints:
+//   - Model a router, cache, or warehouse throughput here instead of editing
+//     every incident edge.
+func WithVertexCapacities(limits map[string]float64) Option {
+	return func(o *options) error {
+		copied, err := copyLimits("vertex capacity", limits)
+		if err != nil {
+			return err
+		}
+		o.vertexCapacities = copied
+		return nil
+	}
+}
+
+// WithLowerBound sets the minimum flow function used by Circulation.
+//
+// Implementation:
+//   - Stage 1: Reject a nil function.
+//   - Stage 2: Store the function; Circulation calls it once per edge.
+//
+// Behavior highlights:
+//   - The upper bound of an edge is its capacity (WithCapacity or Edge.Weight).
+//   - Lower bounds must be finite, non-negative, and at most the upper bound;
+//     undirected edges only accept a zero lower bound.
+//   - MaxFlow, MultiMaxFlow, and MinCostFlow ignore this option.
+//
+// Inputs:
+//   - lower: returns the minimum flow an edge must carry.
+//
+// Returns:
+//   - Option: option closure for Circulation.
+//
+// Errors:
+//   - ErrInvalidOptions if lower is nil.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+//
+// AI-Hints:
+//   - Use it for staffing minimums, take-or-pay contracts, or required coverage.
+func WithLowerBound(lower func(edge core.Edge) float64) Option {
+	return func(o *options) error {
+		if lower == nil {
+			return ErrInvalidOptions
+		}
+		o.lowerBound = lower
+		return nil
+	}
+}
+
+// WithVertexDemands sets the signed net demand of vertices for Circulation.
+//
+// Implementation:
+//   - Stage 1: Validate every demand as finite.
+//   - Stage 2: Store a copy; later changes to the caller's map have no effect.
+//
+// Behavior highlights:
+//   - A feasible flow has inflow minus outflow equal to demands[v] at every
+//     vertex: positive values consume flow, negative values supply it.
+//   - Vertices missing from the map have zero demand (pure conservation).
+//   - Unlike WithDemands, which bounds MultiMaxFlow sinks, these demands are
+//     exact and must sum to zero.
+//   - MaxFlow, MultiMaxFlow, and MinCostFlow ignore this option.
+//
+// Inputs:
+//   - demands: vertex ID -> net demand.
+//
+// Returns:
+//   - Option: option closure for Circulation.
+//
+// Errors:
+//   - ErrInvalidOptions if demands is nil.
+//   - ErrInvalidLimit for NaN or Inf demands.
+//
+// Complexity:
+//   - Time O(k), Space O(k), where k is len(demands).
+func WithVertexDemands(demands map[string]float64) Option {
+	return func(o *options) error {
+		if demands == nil {
+			return ErrInvalidOptions
+		}
+
+		copied := make(map[string]float64, len(demands))
+		for vertexID, demand := range demands {
+			if math.IsNaN(demand) || math.IsInf(demand, 0) {
+				return fmt.Errorf("flow: demand %v for vertex %q: %w", demand, vertexID, ErrInvalidLimit)
+			}
+			copied[vertexID] = demand
+		}
+		o.vertexDemands = copied
+		return nil
+	}
+}
+
+// WithPathCount sets how many disjoint paths the disjoint-path functions must find.
+//
+// Implementation:
+//   - Stage 1: Validate a positive count.
+//   - Stage 2: Store the count; without it EdgeDisjointPaths and
+//     VertexDisjointPaths return as many paths as exist, and the MinWeight
+//     variants look for two.
+//
+// Inputs:
+//   - k: number of paths.
+//
+// Returns:
+//   - Option: option closure for the disjoint-path functions.
+//
+// Errors:
+//   - ErrInvalidOptions when k < 1.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+//
+// AI-Hints:
+//   - Fewer than k disjoint paths yields the best available set together
+//     with ErrInsufficientPaths.
+func WithPathCount(k int) Option {
+	return func(o *options) error {
+		if k < 1 {
+			return ErrInvalidOptions
+		}
+		o.pathCount = k
+		return nil
+	}
+}
+
+// WithWarmStart seeds MaxFlow with the flow of a previous result on the same
+// network, so a run after capacity changes only repairs and extends that flow.
+//
+// Implementation:
+//   - Stage 1: Reject a nil result.
+//   - Stage 2: Store the result; MaxFlow checks it against the graph and
+//     terminals before any kernel runs.
+//
+// Inputs:
+//   - prev: a complete MaxFlowResult computed on a graph with the same edge IDs
+//     and endpoints; only capacities may differ.
+//
+// Returns:
+//   - Option: option closure for MaxFlow.
+//
+// Errors:
+//   - ErrInvalidOptions when prev is nil.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+//
+// AI-Hints:
+//   - Remove an edge by setting its capacity to zero rather than deleting it;
+//     a deleted edge makes the previous flow unusable.
+//   - MultiMaxFlow, Circulation and the disjoint-path functions ignore it.
+func WithWarmStart(prev *MaxFlowResult) Option {
+	return func(o *options) error {
+		if prev == nil {
+			return ErrInvalidOptions
+		}
+		o.warmStart = prev
+		return nil
+	}
+}
+
+// copyLimits validates and copies a per-vertex limit map.
+//
+// Errors:
+//   - ErrInvalidOptions for a nil map.
+//   - ErrInvalidLimit for negative, NaN, or Inf values.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func copyLimits(kind string, limits map[string]float64) (map[string]float64, error) {
+	if limits == nil {
+		return nil, ErrInvalidOptions
+	}
+
+	copied := make(map[string]float64, len(limits))
+	for vertexID, limit := range limits {
+		if math.IsNaN(limit) || math.IsInf(limit, 0) || limit < 0 {
+			return nil, fmt.Errorf("flow: %s %v for vertex %q: %w", kind, limit, vertexID, ErrInvalidLimit)
+		}
+		copied[vertexID] = limit
+	}
+
+	return copied, nil
+}
+
 // checkAugmentationLimit verifies whether another successful push is allowed.
 // It must be called only after an augmenting path has been found.
 //
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// AI-HINTS (file):
+//   - Push-relabel is checked against Dinic on seeded random networks; the
+//     residual must encode a valid flow (no stranded excess), not just a preflow.
+//   - Observer, context, and augmentation-limit tests pin the event contract.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+)
+
+// randomCapacityNetwork builds a seeded network with integral capacities,
+// including parallel edges, antiparallel pairs, and dead ends.
+func randomCapacityNetwork(t *testing.T, rng *rand.Rand, vertices, edges int, directed bool) *core.Graph {
+	t.Helper()
+
+	g := mustGraph(t, core.WithDirected(directed), core.WithWeighted(), core.WithMultiEdges())
+	for v := 0; v < vertices; v++ {
+		mustAddVertex(t, g, fmt.Sprintf("v%02d", v))
+	}
+	for e := 0; e < edges; e++ {
+		from, to := rng.Intn(vertices), rng.Intn(vertices)
+		if from == to {
+			continue
+		}
+		mustAddEdge(t, g, fmt.Sprintf("v%02d", from), fmt.Sprintf("v%02d", to), float64(1+rng.Intn(9)))
+	}
+
+	return g
+}
+
+// mustConserveFlow checks that every non-terminal vertex has zero net outflow
+// in the flow encoded by result.Residual: net out of u is sum(cap - residual).
+func mustConserveFlow(t *testing.T, original *core.Graph, result *flow.MaxFlowResult) {
+	t.Helper()
+
+	capacity := make(map[[2]string]float64)
+	for _, edge := range original.Edges() {
+		if edge.From == edge.To {
+			continue
+		}
+		capacity[[2]string{edge.From, edge.To}] += edge.Weight
+		if !edge.Directed {
+			capacity[[2]string{edge.To, edge.From}] += edge.Weight
+		}
+	}
+
+	residual := make(map[[2]string]float64)
+	for _, edge := range result.Residual.Edges() {
+		residual[[2]string{edge.From, edge.To}] += edge.Weight
+	}
+
+	net := make(map[string]float64)
+	for pair, c := range capacity {
+		net[pair[0]] += c - residual[pair]
+	}
+	for pair, r := range residual {
+		if _, ok := capacity[pair]; !ok {
+			net[pair[0]] -= r
+		}
+	}
+
+	for _, vertexID := range original.Vertices() {
+		switch vertexID {
+		case result.Source:
+			mustEqualFloat(t, net[vertexID], result.Value, "source outflow")
+		case result.Sink:
+			mustEqualFloat(t, net[vertexID], -result.Value, "sink inflow")
+		default:
+			mustEqualFloat(t, net[vertexID], 0, "conservation at "+vertexID)
+		}
+	}
+}
+
+func TestMaxFlow_PushRelabelMatchesDinicOnRandomNetworks(t *testing.T) {
+	rng := rand.New(rand.NewSource(41))
+
+	for trial := 0; trial < 60; trial++ {
+		directed := trial%3 != 0
+		vertices := 2 + rng.Intn(14)
+		g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), directed)
+		source, sink := "v00", fmt.Sprintf("v%02d", vertices-1)
+
+		want, err := flow.MaxFlow(g, source, sink, flow.WithAlgorithm(flow.AlgorithmDinic))
+		mustNoError(t, err, "Dinic oracle")
+
+		got, err := flow.MaxFlow(g, source, sink, flow.WithAlgorithm(flow.AlgorithmPushRelabel))
+		mustNoError(t, err, "push-relabel")
+
+		op := fmt.Sprintf("trial %d", trial)
+		if got.Algorithm != flow.AlgorithmPushRelabel {
+			t.Fatalf("%s: algorithm=%q", op, got.Algorithm)
+		}
+		mustSuccessfulCertificate(t, g, got, source, sink, want.Value, 0, op)
+		mustConserveFlow(t, g, got)
+	}
+}
+
+func TestMaxFlow_PushRelabelObserverSeesSinkDeliveries(t *testing.T) {
+	g := buildEnterpriseBackboneProofNetwork(t)
+
+	var events []flow.AugmentationEvent
+	result, err := flow.MaxFlow(
+		g,
+		"S",
+		"T",
+		flow.WithAlgorithm(flow.AlgorithmPushRelabel),
+		flow.WithObserver(func(_ context.Context, event flow.AugmentationEvent) error {
+			events = append(events, event)
+			return nil
+		}),
+	)
+	mustNoError(t, err, "push-relabel with observer")
+
+	if len(events) != result.Augmentations || len(events) == 0 {
+		t.Fatalf("events=%d augmentations=%d", len(events), result.Augmentations)
+	}
+	total := 0.0
+	for position, event := range events {
+		total += event.Delta
+		if event.Index != position+1 || len(event.Path) != 2 || event.Path[1] != "T" {
+			t.Fatalf("event %d: %+v", position, event)
+		}
+		mustEqualFloat(t, event.Total, total, "running total")
+	}
+	mustEqualFloat(t, total, result.Value, "delivered flow")
+}
+
+func TestMaxFlow_PushRelabelInterruptionsReturnPartialResult(t *testing.T) {
+	observerErr := errors.New("observer stopped after first event")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		err  error
+		opts []flow.Option
+	}{
+		{name: "Canceled", err: context.Canceled, opts: []flow.Option{flow.WithContext(ctx)}},
+		{name: "AugmentationLimit", err: flow.ErrAugmentationLimit, opts: []flow.Option{flow.WithMaxAugmentations(1)}},
+		{name: "Observer", err: observerErr, opts: []flow.Option{
+			flow.WithObserver(func(context.Context, flow.AugmentationEvent) error { return observerErr }),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := buildEnterpriseBackboneProofNetwork(t)
+			opts := append([]flow.Option{flow.WithAlgorithm(flow.AlgorithmPushRelabel)}, tt.opts...)
+
+			result, err := flow.MaxFlow(g, "S", "T", opts...)
+			mustErrorIs(t, err, tt.err, tt.name)
+			if result == nil {
+				if tt.err == context.Canceled {
+					return // canceled before the residual network was built
+				}
+				t.Fatalf("%s: want partial result", tt.name)
+			}
+			mustEqualBool(t, result.Partial, true, tt.name+" partial")
+			if result.Residual != nil {
+				t.Fatalf("%s: partial result must not publish a residual", tt.name)
+			}
+		})
+	}
+}
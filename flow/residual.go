@@ -53,6 +53,18 @@ type residualNetwork struct {
 	vertices []string
 	cap      map[string]map[string]float64
 	adj      map[string][]string
+	edges    []residualEdge
+}
+
+// residualEdge records one original edge as buildResidualNetwork ingested it.
+// Loops and zero-capacity edges are kept with capacity 0 so that per-edge
+// flows can be attributed later without walking the input graph again.
+type residualEdge struct {
+	id       string
+	from     string
+	to       string
+	capacity float64
+	directed bool
 }
 
 // newResidualNetwork allocates empty residual storage for a known vertex order.
@@ -254,7 +266,8 @@ func compactSortedStrings(values []string) []string {
 // Implementation:
 //   - Stage 1: Snapshot vertices through core.Vertices lexical order.
 //   - Stage 2: Snapshot edges through core.Edges stable Edge.ID order.
-//   - Stage 3: Validate each capacity and ignore loops.
+//   - Stage 3: Validate each capacity, ignore loops, and record every edge in
+//     rn.edges for later per-edge flow attribution.
 //   - Stage 4: Translate directed edges into one arc and undirected edges into two arcs.
 //   - Stage 5: Sort and compact residual adjacency lists once.
 //
@@ -280,7 +293,7 @@ func compactSortedStrings(values []string) []string {
 //
 // Complexity:
 //   - Time O(V + E + A log A), where A is residual adjacency entries.
-//   - Space O(V + A).
+//   - Space O(V + E + A).
 //
 // Notes:
 //   - The input graph is never mutated.
@@ -295,7 +308,9 @@ func buildResidualNetwork(g *core.Graph, cfg options) (*residualNetwork, error)
 	vertices := g.Vertices()
 	rn := newResidualNetwork(vertices)
 
-	for _, edge := range g.Edges() {
+	edges := g.Edges()
+	rn.edges = make([]residualEdge, 0, len(edges))
+	for _, edge := range edges {
 		if err := cfg.ctx.Err(); err != nil {
 			return nil, err
 		}
@@ -303,13 +318,17 @@ func buildResidualNetwork(g *core.Graph, cfg options) (*residualNetwork, error)
 			return nil, ErrInvalidCapacity
 		}
 		if edge.From == edge.To {
+			rn.edges = append(rn.edges, residualEdge{id: edge.ID, from: edge.From, to: edge.To, directed: edge.Directed})
 			continue
 		}
 
-		capacity, err := validateCapacity(edge, cfg.epsilon)
+		capacity, err := edgeCapacity(edge, cfg)
 		if err != nil {
 			return nil, err
 		}
+		rn.edges = append(rn.edges, residualEdge{
+			id: edge.ID, from: edge.From, to: edge.To, capacity: capacity, directed: edge.Directed,
+		})
 		if capacity == 0 {
 			continue
 		}
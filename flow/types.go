@@ -19,6 +19,9 @@ import (
 // Behavior highlights:
 //   - AlgorithmDinic is the default canonical algorithm.
 //   - Legacy wrappers force the corresponding Algorithm explicitly.
+//   - AlgorithmPushRelabel always uses highest-label selection. FIFO selection
+//     was measured within noise of it on the benchmark networks, so it is not
+//     offered as a separate value or option.
 //
 // Determinism:
 //   - Algorithm selection is explicit; no runtime heuristic changes the kernel.
@@ -31,6 +34,7 @@ const (
 	AlgorithmDinic         Algorithm = "dinic"
 	AlgorithmEdmondsKarp   Algorithm = "edmonds_karp"
 	AlgorithmFordFulkerson Algorithm = "ford_fulkerson"
+	AlgorithmPushRelabel   Algorithm = "push_relabel"
 )
 
 // MaxFlowResult is the canonical result artifact for maximum-flow computations.
@@ -39,10 +43,16 @@ const (
 //   - Stage 1: The selected kernel computes Value over an internal residualNetwork.
 //   - Stage 2: Finalization publishes a detached directed weighted Residual graph.
 //   - Stage 3: Min-cut sides are derived from the final residual network.
+//   - Stage 4: EdgeFlows attributes the pair flows to original edge IDs on demand.
 //
 // Behavior highlights:
 //   - Residual is never the input graph and is safe to mutate independently.
 //   - CutSourceSide and CutSinkSide are sorted, caller-owned snapshots.
+//   - EdgeFlows() maps every original edge ID to its flow: in [0, capacity] for
+//     a directed edge, signed From->To for an undirected one, zero for loops.
+//     The net flow of a vertex pair fills its edges in core.Edges() order.
+//   - The per-edge map is built only when EdgeFlows is called, so runs that
+//     need just Value or the cut do not pay for it.
 //   - Partial marks cancellation or observer interruption after some flow was pushed.
 //
 // Inputs:
@@ -52,15 +62,17 @@ const (
 // Returns:
 //   - Value: maximum flow value accumulated before termination.
 //   - Residual: final residual graph on success; may be nil on partial interruption.
+//   - EdgeFlows(): per-edge flow on success; ErrNoEdgeFlows on partial interruption.
 //
 // Errors:
-//   - Helper methods return ErrNilResult or ErrNoResidual.
+//   - Helper methods return ErrNilResult, ErrNoResidual, or ErrNoEdgeFlows.
 //
 // Determinism:
 //   - Cut sides follow core.Vertices() lexical order preserved by residualNetwork.
 //
 // Complexity:
-//   - Result helper methods are O(1) except ResidualClone, which is O(V+E_res).
+//   - Result helper methods are O(1) except ResidualClone, which is O(V+E_res),
+//     EdgeFlows, which is O(E), and Decompose, which is O(E * V).
 //
 // Notes:
 //   - Partial=true does not mean Value is wrong; it means the run did not publish
@@ -84,6 +96,11 @@ type MaxFlowResult struct {
 
 	Augmentations int
 	Partial       bool
+
+	// network is the final residual network of a complete run; EdgeFlows
+	// attributes it to edge IDs. It stays nil on partial results.
+	network *residualNetwork
+	epsilon float64
 }
 
 // IsNil reports whether the receiver is nil.
@@ -154,6 +171,44 @@ func (r *MaxFlowResult) ResidualClone() (*core.Graph, error) {
 	return r.Residual.Clone(), nil
 }
 
+// EdgeFlows returns the flow on every original edge ID.
+//
+// Implementation:
+//   - Stage 1: Validate the result receiver.
+//   - Stage 2: Validate that the run completed and kept its residual network.
+//   - Stage 3: Attribute the net flow of every vertex pair to its edges.
+//
+// Behavior highlights:
+//   - Flows are in [0, capacity] for a directed edge, signed From->To for an
+//     undirected one, and zero for loops and zero-capacity edges.
+//   - Each call builds a fresh map that the caller owns.
+//
+// Returns:
+//   - map[string]float64: per-edge flow keyed by original edge ID.
+//
+// Errors:
+//   - ErrNilResult when the receiver is nil.
+//   - ErrNoEdgeFlows for partial results and results not produced by MaxFlow.
+//
+// Determinism:
+//   - The net flow of a vertex pair fills its edges in core.Edges() order.
+//
+// Complexity:
+//   - Time O(E), Space O(E).
+//
+// AI-Hints:
+//   - Call it once and keep the map; it is rebuilt on every call.
+func (r *MaxFlowResult) EdgeFlows() (map[string]float64, error) {
+	if r == nil {
+		return nil, ErrNilResult
+	}
+	if r.network == nil {
+		return nil, ErrNoEdgeFlows
+	}
+
+	return r.network.edgeFlows(r.epsilon), nil
+}
+
 // newPartialResult creates a partial result after cancellation or observer failure.
 // It intentionally does not publish a residual graph or min-cut certificate.
 //
@@ -291,3 +346,242 @@ func DefaultOptions() Options {
 		MaxAugmentations:     0,
 	}
 }
+
+// MinCostFlowResult is the result artifact of MinCostFlow.
+//
+// Implementation:
+//   - Stage 1: Successive shortest paths ship flow along cheapest residual routes.
+//   - Stage 2: Publication reads per-edge flows back from the internal arcs.
+//
+// Behavior highlights:
+//   - EdgeFlows is keyed by original edge ID, so parallel edges keep separate flows.
+//   - A directed edge carries flow in [0, capacity].
+//   - An undirected edge reports the net flow from Edge.From to Edge.To; a
+//     negative value means the flow runs To -> From.
+//   - Cost is the sum of flow * cost over all edges.
+//   - Potentials certify optimality: every residual arc u -> v satisfies
+//     cost + Potentials[u] - Potentials[v] >= 0 up to rounding.
+//
+// Determinism:
+//   - Stable for a fixed graph, cost function, and options.
+//
+// Notes:
+//   - Partial=true means the run stopped early; the published flow is still a
+//     feasible flow of value Value and the cheapest one for that value.
+//
+// AI-Hints:
+//   - Verify an external flow by rebuilding its residual network and checking
+//     the reduced costs against Potentials.
+type MinCostFlowResult struct {
+	Value float64
+	Cost  float64
+
+	Source string
+	Sink   string
+
+	EdgeFlows  map[string]float64
+	Potentials map[string]float64
+
+	Augmentations int
+	Partial       bool
+}
+
+// FlowPath is one component of a flow decomposition: a source-sink path, or a
+// cycle whose first and last vertex coincide, carrying Amount units.
+//
+// Behavior highlights:
+//   - EdgeIDs[i] carries the flow from Vertices[i] to Vertices[i+1]; for an
+//     undirected edge that may be its To -> From direction.
+type FlowPath struct {
+	Vertices []string
+	EdgeIDs  []string
+	Amount   float64
+}
+
+// FlowDecomposition splits a flow into source-sink paths and cycles.
+//
+// Behavior highlights:
+//   - Summing Amount over every component that uses an edge gives the absolute
+//     flow on that edge; the path amounts sum to the flow value.
+//   - A maximum flow never needs cycles, but a valid flow may contain them;
+//     they carry no source-sink value.
+//
+// Determinism:
+//   - Walks start at the source, then at vertices in core.Vertices() order,
+//     and leave each vertex by its first loaded edge in core.Edges() order.
+type FlowDecomposition struct {
+	Paths  []FlowPath
+	Cycles []FlowPath
+}
+
+// MultiFlowResult is the result artifact of MultiMaxFlow.
+//
+// Implementation:
+//   - Stage 1: MultiMaxFlow solves an auxiliary super-terminal network.
+//   - Stage 2: Flows and the min cut are mapped back to original IDs.
+//
+// Behavior highlights:
+//   - EdgeFlows follows the MaxFlowResult law: per original edge ID, signed
+//     From->To for undirected edges, zero for loops.
+//   - SourceFlows and SinkFlows give the flow each terminal emits or absorbs;
+//     they each sum to Value.
+//   - CutSourceSide and CutSinkSide partition the original vertices. A vertex
+//     belongs to the source side when flow can still reach it.
+//   - The cut crosses CutEdges (saturated edges), CutVertices (source-side
+//     vertices whose throughput is exhausted), CutSupplies (sink-side sources
+//     whose supply is exhausted), and CutDemands (source-side sinks whose
+//     demand is met); their capacities sum to Value.
+//   - Partial results carry Value, Augmentations, and Partial only.
+//
+// Determinism:
+//   - Vertex lists follow core.Vertices(), CutEdges follows core.Edges(), and
+//     terminal lists follow the caller's order.
+//
+// AI-Hints:
+//   - CutVertices answers "which node limits are the bottleneck?"; CutEdges
+//     answers the same for links.
+type MultiFlowResult struct {
+	Value float64
+
+	Sources []string
+	Sinks   []string
+
+	Algorithm Algorithm
+
+	SourceFlows map[string]float64
+	SinkFlows   map[string]float64
+	EdgeFlows   map[string]float64
+
+	CutSourceSide []string
+	CutSinkSide   []string
+	CutEdges      []string
+	CutVertices   []string
+	CutSupplies   []string
+	CutDemands    []string
+
+	Augmentations int
+	Partial       bool
+}
+
+// CirculationResult is the result artifact of Circulation.
+//
+// Behavior highlights:
+//   - Feasible reports whether every bound and demand can be met.
+//   - EdgeFlows is a feasible flow per original edge ID when Feasible, nil
+//     otherwise; undirected edges report signed From->To flow.
+//   - Violation is the infeasibility certificate when not Feasible.
+//   - Partial marks an interrupted run; neither EdgeFlows nor Violation is set.
+//
+// AI-Hints:
+//   - Check Feasible, not just the error, when a caller tolerates infeasibility.
+type CirculationResult struct {
+	Feasible bool
+
+	EdgeFlows map[string]float64
+	Violation *CirculationCut
+
+	Algorithm     Algorithm
+	Augmentations int
+	Partial       bool
+}
+
+// CirculationCut is a vertex set whose demand cannot be delivered.
+//
+// Implementation:
+//   - Capacity = upper bounds of InEdges - lower bounds of OutEdges: the most
+//     flow the set can net-receive, since OutEdges must carry flow out.
+//
+// Behavior highlights:
+//   - Demand > Capacity proves infeasibility (Hoffman's circulation theorem);
+//     the check needs nothing but the graph, the bounds, and the demands.
+//   - InEdges holds every positive-capacity edge entering Vertices, including
+//     undirected edges crossing the boundary; OutEdges holds directed edges
+//     leaving Vertices with a positive lower bound.
+//
+// Determinism:
+//   - Vertices follows core.Vertices(); edge lists follow core.Edges().
+type CirculationCut struct {
+	Vertices []string
+	Demand   float64
+	Capacity float64
+	InEdges  []string
+	OutEdges []string
+}
+
+// DisjointPathsResult is the result artifact of EdgeDisjointPaths and
+// VertexDisjointPaths.
+//
+// Behavior highlights:
+//   - Paths are simple source-sink paths with Amount 1; no two share an edge
+//     (edge-disjoint) or an inner vertex (vertex-disjoint).
+//   - MaxPaths is the local connectivity: the largest number of disjoint
+//     paths between the terminals. len(Paths) is min(MaxPaths, WithPathCount).
+//   - The cut is Menger's certificate that no more paths exist. For
+//     edge-disjoint paths CutEdges holds MaxPaths edges. For vertex-disjoint
+//     paths CutVertices holds the inner vertices of the cut and CutEdges the
+//     direct source-sink edges; together they count MaxPaths.
+//
+// Determinism:
+//   - Paths follow the flow decomposition order; CutVertices follows
+//     core.Vertices() and CutEdges follows core.Edges().
+//
+// AI-Hints:
+//   - A redundancy audit wants MaxPaths >= 2 and reads the cut as the list of
+//     single points of failure when MaxPaths == 1.
+type DisjointPathsResult struct {
+	Source string
+	Sink   string
+
+	Paths    []FlowPath
+	MaxPaths int
+
+	CutEdges    []string
+	CutVertices []string
+}
+
+// MinWeightPathsResult is the result artifact of MinWeightEdgeDisjointPaths
+// and MinWeightVertexDisjointPaths.
+//
+// Behavior highlights:
+//   - Paths are disjoint as in DisjointPathsResult; Weights[i] is the total
+//     cost of Paths[i] and Weight their sum, the minimum over every set of
+//     that many disjoint paths.
+//   - Paths are ordered by weight, cheapest first; ties keep decomposition order.
+//
+// AI-Hints:
+//   - Paths[0] is not necessarily the single shortest path: the cheapest pair
+//     may avoid it, which is the point of Suurballe's algorithm.
+type MinWeightPathsResult struct {
+	Source string
+	Sink   string
+
+	Paths   []FlowPath
+	Weights []float64
+	Weight  float64
+}
+
+// ConnectivityResult is the result artifact of EdgeConnectivity and
+// VertexConnectivity.
+//
+// Behavior highlights:
+//   - Value is the connectivity of the whole graph: the fewest edges (or
+//     vertices) whose removal disconnects it; for mixed graphs, strong
+//     connectivity along edge directions.
+//   - Source and Sink witness the minimum: Value is their local connectivity,
+//     and CutEdges (edge connectivity) or CutVertices (vertex connectivity)
+//     separates them with exactly Value elements.
+//   - A complete graph has vertex connectivity V-1 and no separating cut, so
+//     Source, Sink, and CutVertices stay empty; so do graphs with fewer than
+//     two vertices, whose connectivity is 0.
+//
+// Determinism:
+//   - The witness is the first minimum in core.Vertices() pair order.
+type ConnectivityResult struct {
+	Value int
+
+	Source string
+	Sink   string
+
+	CutEdges    []string
+	CutVertices []string
+}
@@ -16,7 +16,7 @@ import (
 //
 // Implementation:
 //   - Stage 1: Reject nil graph.
-//   - Stage 2: Require weighted graph because Edge.Weight carries capacity.
+//   - Stage 2: Require a weighted graph unless WithCapacity supplies capacities.
 //   - Stage 3: Respect context cancellation before heavy adapter work.
 //
 // Behavior highlights:
@@ -53,7 +53,7 @@ func validateFlowGraphOnly(g *core.Graph, cfg options) error {
 	if err := cfg.ctx.Err(); err != nil {
 		return err
 	}
-	if !g.Weighted() {
+	if cfg.capacity == nil && !g.Weighted() {
 		return errors.Join(ErrUnweightedGraph, core.ErrBadWeight)
 	}
 
@@ -66,7 +66,7 @@ func validateFlowGraphOnly(g *core.Graph, cfg options) error {
 //   - Stage 1: Reject nil graph before any method call.
 //   - Stage 2: Reject empty and identical terminal IDs.
 //   - Stage 3: Validate terminal presence through core.Graph.
-//   - Stage 4: Require weighted graphs because capacities are stored in Edge.Weight.
+//   - Stage 4: Require weighted graphs unless WithCapacity supplies capacities.
 //
 // Behavior highlights:
 //   - Multi-layer errors preserve flow and core sentinels where applicable.
@@ -97,8 +97,6 @@ func validateFlowGraphOnly(g *core.Graph, cfg options) error {
 // AI-Hints:
 //   - Do not call g.HasVertex before nil graph validation.
 func validateFlowInput(g *core.Graph, source, sink string, cfg options) error {
-	_ = cfg
-
 	if g == nil {
 		return ErrNilGraph
 	}
@@ -114,7 +112,7 @@ func validateFlowInput(g *core.Graph, source, sink string, cfg options) error {
 	if !g.HasVertex(sink) {
 		return errors.Join(ErrSinkNotFound, core.ErrVertexNotFound)
 	}
-	if !g.Weighted() {
+	if cfg.capacity == nil && !g.Weighted() {
 		return errors.Join(ErrUnweightedGraph, core.ErrBadWeight)
 	}
 
@@ -168,7 +166,34 @@ func validateCapacity(edge *core.Edge, epsilon float64) (float64, error) {
 		return 0, ErrInvalidCapacity
 	}
 
-	capacity := edge.Weight
+	return validateCapacityValue(edge, edge.Weight, epsilon)
+}
+
+// edgeCapacity reads one edge capacity through WithCapacity, or Edge.Weight by
+// default, and validates it with the validateCapacity law.
+//
+// Complexity:
+//   - Time O(1) plus the capacity callback, Space O(1).
+func edgeCapacity(edge *core.Edge, cfg options) (float64, error) {
+	if edge == nil {
+		return 0, ErrInvalidCapacity
+	}
+	if cfg.capacity == nil {
+		return validateCapacity(edge, cfg.epsilon)
+	}
+
+	return validateCapacityValue(edge, cfg.capacity(*edge), cfg.epsilon)
+}
+
+// validateCapacityValue applies the validateCapacity law to a capacity that
+// was read from edge by a caller-supplied function rather than Edge.Weight.
+//
+// Errors:
+//   - Same as validateCapacity.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func validateCapacityValue(edge *core.Edge, capacity float64, epsilon float64) (float64, error) {
 	if math.IsNaN(capacity) || math.IsInf(capacity, 0) {
 		return 0, errors.Join(
 			ErrInvalidCapacity,
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package flow seeds a residual network with the flow of a previous run.
+//
+// A warm start keeps as much of the old flow as the new capacities allow and
+// leaves the rest to the selected kernel, so a small capacity change costs a
+// few augmentations instead of a cold solve.
+package flow
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// seedWarmStart loads cfg.warmStart into rn as a feasible initial flow.
+//
+// Implementation:
+//   - Stage 1: Check that the previous result is complete and has the same terminals.
+//   - Stage 2: Decompose its EdgeFlows on g into source-sink paths; cycles
+//     carry no value and are dropped.
+//   - Stage 3: Walk the paths in decomposition order and keep each one at the
+//     smallest of its amount and the capacity its edges still have left.
+//   - Stage 4: Push every kept amount through rn with addResidual.
+//
+// Behavior highlights:
+//   - Capacity decreases trim or drop the paths that crossed the reduced
+//     edges; what remains is conserving and within every new capacity.
+//   - Capacity increases keep the whole old flow; the kernel then augments
+//     from it as from any other feasible flow.
+//
+// Inputs:
+//   - g: the graph of this run, already validated.
+//   - rn: the fresh residual network of g.
+//   - source, sink: terminals of this run.
+//   - cfg: finalized options with a non-nil warmStart.
+//
+// Returns:
+//   - float64: value of the seeded flow.
+//
+// Errors:
+//   - ErrWarmStartMismatch for a partial result or other terminals.
+//   - ErrNoEdgeFlows, ErrFlowNotConserved from decomposeFlow when the result
+//     does not belong to a graph with g's edges.
+//
+// Determinism:
+//   - Paths follow decomposeFlow order, so the same inputs seed the same flow.
+//
+// Complexity:
+//   - Time O(E * V) for the decomposition, Space O(V + E).
+//
+// AI-Hints:
+//   - Trimming is greedy and may keep less than a maximal feasible part of
+//     the old flow; the kernel recovers the difference.
+func seedWarmStart(g *core.Graph, rn *residualNetwork, source, sink string, cfg options) (float64, error) {
+	prev := cfg.warmStart
+	if prev.Partial {
+		return 0, fmt.Errorf("%w: previous result is partial", ErrWarmStartMismatch)
+	}
+	if prev.Source != source || prev.Sink != sink {
+		return 0, fmt.Errorf("%w: previous result is %q->%q, run is %q->%q",
+			ErrWarmStartMismatch, prev.Source, prev.Sink, source, sink)
+	}
+
+	flows, err := prev.EdgeFlows()
+	if err != nil {
+		return 0, err
+	}
+	decomposition, err := decomposeFlow(g, source, sink, flows)
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := make(map[string]float64)
+	for _, edge := range g.Edges() {
+		if edge.From == edge.To {
+			continue
+		}
+		if remaining[edge.ID], err = edgeCapacity(edge, cfg); err != nil {
+			return 0, err
+		}
+	}
+
+	value := 0.0
+	for _, path := range decomposition.Paths {
+		keep := path.Amount
+		for _, edgeID := range path.EdgeIDs {
+			keep = math.Min(keep, remaining[edgeID])
+		}
+		if keep <= cfg.epsilon {
+			continue
+		}
+
+		for position, edgeID := range path.EdgeIDs {
+			remaining[edgeID] -= keep
+			addResidual(rn, path.Vertices[position], path.Vertices[position+1], keep, cfg.epsilon)
+		}
+		value += keep
+	}
+
+	return value, nil
+}
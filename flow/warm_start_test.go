@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// AI-HINTS (file):
+//   - A warm-started run must be indistinguishable from a cold one by its
+//     certificate: the same Value, feasible EdgeFlows, and a tight cut.
+//   - Capacity drift rebuilds the graph under the same edge IDs, so the
+//     previous EdgeFlows still name real edges.
+
+package flow_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+)
+
+// withCapacities rebuilds g with capacity(edge) as every edge weight and the
+// original edge IDs.
+func withCapacities(t *testing.T, g *core.Graph, capacity func(edge *core.Edge) float64) *core.Graph {
+	t.Helper()
+
+	next := mustGraph(t, core.WithDirected(g.Directed()), core.WithWeighted(), core.WithMultiEdges())
+	for _, vertexID := range g.Vertices() {
+		mustAddVertex(t, next, vertexID)
+	}
+	edges := g.Edges()
+	ids := make([]string, len(edges))
+	for position, edge := range edges {
+		id, err := next.AddEdge(edge.From, edge.To, capacity(edge))
+		mustNoError(t, err, "AddEdge")
+		ids[position] = "tmp-" + edge.ID
+		mustNoError(t, next.SetEdgeID(id, ids[position]), "SetEdgeID")
+	}
+	// Rename in a second pass so no generated ID collides with a wanted one.
+	for position, edge := range edges {
+		mustNoError(t, next.SetEdgeID(ids[position], edge.ID), "SetEdgeID")
+	}
+
+	return next
+}
+
+func TestMaxFlow_WarmStartMatchesColdSolveAfterDrift(t *testing.T) {
+	for _, tt := range allAlgorithms() {
+		t.Run(tt.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(50))
+			for trial := 0; trial < 40; trial++ {
+				vertices := 2 + rng.Intn(10)
+				g := randomCapacityNetwork(t, rng, vertices, rng.Intn(4*vertices+1), trial%3 != 0)
+				source, sink := "v00", fmt.Sprintf("v%02d", vertices-1)
+
+				prev, err := flow.MaxFlow(g, source, sink, flow.WithAlgorithm(tt.algorithm))
+				mustNoError(t, err, "MaxFlow")
+
+				// Each round raises, lowers, zeroes, or keeps every capacity.
+				for round := 0; round < 3; round++ {
+					g = withCapacities(t, g, func(edge *core.Edge) float64 {
+						switch rng.Intn(4) {
+						case 0:
+							return edge.Weight + float64(rng.Intn(5))
+						case 1:
+							return float64(rng.Intn(int(edge.Weight) + 1))
+						case 2:
+							return 0
+						}
+						return edge.Weight
+					})
+
+					cold, err := flow.MaxFlow(g, source, sink, flow.WithAlgorithm(tt.algorithm))
+					mustNoError(t, err, "cold MaxFlow")
+					warm, err := flow.MaxFlow(g, source, sink, flow.WithAlgorithm(tt.algorithm), flow.WithWarmStart(prev))
+					mustNoError(t, err, "warm MaxFlow")
+
+					op := fmt.Sprintf("trial %d round %d", trial, round)
+					mustSuccessfulCertificate(t, g, warm, source, sink, cold.Value, 1e-9, op)
+					mustValidEdgeFlows(t, g, mustEdgeFlows(t, warm), source, sink, warm.Value)
+					prev = warm
+				}
+			}
+		})
+	}
+}
+
+func TestMaxFlow_WarmStartReusesSurvivingFlow(t *testing.T) {
+	// Ten unit lanes S->Mi->T; cutting one lane leaves nine routes that the
+	// previous flow already saturates.
+	const lanes = 10
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	for i := 0; i < lanes; i++ {
+		mid := fmt.Sprintf("M%d", i)
+		mustAddEdge(t, g, "S", mid, 1)
+		mustAddEdge(t, g, mid, "T", 1)
+	}
+
+	for _, tt := range allAlgorithms() {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, err := flow.MaxFlow(g, "S", "T", flow.WithAlgorithm(tt.algorithm))
+			mustNoError(t, err, "MaxFlow")
+
+			same, err := flow.MaxFlow(g, "S", "T", flow.WithAlgorithm(tt.algorithm), flow.WithWarmStart(prev))
+			mustNoError(t, err, "unchanged")
+			mustSuccessfulCertificate(t, g, same, "S", "T", lanes, 1e-9, "unchanged")
+			if same.Augmentations != 0 {
+				t.Fatalf("unchanged network: %d augmentations, want 0", same.Augmentations)
+			}
+
+			cut := withCapacities(t, g, func(edge *core.Edge) float64 {
+				if edge.From == "M3" {
+					return 0
+				}
+				return edge.Weight
+			})
+			warm, err := flow.MaxFlow(cut, "S", "T", flow.WithAlgorithm(tt.algorithm), flow.WithWarmStart(prev))
+			mustNoError(t, err, "lane cut")
+			mustSuccessfulCertificate(t, cut, warm, "S", "T", lanes-1, 1e-9, "lane cut")
+			if warm.Augmentations != 0 {
+				t.Fatalf("lane cut: %d augmentations, want 0", warm.Augmentations)
+			}
+
+			// Restoring the lane costs exactly one more delivery.
+			restored, err := flow.MaxFlow(g, "S", "T", flow.WithAlgorithm(tt.algorithm), flow.WithWarmStart(warm))
+			mustNoError(t, err, "lane restored")
+			mustSuccessfulCertificate(t, g, restored, "S", "T", lanes, 1e-9, "lane restored")
+			if restored.Augmentations != 1 {
+				t.Fatalf("lane restored: %d augmentations, want 1", restored.Augmentations)
+			}
+		})
+	}
+}
+
+func TestMaxFlow_WarmStartTrimsUndirectedFlow(t *testing.T) {
+	g := mustGraph(t, core.WithWeighted())
+	mustAddEdge(t, g, "S", "A", 5)
+	mustAddEdge(t, g, "A", "B", 5)
+	mustAddEdge(t, g, "B", "T", 5)
+	mustAddEdge(t, g, "S", "B", 2)
+
+	prev, err := flow.MaxFlow(g, "S", "T")
+	mustNoError(t, err, "MaxFlow")
+	mustFlowValue(t, prev, 5, "before")
+
+	narrowed := withCapacities(t, g, func(edge *core.Edge) float64 {
+		if edge.From == "A" {
+			return 1
+		}
+		return edge.Weight
+	})
+	warm, err := flow.MaxFlow(narrowed, "S", "T", flow.WithWarmStart(prev))
+	mustNoError(t, err, "narrowed")
+	mustSuccessfulCertificate(t, narrowed, warm, "S", "T", 3, 1e-9, "narrowed")
+	mustValidEdgeFlows(t, narrowed, mustEdgeFlows(t, warm), "S", "T", 3)
+}
+
+func TestMaxFlow_WarmStartValidation(t *testing.T) {
+	g := mustGraph(t, core.WithDirected(true), core.WithWeighted())
+	mustAddEdge(t, g, "S", "A", 2)
+	mustAddEdge(t, g, "A", "T", 2)
+	prev, err := flow.MaxFlow(g, "S", "T")
+	mustNoError(t, err, "MaxFlow")
+
+	grown := withCapacities(t, g, func(edge *core.Edge) float64 { return edge.Weight })
+	mustAddEdge(t, grown, "S", "T", 1)
+	partial := *prev
+	partial.Partial = true
+	incomplete := &flow.MaxFlowResult{Value: prev.Value, Source: "S", Sink: "T"}
+
+	tests := []struct {
+		name string
+		g    *core.Graph
+		opt  flow.Option
+		from string
+		err  error
+	}{
+		{name: "Nil", g: g, opt: flow.WithWarmStart(nil), from: "S", err: flow.ErrInvalidOptions},
+		{name: "OtherSource", g: g, opt: flow.WithWarmStart(prev), from: "A", err: flow.ErrWarmStartMismatch},
+		{name: "Partial", g: g, opt: flow.WithWarmStart(&partial), from: "S", err: flow.ErrWarmStartMismatch},
+		{name: "NoEdgeFlows", g: g, opt: flow.WithWarmStart(incomplete), from: "S", err: flow.ErrNoEdgeFlows},
+		{name: "EdgeAdded", g: grown, opt: flow.WithWarmStart(prev), from: "S", err: flow.ErrFlowNotConserved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := flow.MaxFlow(tt.g, tt.from, "T", tt.opt)
+			mustErrorIs(t, err, tt.err, "MaxFlow")
+		})
+	}
+
+	// MultiMaxFlow ignores the option instead of rejecting its super terminals.
+	multi, err := flow.MultiMaxFlow(g, []string{"S"}, []string{"T"}, flow.WithWarmStart(prev))
+	mustNoError(t, err, "MultiMaxFlow")
+	mustEqualFloat(t, multi.Value, 2, "MultiMaxFlow value")
+}
@@ -1,12 +1,11 @@
 // SPDX-License-Identifier: AGPL-3.0-only
 // Copyright (C) 2025-2026 katalvlaran
 
-// Package tsp benchmarks the private dense Blossom MWPM engine.
+// Package blossom benchmarks the private dense Blossom MWPM engine.
 //
-// These benchmarks intentionally live in package tsp rather than tsp_test,
-// because solveMinimumWeightPerfectMatching is not public API. Exposing test-only
-// wrappers just to benchmark private internals would pollute the package surface.
-package tsp
+// These benchmarks call solveMinimumWeightPerfectMatching directly so that the
+// numbers exclude the caller-side problem construction done by tsp.
+package blossom
 
 import "testing"
 
@@ -44,7 +43,7 @@ var benchmarkBlossomMatchSink []int
 //   - Benchmark setup stores O(k^2) weights.
 //
 // Notes:
-//   - Keep this benchmark private until a top-level matching package exists.
+//   - Keep the problem build outside the timed loop.
 //
 // AI-Hints:
 //   - Do not replace this with Christofides benchmarks; they measure different regimes.
@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: AGPL-3.0-only
 // Copyright (C) 2025-2026 katalvlaran
 
-// Package tsp contracts and expands odd blossoms in dense weighted matching.
+// Package blossom contracts and expands odd blossoms in dense weighted matching.
 // Contraction turns a same-tree outer/outer odd cycle into one active top-level node;
 // expansion restores cycle children when weighted search requires reopening a blossom.
 //
@@ -13,15 +13,15 @@
 //   - Provide structural cleanup for non-search restoration paths.
 //
 // Boundaries:
-//   - Path lifting through contracted cycles lives in blossom_path.go.
-//   - mate[] mutation lives in blossom_augment.go.
-//   - Tight-edge discovery lives in blossom_forest.go.
+//   - Path lifting through contracted cycles lives in path.go.
+//   - mate[] mutation lives in augment.go.
+//   - Tight-edge discovery lives in forest.go.
 //
 // AI-Hints:
 //   - Do not store only the closing shrink edge.
 //   - Do not clear forest labels during search expansion.
 //   - Do not use a separate children slice; cycles are the child-order source of truth.
-package tsp
+package blossom
 
 // shrink contracts an odd alternating cycle into one active top-level blossom node.
 // The new blossom replaces the cycle base node in the alternating forest, so root
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package blossom implements Edmonds' weighted Blossom algorithm shared by
+// package tsp (Christofides) and package matching (general-graph matching).
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - MinWeightPerfect(n, w, eps)
+//     Exact minimum-weight perfect matching of a dense complete graph given as
+//     a row-major cost buffer. This is the Christofides matching stage.
+//
+//   - Solve(n, edges, mode, eps)
+//     Optimal weighted matching of a sparse general graph under a perfect,
+//     maximum-weight, or maximum-cardinality stopping rule, published with its
+//     laminar dual certificate.
+//
+// -----------------------------------------------------------------------------
+// -- WHY INTERNAL -------------------------------------------------------------
+//
+// The engine works on local vertex indices and raw tolerances. Public callers
+// should use package matching, which owns vertex IDs, graphs, and matrices, or
+// tsp.Christofides, which owns the odd-vertex reduction. Keeping the engine
+// internal lets both share one implementation without widening either API.
+//
+// -----------------------------------------------------------------------------
+// -- ERRORS -------------------------------------------------------------------
+//
+// The sentinels in errors.go are engine-level. Callers translate them into
+// their own package sentinels before returning to users.
+//
+// AI-Hints:
+//   - Do not import package tsp or package matching from here; they import this package.
+//   - Keep engine vertex indices local; callers map them back to their own IDs.
+package blossom
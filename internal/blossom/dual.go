@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: AGPL-3.0-only
 // Copyright (C) 2025-2026 katalvlaran
 
-// Package tsp implements the dual/slack subsystem for weighted Blossom search.
+// Package blossom implements the dual/slack subsystem for weighted Blossom search.
 // Dual movement exposes new tight edges when the current alternating forest has
 // no immediately applicable grow, shrink, augment, or expand event.
 //
@@ -20,7 +20,7 @@
 //   - Do not compute final matching cost from dual values.
 //   - Do not silently clamp large negative duals.
 //   - Do not change tie-breaking without updating deterministic tests.
-package tsp
+package blossom
 
 import "math"
 
@@ -46,6 +46,10 @@ const (
 
 	// deltaExpandInnerBlossom reduces an inner blossom dual to zero.
 	deltaExpandInnerBlossom
+
+	// deltaFreeVertexDualZero reduces the free vertex duals to zero and ends
+	// a blossomModeMaxWeight search.
+	deltaFreeVertexDualZero
 )
 
 // blossomDelta stores one dense dual update candidate.
@@ -307,7 +311,8 @@ func (e *blossomEngine) isAllocatedBlossom(node int) bool {
 //   - Stage 2: Consider outer-to-unlabeled grow deltas.
 //   - Stage 3: Consider outer-to-outer join deltas.
 //   - Stage 4: Consider zero-dual inner blossom expansion deltas.
-//   - Stage 5: Reject when no finite candidate exists.
+//   - Stage 5: In blossomModeMaxWeight, consider driving free vertex duals to zero.
+//   - Stage 6: Reject when no finite candidate exists.
 //
 // Behavior highlights:
 //   - Does not mutate duals.
@@ -352,6 +357,9 @@ func (e *blossomEngine) nextDelta() (blossomDelta, error) {
 	if err := e.considerExpandDeltas(&best); err != nil {
 		return best, err
 	}
+	if e.mode == blossomModeMaxWeight {
+		e.considerFreeVertexDeltas(&best)
+	}
 
 	if best.kind == deltaNone || math.IsInf(best.value, 1) {
 		return best, ErrIncompleteGraph
@@ -534,6 +542,51 @@ func (e *blossomEngine) considerExpandDeltas(best *blossomDelta) error {
 	return nil
 }
 
+// considerFreeVertexDeltas bounds dual movement by the dual of the free vertices.
+// Once free vertex duals reach zero, every augmenting path would lower the total
+// profit, so a blossomModeMaxWeight search stops there.
+//
+// Implementation:
+//   - Stage 1: Scan original vertices in increasing order.
+//   - Stage 2: Offer dual[v] for every unmatched vertex v.
+//
+// Behavior highlights:
+//   - Free vertices are outer roots in every search and start from the same
+//     dual, so they always share the smallest vertex dual.
+//   - Edge-backed candidates win exact ties through deltaTieLess.
+//
+// Inputs:
+//   - best: pointer to currently selected best delta.
+//
+// Returns:
+//   - None.
+//
+// Errors:
+//   - None.
+//
+// Determinism:
+//   - Fixed vertex scan and improveDelta tie-breaking.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+//
+// AI-Hints:
+//   - Do not call this in blossomModePerfect; vertex duals are unrestricted there.
+func (e *blossomEngine) considerFreeVertexDeltas(best *blossomDelta) {
+	for vertex := 0; vertex < e.problem.n; vertex++ {
+		if e.mate[vertex] != noVertex {
+			continue
+		}
+
+		e.improveDelta(best, blossomDelta{
+			kind:  deltaFreeVertexDualZero,
+			value: e.dual[vertex],
+			edge:  noEdge,
+			node:  vertex,
+		})
+	}
+}
+
 // applyDelta moves the laminar dual system by one selected non-negative delta.
 // Vertex duals move for every original vertex inside labeled top-level nodes.
 // Allocated top-level blossom duals move in the opposite doubled amount to keep
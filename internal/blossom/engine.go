@@ -1,28 +1,28 @@
 // SPDX-License-Identifier: AGPL-3.0-only
 // Copyright (C) 2025-2026 katalvlaran
 
-// Package tsp defines dense weighted Blossom engine state.
+// Package blossom defines dense weighted Blossom engine state.
 // This file owns the local maximum-profit transformation, engine allocation,
 // solver entrypoint, result export, and structural verification helpers.
 //
 // Responsibility:
 //   - Build immutable dense edge storage from matchingProblem.
 //   - Allocate matching, forest, contraction, membership, and dual arrays.
-//   - Keep all vertex IDs local to the odd-set matching problem.
+//   - Keep all vertex IDs local to the matching problem.
 //   - Export only original-vertex perfect matchings.
 //
 // Boundaries:
-//   - Alternating-forest search lives in blossom_forest.go.
-//   - Dual/slack movement lives in blossom_dual.go.
-//   - Contraction/expansion lives in blossom_contract.go.
-//   - Path lifting lives in blossom_path.go.
-//   - mate[] mutation lives in blossom_augment.go.
+//   - Alternating-forest search lives in forest.go.
+//   - Dual/slack movement lives in dual.go.
+//   - Contraction/expansion lives in contract.go.
+//   - Path lifting lives in path.go.
+//   - mate[] mutation lives in augment.go.
 //
 // AI-Hints:
-//   - Do not expose blossomEngine through public TSP API.
-//   - Do not store original TSP matrix vertex IDs in mate[].
+//   - Do not expose blossomEngine outside this package.
+//   - Do not store caller vertex IDs in mate[].
 //   - Do not compute published matching cost from transformed profits.
-package tsp
+package blossom
 
 import "math"
 
@@ -58,10 +58,39 @@ type blossomOptions struct {
 	// Eps is the positive finite numeric tolerance used by slack, tight-edge,
 	// dual-feasibility, and equality checks inside the Blossom engine.
 	Eps float64
+
+	// Mode selects the matching objective; the zero value is the perfect
+	// matching search behind MinWeightPerfect.
+	Mode blossomMode
 }
 
+// blossomMode selects when the weighted search stops.
+//
+// Behavior highlights:
+//   - blossomModePerfect augments until every vertex is matched and fails with
+//     ErrIncompleteGraph when no perfect matching exists.
+//   - blossomModeMaxWeight stops when the free vertex duals reach zero, the
+//     point where no augmentation can raise the total profit.
+//   - blossomModeMaxCardinality stops when no dual movement is left, which
+//     happens only once no augmenting path exists.
+//
+// AI-Hints:
+//   - Keep blossomModePerfect the zero value; MinWeightPerfect relies on it.
+type blossomMode uint8
+
+const (
+	// blossomModePerfect requires a perfect matching.
+	blossomModePerfect blossomMode = iota
+
+	// blossomModeMaxWeight allows free vertices and maximizes total profit.
+	blossomModeMaxWeight
+
+	// blossomModeMaxCardinality maximizes profit among maximum-cardinality matchings.
+	blossomModeMaxCardinality
+)
+
 // blossomStats records deterministic internal telemetry produced by the dense Blossom engine.
-// It is intentionally private: public TSP results must not expose Blossom implementation counters
+// It is intentionally private: public matching results must not expose Blossom implementation counters
 // until those counters become stable API semantics.
 //
 // Implementation:
@@ -174,12 +203,12 @@ type blossomEdge struct {
 
 	// u is the smaller local endpoint in [0, problem.n).
 	// Dense construction guarantees u<v and never creates self-loops.
-	// It is an index into matchingProblem, not the original TSP matrix.
+	// It is an index into matchingProblem, not a caller vertex ID.
 	u int
 
 	// v is the larger local endpoint in [0, problem.n).
 	// Dense construction guarantees v>u and stores the undirected edge once.
-	// It is an index into matchingProblem, not the original TSP matrix.
+	// It is an index into matchingProblem, not a caller vertex ID.
 	v int
 
 	// cost is the original local minimization weight.
@@ -337,10 +366,10 @@ const blossomDeltaSelectionToleranceMultiplier = 16.0
 //   - Engine node storage O(k).
 //
 // AI-Hints:
-//   - Do not expose this engine outside package tsp.
-//   - Do not store original TSP matrix indices in mate[]; use local indices.
+//   - Do not expose this engine outside package blossom.
+//   - Do not store caller vertex IDs in mate[]; use local indices.
 type blossomEngine struct {
-	// problem is the detached local MWPM instance over odd-degree vertices.
+	// problem is the detached local matching instance.
 	// All engine vertex indices are local positions in this problem.
 	problem matchingProblem
 
@@ -348,6 +377,9 @@ type blossomEngine struct {
 	// It is copied from blossomOptions and never mutated.
 	eps float64
 
+	// mode is the stopping rule copied from blossomOptions.
+	mode blossomMode
+
 	// scale stores the largest original matching cost used to derive a scale-aware
 	// numeric tolerance for dual/slack comparisons.
 	// It does not affect the original matching objective or exported cost.
@@ -483,6 +515,49 @@ func newBlossomEngine(problem matchingProblem, opts blossomOptions) (*blossomEng
 		return nil, err
 	}
 
+	return allocateBlossomEngine(problem, edges, incident, maxCost, blossomNumericScale(maxCost), opts), nil
+}
+
+// allocateBlossomEngine allocates engine state around a prepared edge set.
+//
+// Implementation:
+//   - Stage 1: Allocate matching, blossom, forest, and dual arrays.
+//   - Stage 2: Initialize original vertices as active singleton blossoms with
+//     vertex dual initialDual.
+//
+// Behavior highlights:
+//   - Shared by the dense constructor and the sparse general constructor.
+//   - initialDual must be >= every edge profit so that the start is dual feasible.
+//
+// Inputs:
+//   - problem: local problem; only problem.n is read by the search.
+//   - edges, incident: immutable edge storage with edge.id == index.
+//   - initialDual: starting vertex dual.
+//   - scale: numeric tolerance scale from blossomNumericScale.
+//   - opts: validated Blossom policy.
+//
+// Returns:
+//   - *blossomEngine: initialized engine.
+//
+// Errors:
+//   - None; callers validate before allocation.
+//
+// Determinism:
+//   - Fixed allocation and initialization order.
+//
+// Complexity:
+//   - Time O(k), Space O(k) beyond the edge storage.
+//
+// AI-Hints:
+//   - Node capacity 2*k-1 is a starting size; allocateBlossomNode grows it.
+func allocateBlossomEngine(
+	problem matchingProblem,
+	edges []blossomEdge,
+	incident [][]int,
+	initialDual float64,
+	scale float64,
+	opts blossomOptions,
+) *blossomEngine {
 	nodeCapacity := 1
 	if problem.n > 0 {
 		nodeCapacity = 2*problem.n - 1
@@ -491,7 +566,8 @@ func newBlossomEngine(problem matchingProblem, opts blossomOptions) (*blossomEng
 	engine := &blossomEngine{
 		problem:   problem,
 		eps:       opts.Eps,
-		scale:     blossomNumericScale(maxCost),
+		mode:      opts.Mode,
+		scale:     scale,
 		edges:     edges,
 		incident:  incident,
 		mate:      makeFilledInt(problem.n, noVertex),
@@ -513,11 +589,11 @@ func newBlossomEngine(problem matchingProblem, opts blossomOptions) (*blossomEng
 	for vertex := 0; vertex < problem.n; vertex++ {
 		engine.base[vertex] = vertex
 		engine.active[vertex] = true
-		engine.dual[vertex] = maxCost
+		engine.dual[vertex] = initialDual
 		engine.members[vertex] = []int{vertex}
 	}
 
-	return engine, nil
+	return engine
 }
 
 // blossomNumericScale normalizes the matching-cost magnitude used by scale-aware
@@ -884,10 +960,11 @@ func (e *blossomEngine) verifyDualFeasibility() error {
 //   - Stage 1: Verify mate[] / mateEdge[] symmetry.
 //   - Stage 2: Verify active top-level ownership for every original vertex.
 //   - Stage 3: Verify dual feasibility and matched-edge tightness.
+//   - Stage 4: Verify the free-vertex dual rule of the search mode.
 //
 // Behavior highlights:
 //   - Does not mutate engine state.
-//   - Runs after the solver has reached a perfect matching count.
+//   - Runs after the solver has reached the mode's optimum.
 //   - Converts hidden internal corruption into ErrInvalidMatching before export.
 //
 // Inputs:
@@ -920,6 +997,76 @@ func (e *blossomEngine) verifyOptimalState() error {
 	if err := e.verifyDualFeasibility(); err != nil {
 		return err
 	}
+	if err := e.verifyFreeVertexDuals(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// verifyFreeVertexDuals checks the vertex-dual conditions that make the final
+// duals an optimality certificate for the non-perfect modes.
+//
+// Implementation:
+//   - Stage 1: Find the smallest vertex dual.
+//   - Stage 2: blossomModeMaxWeight: require non-negative vertex duals and
+//     zero duals on free vertices.
+//   - Stage 3: blossomModeMaxCardinality: require every free vertex to carry
+//     the smallest vertex dual.
+//
+// Behavior highlights:
+//   - No-op in blossomModePerfect, where every vertex is matched.
+//   - Does not mutate engine state.
+//
+// Inputs:
+//   - None; reads dual[] and mate[].
+//
+// Returns:
+//   - error: nil when the mode's vertex-dual conditions hold.
+//
+// Errors:
+//   - ErrInvalidMatching when a condition is violated.
+//
+// Determinism:
+//   - Fixed increasing vertex scan.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+//
+// Notes:
+//   - With equal minimal free duals, every matching of the same size has total
+//     profit at most the vertex-dual sum over its endpoints plus blossom terms,
+//     which is largest for the computed matching.
+//
+// AI-Hints:
+//   - Do not relax the max-weight rule to the cardinality rule; negative
+//     vertex duals would no longer bound unmatched vertices.
+func (e *blossomEngine) verifyFreeVertexDuals() error {
+	if e.mode == blossomModePerfect || e.problem.n == 0 {
+		return nil
+	}
+
+	tol := e.dualTolerance()
+	minDual := math.Inf(1)
+	for vertex := 0; vertex < e.problem.n; vertex++ {
+		minDual = math.Min(minDual, e.dual[vertex])
+	}
+
+	for vertex := 0; vertex < e.problem.n; vertex++ {
+		switch e.mode {
+		case blossomModeMaxWeight:
+			if e.dual[vertex] < -tol {
+				return ErrInvalidMatching
+			}
+			if e.mate[vertex] == noVertex && e.dual[vertex] > tol {
+				return ErrInvalidMatching
+			}
+		case blossomModeMaxCardinality:
+			if e.mate[vertex] == noVertex && e.dual[vertex] > minDual+tol {
+				return ErrInvalidMatching
+			}
+		}
+	}
 
 	return nil
 }
@@ -965,7 +1112,7 @@ func validateBlossomProblem(problem matchingProblem, opts blossomOptions) error
 	if problem.n < 0 || (problem.n&1) == 1 {
 		return ErrInvalidMatching
 	}
-	if len(problem.odd) != problem.n || len(problem.w) != problem.n*problem.n {
+	if len(problem.w) != problem.n*problem.n {
 		return ErrInvalidMatching
 	}
 
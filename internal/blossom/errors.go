@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package blossom
+
+import "errors"
+
+var (
+	// ErrInvalidOptions indicates an unknown mode or a tolerance that is not
+	// finite and strictly positive.
+	ErrInvalidOptions = errors.New("blossom: invalid options")
+
+	// ErrInvalidMatching indicates malformed input or an internal state that
+	// failed verification.
+	ErrInvalidMatching = errors.New("blossom: invalid matching input")
+
+	// ErrIncompleteGraph indicates that no perfect matching exists.
+	ErrIncompleteGraph = errors.New("blossom: no perfect matching exists")
+
+	// ErrNaNInf indicates a NaN or ±Inf cost or weight.
+	ErrNaNInf = errors.New("blossom: NaN or Inf encountered")
+
+	// ErrNegativeWeight indicates a negative dense cost.
+	ErrNegativeWeight = errors.New("blossom: negative cost encountered")
+
+	// ErrAsymmetry indicates a dense cost buffer with w[i*n+j] != w[j*n+i].
+	ErrAsymmetry = errors.New("blossom: asymmetric cost buffer")
+)
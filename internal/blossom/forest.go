@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: AGPL-3.0-only
 // Copyright (C) 2025-2026 katalvlaran
 
-// Package tsp manages the alternating forest for dense weighted Blossom search.
+// Package blossom manages the alternating forest for dense weighted Blossom search.
 // The forest discovers tight-edge events: grow, shrink, augment, and expand.
 // It is rebuilt for each augmentation attempt from the current committed matching.
 //
@@ -12,18 +12,21 @@
 //   - Route events to grow, shrink, augment, or expand handlers.
 //
 // Boundaries:
-//   - Slack and dual updates live in blossom_dual.go.
-//   - Path realization and lifting live in blossom_path.go.
-//   - Matching mutation lives in blossom_augment.go.
-//   - Cycle contraction details live in blossom_contract.go.
+//   - Slack and dual updates live in dual.go.
+//   - Path realization and lifting live in path.go.
+//   - Matching mutation lives in augment.go.
+//   - Cycle contraction details live in contract.go.
 //
 // AI-Hints:
 //   - Do not mutate mate[] from forest scanning.
 //   - Do not classify same-tree outer/outer edges as augmenting paths.
 //   - Do not reuse forest labels across augmentation attempts.
-package tsp
+package blossom
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // blossomEventKind names the next structural action found by tight-edge scanning.
 // Events are applied immediately by applyBlossomEvent.
@@ -85,8 +88,7 @@ const (
 // AI-Hints:
 //   - Do not remove aVertex/bVertex.
 //   - Do not apply events directly inside incident-edge loops.
-//   - Do not store original TSP matrix vertices in a/b.
-//   - Do not store original TSP graph IDs here; these are local matchingProblem vertices.
+//   - Do not store caller vertex IDs in a/b; these are local matchingProblem vertices.
 type blossomEvent struct {
 	// kind selects which structural mutation must be applied.
 	kind blossomEventKind
@@ -107,15 +109,17 @@ type blossomEvent struct {
 	bVertex int
 }
 
-// solve repeatedly finds augmenting paths until every original local vertex is matched.
+// solve repeatedly finds augmenting paths until every original local vertex is matched
+// or, outside blossomModePerfect, until the mode's stopping rule proves optimality.
 // Each successful outer iteration must increase the number of committed matching pairs.
 //
 // Implementation:
-//   - Stage 1: Compute the target number of pairs from the local matching problem size.
+//   - Stage 1: Loop while some original local vertex is free.
 //   - Stage 2: Search for one complete augmenting path.
-//   - Stage 3: Verify that the committed matching size strictly increased.
-//   - Stage 4: Repeat until the perfect matching cardinality is reached.
-//   - Stage 5: Verify final structural, dual, and matching invariants.
+//   - Stage 3: Stop when the search reports that no profitable augmentation remains.
+//   - Stage 4: Verify that the committed matching size strictly increased.
+//   - Stage 5: Repeat until no vertex is free.
+//   - Stage 6: Verify final structural, dual, and matching invariants.
 //
 // Behavior highlights:
 //   - Grow, shrink, and expand events do not count as completed augmentations.
@@ -123,13 +127,13 @@ type blossomEvent struct {
 //   - The method never downgrades to a heuristic matching.
 //
 // Inputs:
-//   - None; uses the engine initialized by newBlossomEngine.
+//   - None; uses the engine initialized by newBlossomEngine or newSparseBlossomEngine.
 //
 // Returns:
-//   - error: nil when a perfect matching is found.
+//   - error: nil when the mode's optimum is found.
 //
 // Errors:
-//   - ErrIncompleteGraph from search when no augmenting path can be exposed.
+//   - ErrIncompleteGraph from search when blossomModePerfect finds no perfect matching.
 //   - ErrInvalidMatching when the engine reports a no-progress augmentation.
 //   - Verification sentinels from verifyOptimalState.
 //
@@ -147,14 +151,18 @@ type blossomEvent struct {
 //   - Do not increment Augmentations after grow or shrink.
 //   - Do not reset the forest between intermediate events of the same augmentation search.
 func (e *blossomEngine) solve() error {
-	targetPairs := e.problem.n / 2
-
-	for e.matchedPairs() < targetPairs {
+	// Odd non-perfect instances keep one free vertex whose dual must still be
+	// driven to the mode's stopping point, so the loop runs while any vertex is free.
+	for 2*e.matchedPairs() < e.problem.n {
 		before := e.matchedPairs()
 
-		if err := e.findAndApplyAugmentation(); err != nil {
+		augmented, err := e.findAndApplyAugmentation()
+		if err != nil {
 			return err
 		}
+		if !augmented {
+			break
+		}
 
 		after := e.matchedPairs()
 		if after <= before {
@@ -176,7 +184,8 @@ func (e *blossomEngine) solve() error {
 //   - Stage 2: Scan tight edges for immediate structural events.
 //   - Stage 3: Apply grow/shrink/expand events in-place and continue the same search.
 //   - Stage 4: Apply dual deltas when no tight event is currently available.
-//   - Stage 5: Return only after an augmenting path has been flipped.
+//   - Stage 5: Return only after an augmenting path has been flipped, or after
+//     the mode's stopping rule fired.
 //
 // Behavior highlights:
 //   - Does not restart the forest after grow or shrink.
@@ -187,10 +196,13 @@ func (e *blossomEngine) solve() error {
 //   - None; uses the current committed matching and contraction state.
 //
 // Returns:
-//   - error: nil only after one successful matching augmentation.
+//   - bool: true after one successful matching augmentation; false when
+//     blossomModeMaxWeight drove the free vertex duals to zero or
+//     blossomModeMaxCardinality ran out of dual movements.
+//   - error: nil on either outcome.
 //
 // Errors:
-//   - ErrIncompleteGraph when no valid delta/event can expose progress.
+//   - ErrIncompleteGraph when blossomModePerfect finds no valid delta/event.
 //   - ErrInvalidMatching for malformed events, contraction state, or path lifting.
 //   - Dual and path sentinels propagated from called subsystems.
 //
@@ -208,8 +220,8 @@ func (e *blossomEngine) solve() error {
 //
 // AI-Hints:
 //   - Returning after grow/shrink causes infinite restarts.
-//   - Only eventAugment should make this function return nil.
-func (e *blossomEngine) findAndApplyAugmentation() error {
+//   - Only eventAugment should make this function return true.
+func (e *blossomEngine) findAndApplyAugmentation() (bool, error) {
 	e.resetForest()
 
 	for vertex := 0; vertex < e.problem.n; vertex++ {
@@ -225,37 +237,44 @@ func (e *blossomEngine) findAndApplyAugmentation() error {
 	for step := 0; step < maxSteps; step++ {
 		event, ok, err := e.scanTightEdges()
 		if err != nil {
-			return fmt.Errorf("search step=%d scan tight edges queue=%v head=%d label=%v parent=%v root=%v: %w",
+			return false, fmt.Errorf("search step=%d scan tight edges queue=%v head=%d label=%v parent=%v root=%v: %w",
 				step, e.queue, e.head, e.label, e.parent, e.treeRoot, err)
 		}
 
 		if ok {
 			done, applyErr := e.applyBlossomEvent(event)
 			if applyErr != nil {
-				return fmt.Errorf("search step=%d apply event=%+v mate=%v mateEdge=%v label=%v parent=%v root=%v base=%v: %w",
+				return false, fmt.Errorf("search step=%d apply event=%+v mate=%v mateEdge=%v label=%v parent=%v root=%v base=%v: %w",
 					step, event, e.mate, e.mateEdge, e.label, e.parent, e.treeRoot, e.base, applyErr)
 			}
 			if done {
-				return nil
+				return true, nil
 			}
 
 			continue
 		}
 
 		delta, err := e.nextDelta()
+		if delta.kind == deltaNone && e.mode == blossomModeMaxCardinality && errors.Is(err, ErrIncompleteGraph) {
+			return false, nil
+		}
 		if err != nil {
-			return fmt.Errorf("search step=%d next delta mate=%v label=%v parent=%v root=%v dual=%v base=%v: %w",
+			return false, fmt.Errorf("search step=%d next delta mate=%v label=%v parent=%v root=%v dual=%v base=%v: %w",
 				step, e.mate, e.label, e.parent, e.treeRoot, e.dual, e.base, err)
 		}
 
 		if err = e.applyDelta(delta); err != nil {
-			return fmt.Errorf("search step=%d apply delta=%+v dual=%v label=%v base=%v: %w",
+			return false, fmt.Errorf("search step=%d apply delta=%+v dual=%v label=%v base=%v: %w",
 				step, delta, e.dual, e.label, e.base, err)
 		}
 
+		if delta.kind == deltaFreeVertexDualZero {
+			return false, nil
+		}
+
 		if delta.kind == deltaExpandInnerBlossom {
 			if err = e.expand(delta.node); err != nil {
-				return fmt.Errorf("search step=%d expand delta=%+v label=%v parent=%v root=%v dual=%v base=%v: %w",
+				return false, fmt.Errorf("search step=%d expand delta=%+v label=%v parent=%v root=%v dual=%v base=%v: %w",
 					step, delta, e.label, e.parent, e.treeRoot, e.dual, e.base, err)
 			}
 		}
@@ -263,7 +282,7 @@ func (e *blossomEngine) findAndApplyAugmentation() error {
 		e.rewindForestScan()
 	}
 
-	return fmt.Errorf("search exceeded step limit=%d mate=%v mateEdge=%v label=%v parent=%v root=%v dual=%v base=%v: %w",
+	return false, fmt.Errorf("search exceeded step limit=%d mate=%v mateEdge=%v label=%v parent=%v root=%v dual=%v base=%v: %w",
 		maxSteps, e.mate, e.mateEdge, e.label, e.parent, e.treeRoot, e.dual, e.base, ErrInvalidMatching)
 }
 
@@ -594,7 +613,7 @@ func (e *blossomEngine) nodeContainsVertex(node int, vertex int) bool {
 //
 // AI-Hints:
 //   - Do not guess orientation from edge.u<edge.v.
-//   - Do not use this helper with original TSP matrix vertex IDs.
+//   - Do not use this helper with caller vertex IDs.
 func (e *blossomEngine) orientEdgeForNodes(edgeID int, a int, b int) (int, int, error) {
 	if edgeID < 0 || edgeID >= len(e.edges) {
 		return noVertex, noVertex, ErrInvalidMatching
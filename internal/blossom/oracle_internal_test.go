@@ -1,4 +1,4 @@
-package tsp
+package blossom
 
 import (
 	"errors"
@@ -202,9 +202,8 @@ func TestBlossomRejectsInvalidOptionsAndOddProblem(t *testing.T) {
 	}
 
 	oddProblem := matchingProblem{
-		odd: []int{0, 1, 2},
-		n:   3,
-		w:   make([]float64, 9),
+		n: 3,
+		w: make([]float64, 9),
 	}
 
 	if _, _, _, err := solveMinimumWeightPerfectMatching(oddProblem, blossomOptions{Eps: DefaultEps}); !errors.Is(err, ErrInvalidMatching) {
@@ -239,13 +238,8 @@ func TestBlossomMatchesOracleForSeededEvenK2To14(t *testing.T) {
 }
 
 func internalConstantMatchingProblem(k int, weight float64) matchingProblem {
-	odd := make([]int, k)
 	w := make([]float64, k*k)
 
-	for vertex := 0; vertex < k; vertex++ {
-		odd[vertex] = vertex
-	}
-
 	for row := 0; row < k; row++ {
 		for col := 0; col < k; col++ {
 			if row == col {
@@ -257,21 +251,15 @@ func internalConstantMatchingProblem(k int, weight float64) matchingProblem {
 	}
 
 	return matchingProblem{
-		odd: odd,
-		w:   w,
-		n:   k,
+		w: w,
+		n: k,
 	}
 }
 func internalWideRangeMatchingProblem(k int, seed int64) matchingProblem {
 	rng := rand.New(rand.NewSource(seed))
 
-	odd := make([]int, k)
 	w := make([]float64, k*k)
 
-	for vertex := 0; vertex < k; vertex++ {
-		odd[vertex] = vertex
-	}
-
 	for row := 0; row < k; row++ {
 		for col := row + 1; col < k; col++ {
 			exp := rng.Intn(6)
@@ -284,8 +272,7 @@ func internalWideRangeMatchingProblem(k int, seed int64) matchingProblem {
 	}
 
 	return matchingProblem{
-		odd: odd,
-		w:   w,
-		n:   k,
+		w: w,
+		n: k,
 	}
 }
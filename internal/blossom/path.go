@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: AGPL-3.0-only
 // Copyright (C) 2025-2026 katalvlaran
 
-// Package tsp realizes endpoint-aware augmenting paths for dense Blossom search.
+// Package blossom realizes endpoint-aware augmenting paths for dense Blossom search.
 // Search events identify top-level nodes, but matching mutation requires original
 // dense edge IDs. This file lifts contracted-blossom paths back into original edges.
 //
@@ -20,7 +20,7 @@
 //   - Do not reduce path steps to bare edge IDs before lifting.
 //   - Do not choose internal blossom direction by shortest path; parity decides validity.
 //   - Do not infer endpoint ownership from edge IDs alone.
-package tsp
+package blossom
 
 // blossomPathStep stores one oriented edge traversal between top-level nodes.
 // It is the unit consumed by augmenting-path lifting before mate[] is mutated.
@@ -486,13 +486,16 @@ func (e *blossomEngine) liftAugmentingPathChoices(steps []blossomPathStep) ([][]
 // Implementation:
 //   - Stage 1: Validate the contracted blossom and same-vertex neutral route.
 //   - Stage 2: Locate entry and exit child indices.
-//   - Stage 3: Build every forward-direction recursive lift candidate.
-//   - Stage 4: Build every backward-direction recursive lift candidate.
-//   - Stage 5: Keep only candidates that alternate locally.
+//   - Stage 3: When the route ends at the blossom base, try only the direction
+//     with an even number of cycle edges and return its alternating candidates.
+//   - Stage 4: Otherwise, or when that yields nothing, build every forward-direction
+//     recursive lift candidate.
+//   - Stage 5: Build every backward-direction recursive lift candidate.
+//   - Stage 6: Keep only candidates that alternate locally.
 //
 // Behavior highlights:
 //   - Does not mutate mate[], base[], labels, or duals.
-//   - Preserves all nested child blossom choices.
+//   - Preserves all nested child blossom choices outside the base-route shortcut.
 //   - Returns candidates in deterministic forward-then-backward order.
 //   - Does not decide full augmenting-path validity; liftAugmentingPath validates the whole path.
 //
@@ -514,16 +517,21 @@ func (e *blossomEngine) liftAugmentingPathChoices(steps []blossomPathStep) ([][]
 //   - Recursive child choices preserve their deterministic order.
 //
 // Complexity:
-//   - Worst-case O(2^b * L) for nested blossoms crossed by this local route.
-//   - Space O(2^b * L).
+//   - O(L) for base routes, whose nested lifts are base routes again.
+//   - Worst-case O(2^b * L) for other routes crossing b nested blossoms.
+//   - Space follows time.
 //
 // Notes:
-//   - This method deliberately returns all viable local choices.
+//   - A route from a vertex to the base must leave on the matched cycle edge and reach
+//     the base child on an unmatched one, which only the even direction does; the odd
+//     direction can never extend to a valid augmenting path. Enumerating both at every
+//     nesting level is exponential on deeply nested sparse instances.
+//   - Outside that case this method deliberately returns all viable local choices.
 //   - The caller filters them against surrounding external edges.
 //
 // AI-Hints:
 //   - Do not collapse recursive child choices to choices[0] here.
-//   - Do not choose by shortest path; alternation decides validity.
+//   - Do not choose by shortest path; alternation and cycle parity decide validity.
 func (e *blossomEngine) liftThroughBlossomChoices(
 	node int,
 	entryVertex int,
@@ -551,6 +559,23 @@ func (e *blossomEngine) liftThroughBlossomChoices(
 
 	choices := make([][]int, 0, 4)
 
+	if entryVertex == e.base[node] || exitVertex == e.base[node] {
+		cycleLength := len(e.cycles[node])
+		even := (toChild-fromChild+cycleLength)%cycleLength%2 == 0
+
+		route, routeErr := e.cyclePathBetweenDirection(node, fromChild, toChild, even, entryVertex, exitVertex)
+		if routeErr == nil {
+			for _, candidate := range route {
+				if e.edgeSequenceAlternates(candidate) {
+					choices = append(choices, candidate)
+				}
+			}
+		}
+		if len(choices) > 0 {
+			return choices, nil
+		}
+	}
+
 	forward, err := e.cyclePathBetweenDirection(node, fromChild, toChild, true, entryVertex, exitVertex)
 	if err == nil {
 		for _, candidate := range forward {
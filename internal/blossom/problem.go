@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package blossom defines the dense matching problem and its public entry.
+// The model holds a detached row-major cost buffer over local indices so the
+// engine never depends on caller matrix ownership or vertex IDs.
+package blossom
+
+import "math"
+
+// DefaultEps is the usual numeric tolerance for slack and equality checks.
+const DefaultEps = 1e-12
+
+// matchingProblem stores a dense complete matching instance.
+//
+// Behavior highlights:
+//   - Row-major local cost layout: w[i*n+j].
+//   - Diagonal values are ignored.
+//   - The sparse constructor sets only n; the cost buffer stays empty.
+//
+// AI-Hints:
+//   - Do not expose matchingProblem; MinWeightPerfect takes n and w directly.
+type matchingProblem struct {
+	// n is the number of local vertices.
+	n int
+
+	// w stores local edge costs in row-major n*n layout.
+	w []float64
+}
+
+// at returns the local cost between vertices i and j.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (p matchingProblem) at(i int, j int) float64 {
+	return p.w[i*p.n+j]
+}
+
+// MinWeightPerfect computes an exact minimum-weight perfect matching of the
+// complete graph on n vertices with costs w[i*n+j].
+//
+// Implementation:
+//   - Stage 1: Validate eps, n, and the cost buffer.
+//   - Stage 2: Run the weighted Blossom search under the perfect stopping rule.
+//   - Stage 3: Verify and return the symmetric mate array.
+//
+// Inputs:
+//   - n: even vertex count; zero returns an empty matching.
+//   - w: n*n finite non-negative symmetric costs; the diagonal is ignored.
+//   - eps: positive finite tolerance; DefaultEps is the usual choice.
+//
+// Returns:
+//   - []int: match[i] is the partner of i.
+//
+// Errors:
+//   - ErrInvalidOptions for invalid eps.
+//   - ErrInvalidMatching for odd n or a buffer of the wrong length.
+//   - ErrNaNInf, ErrNegativeWeight, ErrAsymmetry for bad costs.
+//   - ErrIncompleteGraph when no perfect matching exists.
+//
+// Determinism:
+//   - Fixed edge order and tie-breaks; equal inputs give equal matchings.
+//
+// Complexity:
+//   - Polynomial in n with O(n^2) edge storage.
+//
+// AI-Hints:
+//   - The caller keeps w; the engine reads it only during construction.
+func MinWeightPerfect(n int, w []float64, eps float64) ([]int, error) {
+	match, _, _, err := solveMinimumWeightPerfectMatching(matchingProblem{n: n, w: w}, blossomOptions{Eps: eps})
+
+	return match, err
+}
+
+// matchingCost computes the exact cost of a verified local perfect matching.
+// It sums every pair once, using i<match[i] to avoid double-counting.
+//
+// Errors:
+//   - ErrInvalidMatching from verifyPerfectMatching or a size mismatch.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+//
+// AI-Hints:
+//   - Do not compute the cost from transformed profits.
+func matchingCost(problem matchingProblem, match []int) (float64, error) {
+	if err := verifyPerfectMatching(match); err != nil {
+		return 0, err
+	}
+	if problem.n != len(match) {
+		return 0, ErrInvalidMatching
+	}
+
+	total := 0.0
+	for localVertex, partner := range match {
+		if localVertex < partner {
+			total += problem.at(localVertex, partner)
+		}
+	}
+
+	// Round to 1e-9 absolute precision so costs compare stably across platforms.
+	return math.Round(total*1e9) / 1e9, nil
+}
+
+// verifyPerfectMatching validates a local symmetric match array.
+//
+// Errors:
+//   - ErrInvalidMatching for odd length, out-of-range or self partners, or
+//     one-sided pairs.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func verifyPerfectMatching(match []int) error {
+	if (len(match) & 1) == 1 {
+		return ErrInvalidMatching
+	}
+
+	for index, partner := range match {
+		if partner < 0 || partner >= len(match) || partner == index || match[partner] != index {
+			return ErrInvalidMatching
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package blossom
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func internalSeededMatchingProblem(k int, seed int64) matchingProblem {
+	rng := rand.New(rand.NewSource(seed))
+
+	problem := matchingProblem{
+		n: k,
+		w: make([]float64, k*k),
+	}
+
+	for row := 0; row < k; row++ {
+		for col := row + 1; col < k; col++ {
+			weight := 1 + float64(rng.Intn(10_000))/100
+			problem.w[row*k+col] = weight
+			problem.w[col*k+row] = weight
+		}
+	}
+
+	return problem
+}
+
+func internalEdgeID(t *testing.T, engine *blossomEngine, u int, v int) int {
+	t.Helper()
+
+	for edgeID, edge := range engine.edges {
+		if (edge.u == u && edge.v == v) || (edge.u == v && edge.v == u) {
+			return edgeID
+		}
+	}
+
+	t.Fatalf("missing edge %d-%d", u, v)
+	return noEdge
+}
+
+func exactMatchingOracleForTest(problem matchingProblem) ([]int, float64, error) {
+	if problem.n == 0 {
+		return []int{}, 0, nil
+	}
+	if (problem.n&1) == 1 || len(problem.w) != problem.n*problem.n {
+		return nil, 0, ErrInvalidMatching
+	}
+
+	used := make([]bool, problem.n)
+	current := make([]int, problem.n)
+	best := make([]int, problem.n)
+
+	for vertex := range current {
+		current[vertex] = noVertex
+		best[vertex] = noVertex
+	}
+
+	bestCost := math.Inf(1)
+
+	var search func(float64)
+	search = func(cost float64) {
+		first := noVertex
+		for vertex := 0; vertex < problem.n; vertex++ {
+			if !used[vertex] {
+				first = vertex
+				break
+			}
+		}
+
+		if first == noVertex {
+			if cost < bestCost {
+				bestCost = cost
+				copy(best, current)
+			}
+			return
+		}
+
+		used[first] = true
+		for partner := first + 1; partner < problem.n; partner++ {
+			if used[partner] {
+				continue
+			}
+
+			used[partner] = true
+			current[first] = partner
+			current[partner] = first
+
+			search(cost + problem.at(first, partner))
+
+			current[first] = noVertex
+			current[partner] = noVertex
+			used[partner] = false
+		}
+		used[first] = false
+	}
+
+	search(0)
+
+	if math.IsInf(bestCost, 1) {
+		return nil, 0, ErrIncompleteGraph
+	}
+	if err := verifyPerfectMatching(best); err != nil {
+		return nil, 0, err
+	}
+
+	return best, math.Round(bestCost*1e9) / 1e9, nil
+}
+
+func TestVerifyPerfectMatchingAndMatchingCost(t *testing.T) {
+	problem := matchingProblem{
+		n: 4,
+		w: []float64{
+			0, 2, 5, 6,
+			2, 0, 7, 8,
+			5, 7, 0, 3,
+			6, 8, 3, 0,
+		},
+	}
+
+	match := []int{1, 0, 3, 2}
+
+	if err := verifyPerfectMatching(match); err != nil {
+		t.Fatalf("verifyPerfectMatching: %v", err)
+	}
+
+	cost, err := matchingCost(problem, match)
+	if err != nil {
+		t.Fatalf("matchingCost: %v", err)
+	}
+	if cost != 5 {
+		t.Fatalf("matching cost got %.3f want 5", cost)
+	}
+}
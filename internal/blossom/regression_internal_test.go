@@ -1,4 +1,4 @@
-package tsp
+package blossom
 
 import (
 	"fmt"
@@ -8,8 +8,7 @@ import (
 
 func TestBlossomSolvesGreedyTrapK6(t *testing.T) {
 	problem := matchingProblem{
-		odd: []int{0, 1, 2, 3, 4, 5},
-		n:   6,
+		n: 6,
 		w: []float64{
 			0, 1, 2, 2, 9, 9,
 			1, 0, 2, 2, 9, 9,
@@ -1,4 +1,4 @@
-package tsp
+package blossom
 
 import "testing"
 
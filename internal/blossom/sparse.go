@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package blossom exposes the weighted engine for general-graph matching.
+// The facade runs the same engine as MinWeightPerfect over a caller-supplied
+// sparse edge list and publishes the matching together with its laminar dual
+// certificate.
+//
+// Responsibility:
+//   - Validate index-based edge lists before engine allocation.
+//   - Select the perfect, maximum-weight, or maximum-cardinality stopping rule.
+//   - Convert doubled engine duals into the published LP scale.
+//
+// Boundaries:
+//   - Vertex IDs, graphs, and matrices belong to package matching.
+//   - The search itself lives in engine.go, forest.go, dual.go, contract.go,
+//     path.go, and augment.go.
+//
+// AI-Hints:
+//   - Prefer matching.MaxWeight and matching.MinWeightPerfect in application code.
+//   - Do not return engine storage; every published slice is detached.
+package blossom
+
+import (
+	"math"
+	"sort"
+)
+
+// Mode selects the objective of Solve.
+type Mode int
+
+const (
+	// ModeMaxWeight maximizes total weight over all matchings; vertices may
+	// stay unmatched and edges of weight <= 0 are never needed.
+	ModeMaxWeight Mode = iota
+
+	// ModeMaxCardinality maximizes total weight among the matchings of
+	// maximum cardinality.
+	ModeMaxCardinality
+
+	// ModePerfect maximizes total weight among perfect matchings and fails
+	// with ErrIncompleteGraph when none exists.
+	ModePerfect
+)
+
+// Edge is one undirected weighted edge of a general matching instance.
+type Edge struct {
+	// U and V are distinct vertex indices in [0, n).
+	U, V int
+
+	// Weight is the finite edge profit; negative values are allowed.
+	Weight float64
+}
+
+// Matching is an optimal matching with its dual certificate.
+//
+// Behavior highlights:
+//   - Mate[v] is the partner of v or -1; MateEdge[v] is the index of the
+//     matching edge in the input slice or -1.
+//   - Weight is the sum of the input weights of matched edges.
+//   - Duals follow the LP with edge rows VertexDuals[u] + VertexDuals[v] +
+//     sum(BlossomDuals[i] over Blossoms[i] containing u and v) >= Weight(uv),
+//     with equality on matched edges and BlossomDuals >= 0. Every listed
+//     blossom is an odd vertex set with (len-1)/2 matched edges inside, so
+//     sum(VertexDuals over matched vertices) + sum(BlossomDuals[i] *
+//     (len(Blossoms[i])-1)/2) == Weight.
+//   - ModeMaxWeight: VertexDuals >= 0 and zero on unmatched vertices.
+//   - ModeMaxCardinality: every unmatched vertex carries the smallest
+//     vertex dual.
+//
+// Determinism:
+//   - Blossoms are listed in creation order, each with ascending vertices.
+//
+// AI-Hints:
+//   - The certificate lets callers verify optimality without trusting the engine.
+type Matching struct {
+	Mate     []int
+	MateEdge []int
+	Weight   float64
+
+	VertexDuals  []float64
+	Blossoms     [][]int
+	BlossomDuals []float64
+}
+
+// Solve computes an optimal weighted matching of a general graph
+// with Edmonds' weighted Blossom algorithm.
+//
+// Implementation:
+//   - Stage 1: Validate mode, tolerance, vertex count, and edges.
+//   - Stage 2: Build sparse engine edges with profit equal to weight.
+//   - Stage 3: Search until the mode's stopping rule proves optimality.
+//   - Stage 4: Verify the final dual certificate and publish it.
+//
+// Behavior highlights:
+//   - Same engine, tolerances, and tie-breaking as MinWeightPerfect.
+//   - For a minimum-weight perfect matching pass negated weights with
+//     ModePerfect.
+//
+// Inputs:
+//   - n: number of vertices.
+//   - edges: simple undirected edge list; no loops or repeated pairs.
+//   - mode: ModeMaxWeight, ModeMaxCardinality, or ModePerfect.
+//   - eps: positive finite tolerance; DefaultEps is the usual choice.
+//
+// Returns:
+//   - *Matching: matching, weight, and dual certificate.
+//
+// Errors:
+//   - ErrInvalidOptions for an unknown mode or invalid eps.
+//   - ErrInvalidMatching for negative n, out-of-range endpoints, loops,
+//     repeated pairs, or an internal certificate failure.
+//   - ErrNaNInf for NaN or ±Inf weights.
+//   - ErrIncompleteGraph when ModePerfect finds no perfect matching.
+//
+// Determinism:
+//   - Edge order, root order, and delta tie-breaks are fixed, so equal inputs
+//     give equal matchings and duals.
+//
+// Complexity:
+//   - Correctness-first: polynomial, O(n^2) dual phases over O(m) edge scans
+//     with laminar slack sums. Space O(n + m).
+//
+// AI-Hints:
+//   - Keep edge order stable between runs when reproducible tie-breaking matters.
+func Solve(n int, edges []Edge, mode Mode, eps float64) (*Matching, error) {
+	if mode < ModeMaxWeight || mode > ModePerfect {
+		return nil, ErrInvalidOptions
+	}
+	if eps <= 0 || math.IsNaN(eps) || math.IsInf(eps, 0) {
+		return nil, ErrInvalidOptions
+	}
+	if n < 0 {
+		return nil, ErrInvalidMatching
+	}
+	if mode == ModePerfect && (n&1) == 1 {
+		return nil, ErrIncompleteGraph
+	}
+
+	engine, err := newSparseBlossomEngine(n, edges, blossomOptions{Eps: eps, Mode: blossomModeOf(mode)})
+	if err != nil {
+		return nil, err
+	}
+	if err = engine.solve(); err != nil {
+		return nil, err
+	}
+
+	return engine.exportGeneralMatching(edges), nil
+}
+
+// blossomModeOf maps the public mode onto the engine stopping rule.
+func blossomModeOf(mode Mode) blossomMode {
+	switch mode {
+	case ModeMaxCardinality:
+		return blossomModeMaxCardinality
+	case ModePerfect:
+		return blossomModePerfect
+	default:
+		return blossomModeMaxWeight
+	}
+}
+
+// newSparseBlossomEngine validates an index-based edge list and allocates an
+// engine over it.
+//
+// Implementation:
+//   - Stage 1: Reject bad endpoints, loops, repeated pairs, and non-finite weights.
+//   - Stage 2: Store edges in input order with profit equal to weight.
+//   - Stage 3: Start every vertex dual at max(0, max weight).
+//
+// Behavior highlights:
+//   - Edge IDs equal input indices, so MateEdge maps straight back.
+//   - Only problem.n is set; the dense cost fields stay empty.
+//
+// Inputs:
+//   - n, edges: validated by this function.
+//   - opts: Blossom policy with a checked Eps and Mode.
+//
+// Returns:
+//   - *blossomEngine: engine ready for solve.
+//
+// Errors:
+//   - ErrInvalidMatching, ErrNaNInf.
+//
+// Determinism:
+//   - Input order is preserved.
+//
+// Complexity:
+//   - Time O(n + m), Space O(n + m).
+//
+// AI-Hints:
+//   - The starting dual must dominate every profit; lowering it breaks initial feasibility.
+func newSparseBlossomEngine(n int, edges []Edge, opts blossomOptions) (*blossomEngine, error) {
+	engineEdges := make([]blossomEdge, 0, len(edges))
+	incident := make([][]int, n)
+	seen := make(map[[2]int]bool, len(edges))
+	maxWeight, maxAbs := 0.0, 0.0
+
+	for id, edge := range edges {
+		u, v := edge.U, edge.V
+		if u < 0 || v < 0 || u >= n || v >= n || u == v {
+			return nil, ErrInvalidMatching
+		}
+		if u > v {
+			u, v = v, u
+		}
+		if seen[[2]int{u, v}] {
+			return nil, ErrInvalidMatching
+		}
+		seen[[2]int{u, v}] = true
+
+		if math.IsNaN(edge.Weight) || math.IsInf(edge.Weight, 0) {
+			return nil, ErrNaNInf
+		}
+		maxWeight = math.Max(maxWeight, edge.Weight)
+		maxAbs = math.Max(maxAbs, math.Abs(edge.Weight))
+
+		engineEdges = append(engineEdges, blossomEdge{id: id, u: u, v: v, cost: -edge.Weight, profit: edge.Weight})
+		incident[u] = append(incident[u], id)
+		incident[v] = append(incident[v], id)
+	}
+
+	problem := matchingProblem{n: n}
+
+	return allocateBlossomEngine(problem, engineEdges, incident, maxWeight, blossomNumericScale(maxAbs), opts), nil
+}
+
+// exportGeneralMatching publishes the committed matching and halves the
+// doubled engine duals into LP scale.
+//
+// Implementation:
+//   - Stage 1: Copy mate[] and mateEdge[] and sum matched input weights.
+//   - Stage 2: Publish vertex duals as dual/2.
+//   - Stage 3: Publish allocated blossoms with a positive dual, members sorted.
+//
+// Behavior highlights:
+//   - Expanded blossoms keep a zero dual and are omitted.
+//
+// Inputs:
+//   - edges: the input edge list, for weights.
+//
+// Returns:
+//   - *Matching: detached result.
+//
+// Errors:
+//   - None; solve has already verified the state.
+//
+// Determinism:
+//   - Fixed vertex and node scans.
+//
+// Complexity:
+//   - Time O(n + total blossom members log), Space O(n + total blossom members).
+//
+// AI-Hints:
+//   - Keep the halving in one place; engine slack uses 2*profit.
+func (e *blossomEngine) exportGeneralMatching(edges []Edge) *Matching {
+	result := &Matching{
+		Mate:         make([]int, e.problem.n),
+		MateEdge:     make([]int, e.problem.n),
+		VertexDuals:  make([]float64, e.problem.n),
+		Blossoms:     [][]int{},
+		BlossomDuals: []float64{},
+	}
+
+	for vertex := 0; vertex < e.problem.n; vertex++ {
+		result.Mate[vertex] = e.mate[vertex]
+		result.MateEdge[vertex] = e.mateEdge[vertex]
+		result.VertexDuals[vertex] = e.dual[vertex] / blossomDualDeltaScale
+		if mate := e.mate[vertex]; mate != noVertex && vertex < mate {
+			result.Weight += edges[e.mateEdge[vertex]].Weight
+		}
+	}
+
+	for node := e.problem.n; node < e.nextNode; node++ {
+		if !e.isAllocatedBlossom(node) || e.dual[node] <= 0 {
+			continue
+		}
+
+		members := append([]int(nil), e.members[node]...)
+		sort.Ints(members)
+		result.Blossoms = append(result.Blossoms, members)
+		result.BlossomDuals = append(result.BlossomDuals, e.dual[node]/blossomDualDeltaScale)
+	}
+
+	return result
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package blossom_test verifies the public general-graph entry to the Blossom engine.
+// Brute-force comparisons over core.Graph and matrix.Matrix live in package matching;
+// this file pins the index-level contract, the dual certificate, and sparse nesting.
+package blossom_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/internal/blossom"
+)
+
+// mustNoError fails the test on any error.
+func mustNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// mustGeneralCertificate checks that result is a matching of edges whose duals
+// satisfy the Matching certificate for mode.
+func mustGeneralCertificate(t *testing.T, n int, edges []blossom.Edge, mode blossom.Mode, result *blossom.Matching) {
+	t.Helper()
+
+	tol := 1e-6 * (1 + math.Abs(result.Weight))
+	covered := func(b, u, v int) bool {
+		in := 0
+		for _, member := range result.Blossoms[b] {
+			if member == u || member == v {
+				in++
+			}
+		}
+		return in == 2
+	}
+
+	weight, objective := 0.0, 0.0
+	for id, edge := range edges {
+		slack := result.VertexDuals[edge.U] + result.VertexDuals[edge.V] - edge.Weight
+		for b := range result.Blossoms {
+			if covered(b, edge.U, edge.V) {
+				slack += result.BlossomDuals[b]
+			}
+		}
+		matched := result.MateEdge[edge.U] == id
+		if slack < -tol || matched && math.Abs(slack) > tol {
+			t.Fatalf("edge %d %+v: slack %g, matched %v", id, edge, slack, matched)
+		}
+		if matched {
+			weight += edge.Weight
+		}
+	}
+	for v := 0; v < n; v++ {
+		if result.Mate[v] < 0 {
+			if mode == blossom.ModePerfect || mode == blossom.ModeMaxWeight && math.Abs(result.VertexDuals[v]) > tol {
+				t.Fatalf("free vertex %d with dual %g in mode %d", v, result.VertexDuals[v], mode)
+			}
+			continue
+		}
+		objective += result.VertexDuals[v]
+	}
+	for b, members := range result.Blossoms {
+		objective += result.BlossomDuals[b] * float64((len(members)-1)/2)
+	}
+	if math.Abs(weight-result.Weight) > tol || math.Abs(objective-result.Weight) > tol {
+		t.Fatalf("weight %g, replayed %g, dual objective %g", result.Weight, weight, objective)
+	}
+}
+
+func TestSolve_OddCycleNeedsBlossom(t *testing.T) {
+	t.Parallel()
+
+	// A pentagon 0..4 with a pendant 5 on vertex 0: the optimum takes the
+	// heavy pendant edge and pairs the remaining path 1-2, 3-4.
+	edges := []blossom.Edge{
+		{U: 0, V: 1, Weight: 6}, {U: 1, V: 2, Weight: 6}, {U: 2, V: 3, Weight: 6},
+		{U: 3, V: 4, Weight: 6}, {U: 4, V: 0, Weight: 6}, {U: 0, V: 5, Weight: 7},
+	}
+
+	for _, mode := range []blossom.Mode{blossom.ModeMaxWeight, blossom.ModeMaxCardinality, blossom.ModePerfect} {
+		result, err := blossom.Solve(6, edges, mode, blossom.DefaultEps)
+		mustNoError(t, err)
+		if result.Weight != 19 || result.Mate[0] != 5 {
+			t.Fatalf("mode %d: weight %g, mate %v", mode, result.Weight, result.Mate)
+		}
+		mustGeneralCertificate(t, 6, edges, mode, result)
+	}
+}
+
+func TestSolve_ModesDifferOnNegativeAndPendingEdges(t *testing.T) {
+	t.Parallel()
+
+	// Path 0-1-2-3 with a heavy middle: maximum weight takes only the middle,
+	// maximum cardinality trades it for both ends.
+	edges := []blossom.Edge{{U: 0, V: 1, Weight: 1}, {U: 1, V: 2, Weight: 5}, {U: 2, V: 3, Weight: -1}}
+
+	best, err := blossom.Solve(4, edges, blossom.ModeMaxWeight, blossom.DefaultEps)
+	mustNoError(t, err)
+	if best.Weight != 5 || best.Mate[0] != -1 || best.Mate[3] != -1 {
+		t.Fatalf("max weight: %+v", best)
+	}
+	mustGeneralCertificate(t, 4, edges, blossom.ModeMaxWeight, best)
+
+	full, err := blossom.Solve(4, edges, blossom.ModeMaxCardinality, blossom.DefaultEps)
+	mustNoError(t, err)
+	if full.Weight != 0 || full.Mate[0] != 1 || full.Mate[2] != 3 {
+		t.Fatalf("max cardinality: %+v", full)
+	}
+	mustGeneralCertificate(t, 4, edges, blossom.ModeMaxCardinality, full)
+}
+
+// TestSolve_DeepNestingStaysPolynomial replays sparse random
+// instances whose augmenting paths cross deeply nested blossoms; lifting
+// through both cycle directions at every level used to take minutes on the
+// second one.
+func TestSolve_DeepNestingStaysPolynomial(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	const n = 200
+	for round := 0; round < 2; round++ {
+		var edges []blossom.Edge
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if rng.Float64() < 0.3 {
+					edges = append(edges, blossom.Edge{U: u, V: v, Weight: float64(rng.Intn(1000))})
+				}
+			}
+		}
+		for _, mode := range []blossom.Mode{blossom.ModeMaxWeight, blossom.ModeMaxCardinality, blossom.ModePerfect} {
+			result, err := blossom.Solve(n, edges, mode, blossom.DefaultEps)
+			mustNoError(t, err)
+			mustGeneralCertificate(t, n, edges, mode, result)
+		}
+	}
+}
+
+func TestSolve_Validation(t *testing.T) {
+	t.Parallel()
+
+	edge := []blossom.Edge{{U: 0, V: 1, Weight: 1}}
+	tests := []struct {
+		name  string
+		err   error
+		n     int
+		edges []blossom.Edge
+		mode  blossom.Mode
+		eps   float64
+	}{
+		{name: "UnknownMode", err: blossom.ErrInvalidOptions, n: 2, edges: edge, mode: blossom.ModePerfect + 1, eps: blossom.DefaultEps},
+		{name: "ZeroEps", err: blossom.ErrInvalidOptions, n: 2, edges: edge, eps: 0},
+		{name: "NegativeN", err: blossom.ErrInvalidMatching, n: -1, eps: blossom.DefaultEps},
+		{name: "EndpointOutOfRange", err: blossom.ErrInvalidMatching, n: 1, edges: edge, eps: blossom.DefaultEps},
+		{name: "Loop", err: blossom.ErrInvalidMatching, n: 2, edges: []blossom.Edge{{U: 1, V: 1}}, eps: blossom.DefaultEps},
+		{name: "RepeatedPair", err: blossom.ErrInvalidMatching, n: 2, edges: []blossom.Edge{{U: 0, V: 1}, {U: 1, V: 0}}, eps: blossom.DefaultEps},
+		{name: "NaNWeight", err: blossom.ErrNaNInf, n: 2, edges: []blossom.Edge{{U: 0, V: 1, Weight: math.NaN()}}, eps: blossom.DefaultEps},
+		{name: "OddPerfect", err: blossom.ErrIncompleteGraph, n: 3, edges: edge, mode: blossom.ModePerfect, eps: blossom.DefaultEps},
+		{name: "NoPerfect", err: blossom.ErrIncompleteGraph, n: 4, edges: edge, mode: blossom.ModePerfect, eps: blossom.DefaultEps},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := blossom.Solve(tt.n, tt.edges, tt.mode, tt.eps); !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
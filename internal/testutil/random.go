@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package testutil holds fixtures shared by the test suites of lvlath packages.
+//
+// It is internal: nothing here is part of the public API, and only _test.go
+// files are expected to import it.
+//
+// AI-Hints:
+//   - Every generator takes the caller's *rand.Rand so failures reproduce from
+//     the seed printed by the test.
+//   - Package-specific extras (coordinates, resources, potentials) stay in the
+//     calling test and are drawn from the same rng around the shared generator.
+package testutil
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// Mode selects the edge directedness of a RandomGraph.
+type Mode string
+
+const (
+	// Directed builds a plain directed graph.
+	Directed Mode = "directed"
+	// Undirected builds a plain undirected graph.
+	Undirected Mode = "undirected"
+	// Mixed builds a mixed graph; each edge is directed with probability 1/2.
+	Mixed Mode = "mixed"
+)
+
+// WeightFunc draws the weight of one edge. directed reports the edge kind.
+type WeightFunc func(rng *rand.Rand, fromID, toID string, directed bool) float64
+
+// Spec describes a reproducible random weighted multigraph.
+type Spec struct {
+	Vertices int        // vertex count; IDs are VertexID(0..Vertices-1)
+	Edges    int        // edge draws; loop draws are skipped unless Loops is set
+	Mode     Mode       // edge directedness
+	Loops    bool       // allow self-loops
+	Mixable  bool       // enable per-edge direction overrides in every Mode
+	Weight   WeightFunc // nil draws integer weights in [0, 9]
+}
+
+// VertexID returns the zero-padded ID of the index-th generated vertex.
+func VertexID(index int) string {
+	return fmt.Sprintf("v%02d", index)
+}
+
+// RandomGraph builds a weighted multigraph from spec, drawing from rng.
+//
+// Implementation:
+//   - Stage 1: Create the graph with the flags implied by Mode, Loops, and Mixable.
+//   - Stage 2: Add vertices v00..vNN in index order.
+//   - Stage 3: Draw Edges endpoint pairs; skip loops unless allowed, draw the
+//     direction for Mixed, then the weight.
+//
+// Notes:
+//   - Integer default weights keep path sums exact, so oracles compare with ==.
+//   - Mixable only changes the graph flags, so callers may later add edges with
+//     their own direction; generated edges still follow Mode.
+//   - Any construction error fails tb immediately.
+func RandomGraph(tb testing.TB, rng *rand.Rand, spec Spec) *core.Graph {
+	tb.Helper()
+
+	graphOpts := []core.GraphOption{core.WithWeighted(), core.WithMultiEdges(), core.WithDirected(spec.Mode != Undirected)}
+	if spec.Mode == Mixed || spec.Mixable {
+		graphOpts = append(graphOpts, core.WithMixedEdges())
+	}
+	if spec.Loops {
+		graphOpts = append(graphOpts, core.WithLoops())
+	}
+	graph, err := core.NewGraph(graphOpts...)
+	if err != nil {
+		tb.Fatalf("NewGraph failed: %v", err)
+	}
+
+	for index := 0; index < spec.Vertices; index++ {
+		if err = graph.AddVertex(VertexID(index)); err != nil {
+			tb.Fatalf("AddVertex failed: %v", err)
+		}
+	}
+	for index := 0; index < spec.Edges; index++ {
+		fromID, toID := VertexID(rng.Intn(spec.Vertices)), VertexID(rng.Intn(spec.Vertices))
+		if fromID == toID && !spec.Loops {
+			continue
+		}
+
+		directed := spec.Mode == Directed
+		var edgeOpts []core.EdgeOption
+		if spec.Mode == Mixed {
+			directed = rng.Intn(2) == 0
+			edgeOpts = append(edgeOpts, core.WithEdgeDirected(directed))
+		}
+
+		weight := float64(rng.Intn(10))
+		if spec.Weight != nil {
+			weight = spec.Weight(rng, fromID, toID, directed)
+		}
+		if _, err = graph.AddEdge(fromID, toID, weight, edgeOpts...); err != nil {
+			tb.Fatalf("AddEdge failed: %v", err)
+		}
+	}
+
+	return graph
+}
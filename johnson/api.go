@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package johnson
+
+import "github.com/katalvlaran/lvlath/core"
+
+// Johnson computes all-pairs shortest paths on a sparse weighted graph whose
+// edges may carry negative weights.
+//
+// Implementation:
+//   - Stage 1: Validate the graph and assemble options.
+//   - Stage 2: Compute potentials h with bellmanford.Potentials.
+//   - Stage 3: Build a directed reweighted view w'(u,v) = w + h(u) - h(v) >= 0.
+//   - Stage 4: Run dijkstra from every source on Workers goroutines.
+//   - Stage 5: Undo the reweighting: d(u,v) = d'(u,v) - h(u) + h(v).
+//
+// Behavior highlights:
+//   - Rows and columns follow g.Vertices(), the order of matrix.NewAdjacencyMatrix.
+//   - Undirected edges are traversed both ways, exactly as in bellmanford and dijkstra.
+//   - The result does not depend on the worker count.
+//
+// Inputs:
+//   - g: a weighted graph.
+//   - opts: WithWorkers, WithContext.
+//
+// Returns:
+//   - *Result: the distance table, vertex order, and potentials.
+//   - On bellmanford.ErrNegativeCycle: a Result holding only NegativeCycle.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrNilOption, ErrBadWorkers, ErrNilContext.
+//   - bellmanford.ErrInvalidWeight, bellmanford.ErrDistanceOverflow,
+//     bellmanford.ErrNegativeCycle from the reweighting phase.
+//   - dijkstra errors from the per-source phase (first failing source in vertex order).
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Deterministic for the same graph; per-source runs write disjoint rows.
+//
+// Complexity:
+//   - Time O(VE + V (V + E) log V), Space O(V^2 + E).
+//
+// AI-Hints:
+//   - Prefer matrix.FloydWarshall for small dense graphs; Johnson wins when E << V^2.
+func Johnson(g *core.Graph, opts ...Option) (*Result, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if !g.Weighted() {
+		return nil, ErrUnweightedGraph
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runJohnson(g, config)
+}
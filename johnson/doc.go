@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package johnson implements Johnson's all-pairs shortest paths for sparse
+// weighted core.Graph instances whose edge weights may be negative.
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - Johnson(g, opts...)
+//     Returns a Result holding a |V| x |V| matrix.Dense distance table whose rows
+//     and columns follow g.Vertices(), the vertex order of
+//     matrix.NewAdjacencyMatrix.
+//
+// -----------------------------------------------------------------------------
+// -- WHY ----------------------------------------------------------------------
+//
+// matrix.FloydWarshall costs O(V^3) time and needs the dense input table up
+// front. On sparse graphs (E << V^2) Johnson's O(VE + V (V+E) log V) is far
+// cheaper, and per-source searches parallelize without coordination.
+//
+// -----------------------------------------------------------------------------
+// -- HOW ----------------------------------------------------------------------
+//
+//  1. bellmanford.Potentials computes h(v) from a virtual zero-weight source.
+//  2. Every traversal direction of every edge is reweighted to
+//     w'(u,v) = w + h(u) - h(v) >= 0 in a detached directed view.
+//  3. dijkstra.Distances runs from every source on Workers goroutines.
+//  4. Distances are restored: d(u,v) = d'(u,v) - h(u) + h(v).
+//
+// Options:
+//
+//   - WithWorkers(n) - concurrent per-source searches (default GOMAXPROCS).
+//   - WithContext(ctx) - cancellation for both phases.
+//
+// Errors:
+//
+//   - ErrNilGraph, ErrUnweightedGraph, ErrNilOption, ErrBadWorkers, ErrNilContext.
+//   - bellmanford.ErrNegativeCycle with the witness in Result.NegativeCycle.
+//   - bellmanford.ErrInvalidWeight, bellmanford.ErrDistanceOverflow.
+//   - ErrNilResult, ErrVertexNotFound from Result.DistanceTo.
+//
+// Complexity:
+//
+//   - Time O(VE + V (V+E) log V), Space O(V^2 + E); the table dominates memory.
+//
+// AI-Hints:
+//   - The result never depends on the worker count.
+//   - With non-negative weights the potentials are all zero and the reweighting
+//     phase is O(V + E).
+package johnson
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package johnson
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	//
+	// AI-Hints:
+	//   - This is an input-contract failure detected before any allocation.
+	ErrNilGraph = errors.New("johnson: graph is nil")
+
+	// ErrUnweightedGraph reports that the graph does not expose weighted edges.
+	//
+	// AI-Hints:
+	//   - Use bfs for hop distances on unweighted graphs.
+	ErrUnweightedGraph = errors.New("johnson: graph must be weighted")
+
+	// ErrNilOption reports that a nil functional option was supplied.
+	//
+	// AI-Hints:
+	//   - Never replace this with panic-based configuration handling.
+	ErrNilOption = errors.New("johnson: nil option")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	//
+	// AI-Hints:
+	//   - Use context.Background() instead of nil.
+	ErrNilContext = errors.New("johnson: context is nil")
+
+	// ErrBadWorkers reports a non-positive worker count.
+	//
+	// AI-Hints:
+	//   - Omit WithWorkers to use runtime.GOMAXPROCS(0).
+	ErrBadWorkers = errors.New("johnson: workers must be > 0")
+
+	// ErrNilResult reports that a Result method was called on a nil receiver.
+	//
+	// AI-Hints:
+	//   - Result helpers return this sentinel instead of panicking.
+	ErrNilResult = errors.New("johnson: nil result")
+
+	// ErrVertexNotFound reports that a result query referenced an unknown vertex.
+	//
+	// AI-Hints:
+	//   - +Inf is the unreachable value; unknown vertices are errors.
+	ErrVertexNotFound = errors.New("johnson: vertex not found")
+)
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package johnson_test
+
+import (
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/johnson"
+)
+
+// ExampleJohnson computes an all-pairs table with one negative edge.
+func ExampleJohnson() {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	_, _ = graph.AddEdge("A", "B", 4)
+	_, _ = graph.AddEdge("A", "C", 1)
+	_, _ = graph.AddEdge("C", "B", -2)
+
+	result, _ := johnson.Johnson(graph, johnson.WithWorkers(2))
+	for row, fromID := range result.VertexIDs {
+		distances := make([]float64, len(result.VertexIDs))
+		for col := range result.VertexIDs {
+			distances[col], _ = result.Distances.At(row, col)
+		}
+		fmt.Println(fromID, distances)
+	}
+
+	// Output:
+	// A [0 -1 1]
+	// B [+Inf 0 +Inf]
+	// C [+Inf -2 0]
+}
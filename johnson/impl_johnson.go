@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package johnson
+
+import (
+	"math"
+	"sync"
+
+	"github.com/katalvlaran/lvlath/bellmanford"
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/dijkstra"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// runJohnson executes the reweighting and per-source phases for a validated input.
+//
+// Implementation:
+//   - Stage 1: Compute potentials; surface a negative cycle witness.
+//   - Stage 2: Build the reweighted directed view and the output table.
+//   - Stage 3: Fan sources out to workers and collect per-source errors.
+//   - Stage 4: Return the error of the first failing source in vertex order.
+//
+// Errors:
+//   - See Johnson.
+//
+// Complexity:
+//   - See Johnson.
+func runJohnson(g *core.Graph, config Options) (*Result, error) {
+	potentials, err := bellmanford.Potentials(g, bellmanford.WithContext(config.ctx))
+	if err != nil {
+		if potentials != nil && potentials.NegativeCycle != nil {
+			return &Result{NegativeCycle: potentials.NegativeCycle}, err
+		}
+		return nil, err
+	}
+
+	h := potentials.Distances
+	reweighted, err := buildReweighted(g, h)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := g.Vertices()
+	table, err := matrix.NewPreparedDense(len(ids), len(ids), matrix.WithAllowInfDistances())
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(ids))
+	for position, vertexID := range ids {
+		index[vertexID] = position
+	}
+
+	errs := make([]error, len(ids))
+	sources := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < config.Workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range sources {
+				errs[row] = fillRow(reweighted, table, ids, h, row)
+			}
+		}()
+	}
+
+	for row := range ids {
+		if err = config.ctx.Err(); err != nil {
+			break
+		}
+		sources <- row
+	}
+	close(sources)
+	wg.Wait()
+
+	if err != nil {
+		return nil, err
+	}
+	for _, rowErr := range errs {
+		if rowErr != nil {
+			return nil, rowErr
+		}
+	}
+
+	return &Result{
+		VertexIDs:  ids,
+		Distances:  table,
+		Potentials: h,
+		index:      index,
+	}, nil
+}
+
+// buildReweighted returns a directed multigraph with one arc per traversal
+// direction of every edge of g, weighted w + h(from) - h(to).
+//
+// Behavior highlights:
+//   - Tiny negative values caused by floating-point rounding are clamped to 0;
+//     the exact reweighted value is never negative.
+//
+// Errors:
+//   - core errors from graph construction (not expected for a valid g).
+//
+// Complexity:
+//   - Time O(V + E log E), Space O(V + E).
+func buildReweighted(g *core.Graph, h map[string]float64) (*core.Graph, error) {
+	reweighted, err := core.NewGraph(
+		core.WithWeighted(),
+		core.WithDirected(true),
+		core.WithMultiEdges(),
+		core.WithLoops(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vertexID := range g.Vertices() {
+		if err = reweighted.AddVertex(vertexID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, edge := range g.Edges() {
+		if _, err = reweighted.AddEdge(edge.From, edge.To, math.Max(0, edge.Weight+h[edge.From]-h[edge.To])); err != nil {
+			return nil, err
+		}
+		if edge.Directed || edge.From == edge.To {
+			continue
+		}
+		if _, err = reweighted.AddEdge(edge.To, edge.From, math.Max(0, edge.Weight+h[edge.To]-h[edge.From])); err != nil {
+			return nil, err
+		}
+	}
+
+	return reweighted, nil
+}
+
+// fillRow runs dijkstra from ids[row] on the reweighted view and writes the
+// restored distances into the table row.
+//
+// Errors:
+//   - dijkstra errors; matrix errors (not expected for restored values).
+//
+// Complexity:
+//   - Time O((V + E) log V), Space O(V).
+func fillRow(reweighted *core.Graph, table *matrix.Dense, ids []string, h map[string]float64, row int) error {
+	sourceID := ids[row]
+	distances, err := dijkstra.Distances(reweighted, sourceID)
+	if err != nil {
+		return err
+	}
+
+	for col, targetID := range ids {
+		distance := distances[targetID]
+		if !math.IsInf(distance, 1) {
+			distance = distance - h[sourceID] + h[targetID]
+		}
+		if err = table.Set(row, col, distance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
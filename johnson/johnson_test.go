@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package johnson_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/bellmanford"
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/internal/testutil"
+	"github.com/katalvlaran/lvlath/johnson"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// AI-HINTS (file):
+//   - Fixtures use integer weights so the reweight/restore round trip is exact.
+//   - matrix.FloydWarshall on a hand-built +Inf table is the dense oracle.
+//   - bellmanford per source is the sparse oracle for negative weights.
+
+// buildRandomGraph constructs a reproducible weighted mixed multigraph with
+// negative edges but no negative cycle.
+//
+// Implementation:
+//   - Stage 1: Draw an integer potential p(v) in [0, spread] for every vertex.
+//   - Stage 2: Directed edges get w = c + p(to) - p(from) with c in [0, 9], so the
+//     reduced cost c is non-negative and no cycle is negative.
+//   - Stage 3: Undirected edges get w = c + |p(u) - p(v)|, safe in both directions.
+//
+// AI-Hints:
+//   - Larger spread produces more negative edges.
+func buildRandomGraph(t *testing.T, seed int64, vertexCount, edgeCount, spread int) *core.Graph {
+	t.Helper()
+
+	rng := rand.New(rand.NewSource(seed))
+	potential := make(map[string]int, vertexCount)
+	for index := 0; index < vertexCount; index++ {
+		potential[testutil.VertexID(index)] = rng.Intn(spread + 1)
+	}
+
+	return testutil.RandomGraph(t, rng, testutil.Spec{
+		Vertices: vertexCount,
+		Edges:    edgeCount,
+		Mode:     testutil.Mixed,
+		Weight: func(rng *rand.Rand, fromID, toID string, directed bool) float64 {
+			shift := potential[toID] - potential[fromID]
+			if !directed {
+				shift = int(math.Abs(float64(shift)))
+			}
+
+			return float64(rng.Intn(10) + shift)
+		},
+	})
+}
+
+// TestJohnson_MatchesBellmanFord verifies every table entry against per-source
+// Bellman-Ford on graphs with negative edges, independently of the worker count.
+//
+// Implementation:
+//   - Stage 1: Build random graphs with negative edges and no negative cycle.
+//   - Stage 2: Run Johnson with 1 and 4 workers.
+//   - Stage 3: Compare every cell with bellmanford.BellmanFord.
+func TestJohnson_MatchesBellmanFord(t *testing.T) {
+	for seed := int64(1); seed <= 4; seed++ {
+		graph := buildRandomGraph(t, seed, 14, 40, 6)
+
+		for _, workers := range []int{1, 4} {
+			result, err := johnson.Johnson(graph, johnson.WithWorkers(workers))
+			if err != nil {
+				t.Fatalf("seed %d: Johnson failed: %v", seed, err)
+			}
+
+			for row, sourceID := range result.VertexIDs {
+				oracle, err := bellmanford.BellmanFord(graph, sourceID)
+				if err != nil {
+					t.Fatalf("seed %d: BellmanFord failed: %v", seed, err)
+				}
+				for col, targetID := range result.VertexIDs {
+					got, _ := result.Distances.At(row, col)
+					if got != oracle.Distances[targetID] {
+						t.Fatalf("seed %d workers %d %s->%s: got=%v want=%v",
+							seed, workers, sourceID, targetID, got, oracle.Distances[targetID])
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestJohnson_MatchesFloydWarshall verifies the table against matrix.FloydWarshall
+// and the vertex order against matrix.NewAdjacencyMatrix.
+//
+// Implementation:
+//   - Stage 1: Build a dense oracle table from minimum parallel edge weights.
+//   - Stage 2: Run FloydWarshall in place and compare cell by cell.
+//   - Stage 3: Compare VertexIDs with the adjacency matrix vertex order.
+func TestJohnson_MatchesFloydWarshall(t *testing.T) {
+	graph := buildRandomGraph(t, 7, 10, 30, 6)
+
+	result, err := johnson.Johnson(graph)
+	if err != nil {
+		t.Fatalf("Johnson failed: %v", err)
+	}
+
+	vertexCount := len(result.VertexIDs)
+	index := make(map[string]int, vertexCount)
+	for position, vertexID := range result.VertexIDs {
+		index[vertexID] = position
+	}
+
+	oracle, _ := matrix.NewPreparedDense(vertexCount, vertexCount, matrix.WithAllowInfDistances())
+	for row := 0; row < vertexCount; row++ {
+		for col := 0; col < vertexCount; col++ {
+			value := math.Inf(1)
+			if row == col {
+				value = 0
+			}
+			_ = oracle.Set(row, col, value)
+		}
+	}
+	relax := func(from, to string, weight float64) {
+		current, _ := oracle.At(index[from], index[to])
+		if weight < current {
+			_ = oracle.Set(index[from], index[to], weight)
+		}
+	}
+	for _, edge := range graph.Edges() {
+		relax(edge.From, edge.To, edge.Weight)
+		if !edge.Directed {
+			relax(edge.To, edge.From, edge.Weight)
+		}
+	}
+	if err = matrix.FloydWarshall(oracle); err != nil {
+		t.Fatalf("FloydWarshall failed: %v", err)
+	}
+
+	same, err := matrix.AllClose(result.Distances, oracle, 0, 0)
+	if err != nil || !same {
+		t.Fatalf("Johnson table differs from FloydWarshall (err=%v)", err)
+	}
+
+	options, _ := matrix.NewMatrixOptions(matrix.WithDirected(), matrix.WithWeighted())
+	adjacency, err := matrix.NewAdjacencyMatrix(graph, options)
+	if err != nil {
+		t.Fatalf("NewAdjacencyMatrix failed: %v", err)
+	}
+	adjacencyIDs, _ := adjacency.VertexIDs()
+	if fmt.Sprint(adjacencyIDs) != fmt.Sprint(result.VertexIDs) {
+		t.Fatalf("vertex order: got=%v want=%v", result.VertexIDs, adjacencyIDs)
+	}
+}
+
+// TestJohnson_NegativeCycleAndValidation verifies the negative-cycle witness,
+// result helpers, option sentinels, and cancellation.
+func TestJohnson_NegativeCycleAndValidation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	_, _ = graph.AddEdge("A", "B", 2)
+	_, _ = graph.AddEdge("B", "C", -1)
+	if err := graph.AddVertex("Z"); err != nil {
+		t.Fatalf("AddVertex failed: %v", err)
+	}
+
+	result, err := johnson.Johnson(graph)
+	if err != nil {
+		t.Fatalf("Johnson failed: %v", err)
+	}
+	if distance, _ := result.DistanceTo("A", "C"); distance != 1 {
+		t.Fatalf("DistanceTo(A, C): got=%v want=1", distance)
+	}
+	if distance, _ := result.DistanceTo("C", "A"); !math.IsInf(distance, 1) {
+		t.Fatalf("DistanceTo(C, A): got=%v want=+Inf", distance)
+	}
+	if _, err = result.DistanceTo("A", "Q"); !errors.Is(err, johnson.ErrVertexNotFound) {
+		t.Fatalf("DistanceTo(unknown): got err=%v", err)
+	}
+
+	_, _ = graph.AddEdge("C", "A", -2)
+	result, err = johnson.Johnson(graph)
+	if !errors.Is(err, bellmanford.ErrNegativeCycle) || result == nil || result.NegativeCycle == nil {
+		t.Fatalf("negative cycle: got err=%v result=%+v", err, result)
+	}
+	if fmt.Sprint(result.NegativeCycle.VertexIDs) != "[A B C]" {
+		t.Fatalf("witness: got=%v", result.NegativeCycle.VertexIDs)
+	}
+
+	if _, err = johnson.Johnson(nil); !errors.Is(err, johnson.ErrNilGraph) {
+		t.Fatalf("nil graph: got err=%v", err)
+	}
+	unweighted, _ := core.NewGraph()
+	if _, err = johnson.Johnson(unweighted); !errors.Is(err, johnson.ErrUnweightedGraph) {
+		t.Fatalf("unweighted: got err=%v", err)
+	}
+	if _, err = johnson.Johnson(graph, nil); !errors.Is(err, johnson.ErrNilOption) {
+		t.Fatalf("nil option: got err=%v", err)
+	}
+	if _, err = johnson.Johnson(graph, johnson.WithWorkers(0)); !errors.Is(err, johnson.ErrBadWorkers) {
+		t.Fatalf("workers: got err=%v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = johnson.Johnson(graph, johnson.WithContext(ctx)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled: got err=%v", err)
+	}
+
+	var nilResult *johnson.Result
+	if _, err = nilResult.DistanceTo("A", "B"); !errors.Is(err, johnson.ErrNilResult) {
+		t.Fatalf("nil result: got err=%v", err)
+	}
+}
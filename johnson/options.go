@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package johnson
+
+import (
+	"context"
+	"runtime"
+)
+
+// Options holds the effective policy of one Johnson run.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value is not a valid policy.
+type Options struct {
+	// Workers is the number of goroutines running per-source Dijkstra searches.
+	Workers int
+
+	// ctx allows cancellation between per-source searches.
+	ctx context.Context
+}
+
+// Option configures a Johnson run through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns Workers = runtime.GOMAXPROCS(0) and context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{
+		Workers: runtime.GOMAXPROCS(0),
+		ctx:     context.Background(),
+	}
+}
+
+// WithWorkers sets the number of concurrent per-source searches.
+//
+// Errors:
+//   - ErrBadWorkers if workers <= 0.
+//
+// AI-Hints:
+//   - Results do not depend on the worker count; only wall time does.
+func WithWorkers(workers int) Option {
+	return func(o *Options) error {
+		if workers <= 0 {
+			return ErrBadWorkers
+		}
+		o.Workers = workers
+		return nil
+	}
+}
+
+// WithContext sets the context used for cancellation; it is also forwarded to
+// the Bellman-Ford reweighting phase.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package johnson
+
+import (
+	"github.com/katalvlaran/lvlath/bellmanford"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// Result stores the all-pairs distance table of one Johnson run.
+//
+// Behavior highlights:
+//   - VertexIDs equals g.Vertices(), which is also the row/column order of
+//     matrix.NewAdjacencyMatrix for the same graph state.
+//   - Distances.At(i, j) is the shortest distance VertexIDs[i] -> VertexIDs[j];
+//     +Inf marks unreachable pairs and the diagonal is 0.
+//   - Potentials holds the Bellman-Ford reweighting potentials h.
+//   - On bellmanford.ErrNegativeCycle only NegativeCycle is set.
+//
+// AI-Hints:
+//   - Distances is built with matrix.WithAllowInfDistances and can be fed to
+//     matrix APIs that accept APSP-style +Inf tables.
+type Result struct {
+	// VertexIDs lists vertices in row/column order.
+	VertexIDs []string
+
+	// Distances is the |V| x |V| shortest-path table.
+	Distances *matrix.Dense
+
+	// Potentials maps every vertex to its reweighting potential h(v) <= 0.
+	Potentials map[string]float64
+
+	// NegativeCycle is the witness published together with bellmanford.ErrNegativeCycle.
+	NegativeCycle *bellmanford.Cycle
+
+	// index maps vertex IDs to row/column positions.
+	index map[string]int
+}
+
+// DistanceTo returns the shortest distance fromID -> toID; +Inf means unreachable.
+//
+// Errors:
+//   - ErrNilResult if the receiver or its table is nil.
+//   - ErrVertexNotFound if either vertex is unknown.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (r *Result) DistanceTo(fromID, toID string) (float64, error) {
+	if r == nil || r.Distances == nil {
+		return 0, ErrNilResult
+	}
+
+	row, ok := r.index[fromID]
+	if !ok {
+		return 0, ErrVertexNotFound
+	}
+	col, ok := r.index[toID]
+	if !ok {
+		return 0, ErrVertexNotFound
+	}
+
+	return r.Distances.At(row, col)
+}
@@ -0,0 +1,373 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+import (
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// HopcroftKarp computes a maximum-cardinality matching of a bipartite graph.
+//
+// Implementation:
+//   - Stage 1: Validate options and graph; resolve the bipartition from
+//     WithLeft or by two-colouring.
+//   - Stage 2: Run Hopcroft-Karp phases of shortest augmenting paths.
+//   - Stage 3: Publish pairs, sides, and a Konig vertex cover.
+//
+// Behavior highlights:
+//   - Weights and edge direction are ignored; parallel edges count once.
+//   - Cover has exactly Size vertices and touches every edge, which proves
+//     that no larger matching exists.
+//
+// Inputs:
+//   - g: any graph whose vertices split into two sides with no edge inside a
+//     side.
+//   - opts: WithLeft, WithContext.
+//
+// Returns:
+//   - *BipartiteResult: pairs, mates, sides, and the cover.
+//
+// Errors:
+//   - ErrNilGraph, ErrNilOption, ErrEmptyVertexID, ErrDuplicateVertex,
+//     ErrNilContext, ErrVertexNotFound.
+//   - ErrNotBipartite wrapped with the first offending edge in core.Edges() order.
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Equal graphs and options give equal results.
+//
+// Complexity:
+//   - Time O(E sqrt(V)), Space O(V + E).
+//
+// AI-Hints:
+//   - Replaces the hand-built source -> left -> right -> sink unit-capacity
+//     network for flow.MaxFlow; the cover is that network's min cut.
+//   - Pass WithLeft when the sides mean something: auto-detection picks the
+//     side of each component's first vertex.
+func HopcroftKarp(g *core.Graph, opts ...Option) (*BipartiteResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	b, err := newBipartiteGraph(g, config.Left)
+	if err != nil {
+		return nil, err
+	}
+	mate, err := runHopcroftKarp(config.ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.result(mate), nil
+}
+
+// Assignment computes a minimum-cost assignment of rows to columns of costs.
+//
+// Implementation:
+//   - Stage 1: Validate options and read the matrix, transposing it when it
+//     has more rows than columns.
+//   - Stage 2: Run the shortest augmenting path (Jonker-Volgenant) method.
+//   - Stage 3: Map back, sum the assigned costs, and publish potentials.
+//
+// Behavior highlights:
+//   - Square matrices get a perfect assignment; rectangular ones assign every
+//     entry of the smaller dimension.
+//   - +Inf marks a forbidden pair (store it with matrix.WithAllowInfDistances).
+//   - A zero-shape matrix gives an empty assignment of cost 0.
+//
+// Inputs:
+//   - costs: any matrix.Matrix; entries are finite or +Inf, negatives allowed.
+//   - opts: WithContext.
+//
+// Returns:
+//   - *AssignmentResult: the assignment, its cost, and dual potentials.
+//
+// Errors:
+//   - ErrNilOption, ErrNilContext, ErrNilMatrix, ErrInvalidCost, matrix errors
+//     from At.
+//   - ErrNoPerfectAssignment when forbidden pairs leave no full assignment.
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Ties go to the lowest column index; equal inputs give equal results.
+//
+// Complexity:
+//   - Time O(n^2 m) for n = min(R, C) and m = max(R, C), Space O(R*C).
+//
+// AI-Hints:
+//   - Job-to-worker scheduling: rows are jobs, columns are workers, entries are
+//     costs or -profits.
+//   - The potentials let a caller verify optimality without trusting the solver.
+func Assignment(costs matrix.Matrix, opts ...Option) (*AssignmentResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c, m, transposed, err := readCosts(costs)
+	if err != nil {
+		return nil, err
+	}
+	assigned, u, v, err := solveAssignment(config.ctx, c, m)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AssignmentResult{
+		RowToCol:      make([]int, costs.Rows()),
+		ColToRow:      make([]int, costs.Cols()),
+		RowPotentials: u,
+		ColPotentials: v,
+	}
+	if transposed {
+		result.RowPotentials, result.ColPotentials = v, u
+	}
+	for i := range result.RowToCol {
+		result.RowToCol[i] = -1
+	}
+	for j := range result.ColToRow {
+		result.ColToRow[j] = -1
+	}
+
+	for small, large := range assigned {
+		row, col := small, large
+		if transposed {
+			row, col = large, small
+		}
+		result.RowToCol[row], result.ColToRow[col] = col, row
+		result.Cost += c[small][large]
+	}
+
+	return result, nil
+}
+
+// MaxWeight computes a maximum-weight matching of a general graph.
+//
+// Implementation:
+//   - Stage 1: Validate options and graph; keep one edge per vertex pair.
+//   - Stage 2: Run Edmonds' weighted Blossom algorithm (the engine behind
+//     tsp.Christofides, shared through internal/blossom) until no augmenting path raises the weight, or, with
+//     WithMaxCardinality, until no augmenting path is left.
+//   - Stage 3: Publish pairs, weight, and the dual certificate.
+//
+// Behavior highlights:
+//   - Odd cycles are handled: the graph need not be bipartite.
+//   - Unweighted graphs weigh every edge 1, so the result is a
+//     maximum-cardinality matching.
+//   - Without WithMaxCardinality, edges of weight <= 0 are never taken.
+//   - Direction is ignored, loops are dropped, and the heaviest of parallel
+//     edges is used.
+//
+// Inputs:
+//   - g: any graph; weights may be negative.
+//   - opts: WithMaxCardinality, WithContext.
+//
+// Returns:
+//   - *WeightedResult: pairs, weight, and duals.
+//
+// Errors:
+//   - ErrNilGraph, ErrNilOption, ErrNilContext.
+//   - ErrInvalidWeight wrapped with the first NaN or infinite edge.
+//   - ctx.Err() when cancelled before the search; the search itself is not
+//     interruptible.
+//
+// Determinism:
+//   - Equal graphs and options give equal results and duals.
+//
+// Complexity:
+//   - Polynomial; the correctness-first engine performs O(V^2) dual phases
+//     over O(E) edge scans. Space O(V + E).
+//
+// AI-Hints:
+//   - Pairing tasks on general graphs (roommates, tandem shifts, pairing
+//     players of one pool); bipartite cardinality problems are faster with
+//     HopcroftKarp.
+//   - For a minimum-cost matching of any size, negate the weights.
+func MaxWeight(g *core.Graph, opts ...Option) (*WeightedResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	in, err := newGraphInstance(g, false)
+	if err != nil {
+		return nil, err
+	}
+	m, err := in.solve(false, config.MaxCardinality)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.graphResult(m), nil
+}
+
+// MinWeightPerfect computes a minimum-weight perfect matching of a
+// general graph.
+//
+// Implementation:
+//   - Stage 1: Validate options and graph; keep the cheapest edge per pair.
+//   - Stage 2: Run the weighted Blossom algorithm on negated weights until
+//     every vertex is matched.
+//   - Stage 3: Publish pairs, total cost, and cost-space duals.
+//
+// Behavior highlights:
+//   - Every vertex is matched or the call fails; negative costs are allowed.
+//   - Unweighted graphs cost every edge 1.
+//   - A graph without vertices has an empty matching of cost 0.
+//
+// Inputs:
+//   - g: any graph.
+//   - opts: WithContext; WithMaxCardinality is ignored.
+//
+// Returns:
+//   - *WeightedResult: pairs, cost in Weight, and duals (see WeightedResult).
+//
+// Errors:
+//   - ErrNilGraph, ErrNilOption, ErrNilContext.
+//   - ErrInvalidWeight wrapped with the first NaN or infinite edge.
+//   - ErrNoPerfectMatching when some vertex cannot be paired.
+//   - ctx.Err() when cancelled before the search.
+//
+// Determinism:
+//   - Equal graphs and options give equal results and duals.
+//
+// Complexity:
+//   - As MaxWeight.
+//
+// AI-Hints:
+//   - Christofides-style pairing of odd vertices on a sparse graph, or
+//     splitting a group into cheapest pairs.
+func MinWeightPerfect(g *core.Graph, opts ...Option) (*WeightedResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	in, err := newGraphInstance(g, true)
+	if err != nil {
+		return nil, err
+	}
+	m, err := in.solve(true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.graphResult(m), nil
+}
+
+// MaxWeightMatrix computes a maximum-weight matching over a symmetric weight
+// matrix whose entry (i, j) weighs the pair of rows i and j.
+//
+// Behavior highlights:
+//   - +Inf marks a missing pair (store it with matrix.WithAllowInfDistances);
+//     the diagonal is ignored.
+//   - Otherwise as MaxWeight, with row indices instead of vertex IDs.
+//
+// Inputs:
+//   - weights: square symmetric matrix.Matrix; entries finite or +Inf.
+//   - opts: WithMaxCardinality, WithContext.
+//
+// Returns:
+//   - *WeightedMatrixResult: mates by row, weight, and duals.
+//
+// Errors:
+//   - ErrNilOption, ErrNilContext, ErrNilMatrix, ErrNonSquareMatrix, matrix
+//     errors from At.
+//   - ErrInvalidCost and ErrAsymmetricMatrix wrapped with the position.
+//   - ctx.Err() when cancelled before the search.
+//
+// Determinism:
+//   - Equal matrices and options give equal results.
+//
+// Complexity:
+//   - As MaxWeight with E = n^2 / 2; reading the matrix is O(n^2).
+//
+// AI-Hints:
+//   - Compatibility scores between people or items make a natural weight matrix.
+func MaxWeightMatrix(weights matrix.Matrix, opts ...Option) (*WeightedMatrixResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	in, err := newMatrixInstance(weights)
+	if err != nil {
+		return nil, err
+	}
+	m, err := in.solve(false, config.MaxCardinality)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.matrixResult(m), nil
+}
+
+// MinWeightPerfectMatrix computes a minimum-cost perfect matching over a
+// symmetric cost matrix.
+//
+// Behavior highlights:
+//   - +Inf marks a forbidden pair; the diagonal is ignored.
+//   - Otherwise as MinWeightPerfect, with row indices.
+//
+// Inputs:
+//   - costs: square symmetric matrix.Matrix; entries finite or +Inf.
+//   - opts: WithContext.
+//
+// Returns:
+//   - *WeightedMatrixResult: mates by row, cost in Weight, and duals.
+//
+// Errors:
+//   - ErrNilOption, ErrNilContext, ErrNilMatrix, ErrNonSquareMatrix, matrix
+//     errors from At.
+//   - ErrInvalidCost and ErrAsymmetricMatrix wrapped with the position.
+//   - ErrNoPerfectMatching when forbidden pairs or an odd size leave a row
+//     unpaired.
+//   - ctx.Err() when cancelled before the search.
+//
+// Determinism:
+//   - Equal matrices and options give equal results.
+//
+// Complexity:
+//   - As MaxWeightMatrix.
+//
+// AI-Hints:
+//   - Unlike Assignment, rows are paired with each other, not with columns.
+func MinWeightPerfectMatrix(costs matrix.Matrix, opts ...Option) (*WeightedMatrixResult, error) {
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	in, err := newMatrixInstance(costs)
+	if err != nil {
+		return nil, err
+	}
+	m, err := in.solve(true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.matrixResult(m), nil
+}
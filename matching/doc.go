@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package matching pairs up vertices: maximum-cardinality bipartite matching
+// over core.Graph, minimum-cost assignment over a matrix.Matrix cost table, and
+// weighted matching of general (non-bipartite) graphs.
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - HopcroftKarp(g, opts...)
+//     A maximum set of vertex-disjoint edges of a bipartite graph, with the
+//     bipartition (explicit or two-coloured) and a Konig vertex cover that
+//     proves no larger matching exists.
+//
+//   - Assignment(costs, opts...)
+//     A minimum-cost assignment of rows to columns, with dual potentials that
+//     prove optimality; +Inf entries are forbidden pairs.
+//
+//   - MaxWeight(g, opts...), MaxWeightMatrix(weights, opts...)
+//     A maximum-weight matching of any undirected graph, odd cycles included,
+//     optionally restricted to maximum cardinality, with vertex and blossom
+//     duals that certify optimality.
+//
+//   - MinWeightPerfect(g, opts...), MinWeightPerfectMatrix(costs, opts...)
+//     A minimum-weight perfect matching of any undirected graph.
+//
+// -----------------------------------------------------------------------------
+// -- WHY ----------------------------------------------------------------------
+//
+// Job-to-worker assignment is often solved by hand-building a flow network
+// (source -> jobs -> workers -> sink) for flow.MaxFlow. The dedicated
+// algorithms are faster, need no auxiliary graph, and return the matching
+// directly together with an optimality certificate.
+//
+// -----------------------------------------------------------------------------
+// -- HOW ----------------------------------------------------------------------
+//
+//   - Hopcroft-Karp: each phase finds, by one BFS and one layered DFS, a
+//     maximal set of vertex-disjoint shortest augmenting paths. O(sqrt(V))
+//     phases suffice. Alternating reachability from free left vertices then
+//     yields the Konig cover.
+//   - Assignment: rows are added one at a time; a Dijkstra-like search over
+//     reduced costs finds the cheapest augmenting path, and the potentials are
+//     shifted so reduced costs stay non-negative (Jonker-Volgenant shortest
+//     augmenting paths, the Hungarian algorithm with potentials).
+//   - General graphs: Edmonds' weighted Blossom algorithm, shrinking odd
+//     alternating cycles into blossoms while adjusting laminar duals. The
+//     engine is the one tsp.Christofides uses for its odd-vertex matching;
+//     both packages share it through internal/blossom.
+//
+// Options:
+//
+//   - WithLeft(ids...), WithContext(ctx), WithMaxCardinality()
+//
+// Errors:
+//
+//   - ErrNilGraph, ErrNotBipartite, ErrEmptyVertexID, ErrDuplicateVertex,
+//     ErrVertexNotFound
+//   - ErrNilMatrix, ErrInvalidCost, ErrNoPerfectAssignment
+//   - ErrInvalidWeight, ErrNonSquareMatrix, ErrAsymmetricMatrix,
+//     ErrNoPerfectMatching
+//   - ErrNilOption, ErrNilContext
+//   - ctx.Err() on cancellation
+//
+// Complexity:
+//
+//   - HopcroftKarp: O(E sqrt(V)) time, O(V + E) space.
+//   - Assignment: O(n^2 m) time for n = min(R, C), m = max(R, C); O(R*C) space.
+//   - Weighted general matching: polynomial, O(V^2) dual phases over O(E)
+//     edge scans in the correctness-first engine; O(V + E) space.
+//
+// AI-Hints:
+//   - Edge weights and directions are ignored by HopcroftKarp; weighted
+//     bipartite problems belong in a cost matrix for Assignment.
+//   - Maximize profit by negating the matrix.
+//   - Prefer Assignment when the graph is bipartite; the Blossom engine pays
+//     for odd cycles it will never meet there.
+package matching
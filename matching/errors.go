@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	ErrNilGraph = errors.New("matching: graph is nil")
+
+	// ErrNotBipartite reports an edge with both endpoints on the same side:
+	// an odd cycle or loop under auto-detection, or an edge inside the WithLeft
+	// set or inside its complement.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending edge ID.
+	ErrNotBipartite = errors.New("matching: graph is not bipartite")
+
+	// ErrEmptyVertexID reports an empty vertex ID passed to WithLeft.
+	ErrEmptyVertexID = errors.New("matching: vertex id is empty")
+
+	// ErrDuplicateVertex reports a vertex listed twice in WithLeft.
+	ErrDuplicateVertex = errors.New("matching: duplicate vertex id")
+
+	// ErrVertexNotFound reports a WithLeft vertex that is not in the graph.
+	ErrVertexNotFound = errors.New("matching: vertex not found")
+
+	// ErrNilMatrix reports a nil cost matrix.
+	ErrNilMatrix = errors.New("matching: cost matrix is nil")
+
+	// ErrInvalidCost reports a NaN or -Inf cost; +Inf marks a forbidden pair.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending position.
+	ErrInvalidCost = errors.New("matching: cost must be finite or +Inf")
+
+	// ErrNoPerfectAssignment reports that forbidden (+Inf) pairs leave no
+	// assignment covering the smaller dimension.
+	ErrNoPerfectAssignment = errors.New("matching: no assignment avoids every forbidden pair")
+
+	// ErrInvalidWeight reports a NaN or infinite edge weight.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending edge ID.
+	ErrInvalidWeight = errors.New("matching: edge weight must be finite")
+
+	// ErrNonSquareMatrix reports a weight matrix whose rows and columns differ.
+	ErrNonSquareMatrix = errors.New("matching: weight matrix is not square")
+
+	// ErrAsymmetricMatrix reports entries (i, j) and (j, i) that differ.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending position.
+	ErrAsymmetricMatrix = errors.New("matching: weight matrix is not symmetric")
+
+	// ErrNoPerfectMatching reports that no perfect matching exists: an odd
+	// number of vertices, or a structure that leaves some vertex unpaired.
+	ErrNoPerfectMatching = errors.New("matching: no perfect matching exists")
+
+	// ErrNilOption reports that a nil Option was passed.
+	ErrNilOption = errors.New("matching: option is nil")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	ErrNilContext = errors.New("matching: context is nil")
+)
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/matching"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// ExampleHopcroftKarp staffs jobs with qualified workers. Wiring and Painting
+// can only go to Ana, and the cover names her as the reason one stays open.
+func ExampleHopcroftKarp() {
+	graph, _ := core.NewGraph()
+	for _, qualified := range [][2]string{
+		{"Welding", "Ana"}, {"Welding", "Bo"},
+		{"Wiring", "Ana"},
+		{"Painting", "Ana"},
+		{"Plumbing", "Bo"}, {"Plumbing", "Cy"},
+	} {
+		_, _ = graph.AddEdge(qualified[0], qualified[1], 0)
+	}
+
+	result, _ := matching.HopcroftKarp(graph, matching.WithLeft("Welding", "Wiring", "Painting", "Plumbing"))
+	fmt.Println("staffed:", result.Size)
+	for _, pair := range result.Pairs {
+		fmt.Println(pair.U, "->", pair.V)
+	}
+	fmt.Println("bottleneck:", result.Cover)
+
+	// Output:
+	// staffed: 3
+	// Painting -> Ana
+	// Plumbing -> Cy
+	// Welding -> Bo
+	// bottleneck: [Ana Plumbing Welding]
+}
+
+// ExampleAssignment gives each of three couriers one delivery, minimizing the
+// total minutes; +Inf marks a delivery a courier cannot make.
+func ExampleAssignment() {
+	minutes, _ := matrix.NewZeros(3, 3, matrix.WithAllowInfDistances())
+	for i, row := range [][]float64{
+		{25, 40, 35},
+		{30, math.Inf(1), 20},
+		{45, 30, 50},
+	} {
+		for j, value := range row {
+			_ = minutes.Set(i, j, value)
+		}
+	}
+
+	result, _ := matching.Assignment(minutes)
+	fmt.Println("courier -> delivery:", result.RowToCol, "total:", result.Cost)
+
+	// Output:
+	// courier -> delivery: [0 2 1] total: 75
+}
+
+// ExampleMaxWeight pairs climbers into rope teams by how well they work
+// together. Pairing Ana with Cy scores higher than any team that includes Bo,
+// so Bo and Dee stay out this time.
+func ExampleMaxWeight() {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	for _, rapport := range []struct {
+		u, v  string
+		score float64
+	}{
+		{"Ana", "Bo", 4}, {"Bo", "Cy", 4}, {"Ana", "Cy", 9}, {"Cy", "Dee", 3},
+	} {
+		_, _ = graph.AddEdge(rapport.u, rapport.v, rapport.score)
+	}
+
+	result, _ := matching.MaxWeight(graph)
+	fmt.Println("score:", result.Weight, "teams:", len(result.Pairs))
+	for _, pair := range result.Pairs {
+		fmt.Println(pair.U, "+", pair.V)
+	}
+
+	full, _ := matching.MaxWeight(graph, matching.WithMaxCardinality())
+	fmt.Println("everyone roped up, score:", full.Weight)
+
+	// Output:
+	// score: 9 teams: 1
+	// Ana + Cy
+	// everyone roped up, score: 7
+}
+
+// ExampleMinWeightPerfectMatrix splits four runners into relay pairs,
+// minimizing the total handover distance between training bases.
+func ExampleMinWeightPerfectMatrix() {
+	km, _ := matrix.NewZeros(4, 4)
+	for i, row := range [][]float64{
+		{0, 2, 7, 6},
+		{2, 0, 5, 9},
+		{7, 5, 0, 3},
+		{6, 9, 3, 0},
+	} {
+		for j, value := range row {
+			_ = km.Set(i, j, value)
+		}
+	}
+
+	result, _ := matching.MinWeightPerfectMatrix(km)
+	fmt.Println("partners:", result.Mate, "km:", result.Weight)
+
+	// Output:
+	// partners: [1 0 3 2] km: 5
+}
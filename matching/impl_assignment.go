@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// readCosts copies costs into row-major rows, transposed when it has more
+// rows than columns, so that the solver always assigns every row. It also
+// returns the column count of the copy, which an empty copy cannot carry.
+//
+// Errors:
+//   - ErrNilMatrix, ErrInvalidCost, matrix errors from At.
+//
+// Complexity:
+//   - Time O(R*C), Space O(R*C).
+func readCosts(costs matrix.Matrix) ([][]float64, int, bool, error) {
+	if err := matrix.ValidateNotNil(costs); err != nil {
+		return nil, 0, false, errors.Join(ErrNilMatrix, err)
+	}
+
+	rows, cols := costs.Rows(), costs.Cols()
+	transposed := rows > cols
+	if transposed {
+		rows, cols = cols, rows
+	}
+
+	c := make([][]float64, rows)
+	for i := range c {
+		c[i] = make([]float64, cols)
+	}
+	for i := 0; i < costs.Rows(); i++ {
+		for j := 0; j < costs.Cols(); j++ {
+			value, err := costs.At(i, j)
+			if err != nil {
+				return nil, 0, false, err
+			}
+			if math.IsNaN(value) || math.IsInf(value, -1) {
+				return nil, 0, false, fmt.Errorf("matching: cost at (%d, %d) is %v: %w", i, j, value, ErrInvalidCost)
+			}
+			if transposed {
+				c[j][i] = value
+			} else {
+				c[i][j] = value
+			}
+		}
+	}
+
+	return c, cols, transposed, nil
+}
+
+// solveAssignment assigns every row of c, which has m >= len(c) columns, to a
+// distinct column at minimum cost.
+//
+// Implementation:
+//   - Stage 1: Add rows one at a time; for each, grow a shortest-path tree
+//     over reduced costs c[i][j] - u[i] - v[j] (Dijkstra with dense minv).
+//   - Stage 2: After each tree step shift the potentials by the step length,
+//     which keeps every reduced cost non-negative and tree edges tight.
+//   - Stage 3: On reaching a free column, flip the alternating path.
+//
+// Behavior highlights:
+//   - This is the shortest augmenting path method of Jonker and Volgenant,
+//     i.e. the Hungarian algorithm with potentials.
+//   - +Inf costs are never tight; if the tree cannot grow past them, no
+//     assignment exists.
+//
+// Errors:
+//   - ErrNoPerfectAssignment; ctx.Err() between rows.
+//
+// Determinism:
+//   - Ties go to the lowest column index.
+//
+// Complexity:
+//   - Time O(n^2 m) for n rows and m columns, Space O(m).
+func solveAssignment(ctx context.Context, c [][]float64, m int) ([]int, []float64, []float64, error) {
+	n := len(c)
+
+	// 1-based: column 0 is a virtual column holding the row being added, and
+	// p[j] == 0 marks a free column.
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	p := make([]int, m+1)
+	way := make([]int, m+1)
+	minv := make([]float64, m+1)
+	used := make([]bool, m+1)
+
+	for i := 1; i <= n; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, nil, err
+		}
+
+		p[0] = i
+		j0 := 0
+		for j := range minv {
+			minv[j], used[j] = math.Inf(1), false
+		}
+		for p[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := p[j0], math.Inf(1), 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if reduced := c[i0-1][j-1] - u[i0] - v[j]; reduced < minv[j] {
+					minv[j], way[j] = reduced, j0
+				}
+				if minv[j] < delta {
+					delta, j1 = minv[j], j
+				}
+			}
+			if j1 == 0 {
+				return nil, nil, nil, fmt.Errorf("matching: row %d: %w", i-1, ErrNoPerfectAssignment)
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	rowToCol := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			rowToCol[p[j]-1] = j - 1
+		}
+	}
+
+	return rowToCol, u[1:], v[1:], nil
+}
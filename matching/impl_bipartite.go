@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+import (
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// bipartiteGraph is an index-based snapshot of a bipartite core.Graph.
+//
+// AI-Hints:
+//   - adj is filled for left vertices only; parallel edges collapse to the
+//     first one, whose ID edgeID keeps.
+type bipartiteGraph struct {
+	ids    []string
+	left   []bool
+	adj    [][]int
+	edgeID map[[2]int]string
+}
+
+// newBipartiteGraph validates g and resolves its bipartition.
+//
+// Implementation:
+//   - Stage 1: Index vertices in core.Vertices() order.
+//   - Stage 2: Take the sides from explicit, or two-colour every component
+//     by BFS, putting its first vertex on the left.
+//   - Stage 3: Reject any edge within one side; collect left adjacency.
+//
+// Behavior highlights:
+//   - Direction is ignored: a directed edge still joins its two endpoints.
+//   - Isolated vertices go left under auto-detection.
+//
+// Errors:
+//   - ErrNilGraph, ErrVertexNotFound, ErrNotBipartite.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func newBipartiteGraph(g *core.Graph, explicit []string) (*bipartiteGraph, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+
+	b := &bipartiteGraph{ids: g.Vertices(), edgeID: make(map[[2]int]string)}
+	index := make(map[string]int, len(b.ids))
+	for position, vertexID := range b.ids {
+		index[vertexID] = position
+	}
+	b.left = make([]bool, len(b.ids))
+	b.adj = make([][]int, len(b.ids))
+	edges := g.Edges()
+
+	if explicit != nil {
+		for _, vertexID := range explicit {
+			position, ok := index[vertexID]
+			if !ok {
+				return nil, fmt.Errorf("matching: left vertex %q: %w", vertexID, ErrVertexNotFound)
+			}
+			b.left[position] = true
+		}
+	} else {
+		b.colour(index, edges)
+	}
+
+	for _, edge := range edges {
+		u, v := index[edge.From], index[edge.To]
+		if b.left[u] == b.left[v] {
+			return nil, fmt.Errorf("matching: edge %q joins %q and %q on one side: %w", edge.ID, edge.From, edge.To, ErrNotBipartite)
+		}
+		if !b.left[u] {
+			u, v = v, u
+		}
+		key := [2]int{u, v}
+		if _, ok := b.edgeID[key]; !ok {
+			b.edgeID[key] = edge.ID
+			b.adj[u] = append(b.adj[u], v)
+		}
+	}
+
+	return b, nil
+}
+
+// colour two-colours every component by BFS; conflicts are left for the edge
+// check in newBipartiteGraph to report.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func (b *bipartiteGraph) colour(index map[string]int, edges []*core.Edge) {
+	neighbours := make([][]int, len(b.ids))
+	for _, edge := range edges {
+		u, v := index[edge.From], index[edge.To]
+		neighbours[u] = append(neighbours[u], v)
+		neighbours[v] = append(neighbours[v], u)
+	}
+
+	seen := make([]bool, len(b.ids))
+	var queue []int
+	for start := range b.ids {
+		if seen[start] {
+			continue
+		}
+		seen[start], b.left[start] = true, true
+		queue = append(queue[:0], start)
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, v := range neighbours[u] {
+				if !seen[v] {
+					seen[v], b.left[v] = true, !b.left[u]
+					queue = append(queue, v)
+				}
+			}
+		}
+	}
+}
+
+// result publishes mate as a BipartiteResult with its Konig cover.
+//
+// Implementation:
+//   - Stage 1: Collect pairs and sides.
+//   - Stage 2: Mark vertices reachable from free left vertices by alternating
+//     paths; the cover is the unreached left vertices plus the reached right
+//     ones.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V).
+func (b *bipartiteGraph) result(mate []int) *BipartiteResult {
+	result := &BipartiteResult{Mate: make(map[string]string)}
+	reached := make([]bool, len(b.ids))
+	var queue []int
+	for u, vertexID := range b.ids {
+		if !b.left[u] {
+			result.Right = append(result.Right, vertexID)
+			continue
+		}
+		result.Left = append(result.Left, vertexID)
+		if r := mate[u]; r >= 0 {
+			result.Pairs = append(result.Pairs, Pair{U: vertexID, V: b.ids[r], EdgeID: b.edgeID[[2]int{u, r}]})
+			result.Mate[vertexID], result.Mate[b.ids[r]] = b.ids[r], vertexID
+			continue
+		}
+		reached[u] = true
+		queue = append(queue, u)
+	}
+	result.Size = len(result.Pairs)
+
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, r := range b.adj[u] {
+			if reached[r] {
+				continue
+			}
+			reached[r] = true
+			if w := mate[r]; w >= 0 && !reached[w] {
+				reached[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+	for u, vertexID := range b.ids {
+		if reached[u] != b.left[u] {
+			result.Cover = append(result.Cover, vertexID)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/internal/blossom"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// generalInstance is an index-based snapshot of a weighted matching problem.
+//
+// AI-Hints:
+//   - edges holds one entry per vertex pair; edgeIDs parallels it for graphs
+//     and is nil for matrices, as is ids.
+type generalInstance struct {
+	n       int
+	ids     []string
+	edges   []blossom.Edge
+	edgeIDs []string
+}
+
+// newGraphInstance validates g and collapses it to one weighted edge per pair.
+//
+// Implementation:
+//   - Stage 1: Index vertices in core.Vertices() order.
+//   - Stage 2: Validate weights; unweighted graphs weigh every edge 1.
+//   - Stage 3: Drop loops and keep the best parallel edge: the cheapest when
+//     minimize, the heaviest otherwise, the first one on ties.
+//   - Stage 4: Order edges by endpoint indices, as newMatrixInstance does.
+//
+// Behavior highlights:
+//   - Direction is ignored: a directed edge still joins its two endpoints.
+//   - Edge insertion order does not influence tie-breaking, and a graph and
+//     its weight matrix give the same result.
+//
+// Errors:
+//   - ErrNilGraph, ErrInvalidWeight.
+//
+// Complexity:
+//   - Time O(V + E log E), Space O(V + E).
+func newGraphInstance(g *core.Graph, minimize bool) (*generalInstance, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+
+	in := &generalInstance{ids: g.Vertices()}
+	in.n = len(in.ids)
+	index := make(map[string]int, in.n)
+	for position, vertexID := range in.ids {
+		index[vertexID] = position
+	}
+
+	slot := make(map[[2]int]int)
+	for _, edge := range g.Edges() {
+		weight := 1.0
+		if g.Weighted() {
+			weight = edge.Weight
+		}
+		if math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return nil, fmt.Errorf("matching: edge %q has weight %v: %w", edge.ID, weight, ErrInvalidWeight)
+		}
+
+		u, v := index[edge.From], index[edge.To]
+		if u == v {
+			continue
+		}
+		if u > v {
+			u, v = v, u
+		}
+		if at, ok := slot[[2]int{u, v}]; ok {
+			current := in.edges[at].Weight
+			if (minimize && weight < current) || (!minimize && weight > current) {
+				in.edges[at].Weight, in.edgeIDs[at] = weight, edge.ID
+			}
+			continue
+		}
+		slot[[2]int{u, v}] = len(in.edges)
+		in.edges = append(in.edges, blossom.Edge{U: u, V: v, Weight: weight})
+		in.edgeIDs = append(in.edgeIDs, edge.ID)
+	}
+
+	order := make([]int, len(in.edges))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		ea, eb := in.edges[order[a]], in.edges[order[b]]
+		return ea.U < eb.U || ea.U == eb.U && ea.V < eb.V
+	})
+	edges, edgeIDs := make([]blossom.Edge, len(order)), make([]string, len(order))
+	for i, at := range order {
+		edges[i], edgeIDs[i] = in.edges[at], in.edgeIDs[at]
+	}
+	in.edges, in.edgeIDs = edges, edgeIDs
+
+	return in, nil
+}
+
+// newMatrixInstance reads a symmetric weight matrix; every finite off-diagonal
+// entry above the diagonal is one edge.
+//
+// Behavior highlights:
+//   - +Inf marks a missing pair; the diagonal is ignored.
+//
+// Errors:
+//   - ErrNilMatrix, ErrNonSquareMatrix, ErrInvalidCost, ErrAsymmetricMatrix,
+//     matrix errors from At.
+//
+// Complexity:
+//   - Time O(n^2), Space O(n^2).
+func newMatrixInstance(weights matrix.Matrix) (*generalInstance, error) {
+	if err := matrix.ValidateNotNil(weights); err != nil {
+		return nil, errors.Join(ErrNilMatrix, err)
+	}
+	if weights.Rows() != weights.Cols() {
+		return nil, fmt.Errorf("matching: %dx%d: %w", weights.Rows(), weights.Cols(), ErrNonSquareMatrix)
+	}
+
+	in := &generalInstance{n: weights.Rows()}
+	for i := 0; i < in.n; i++ {
+		for j := i + 1; j < in.n; j++ {
+			value, err := weights.At(i, j)
+			if err != nil {
+				return nil, err
+			}
+			mirror, err := weights.At(j, i)
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range [...]float64{value, mirror} {
+				if math.IsNaN(entry) || math.IsInf(entry, -1) {
+					return nil, fmt.Errorf("matching: cost at (%d, %d) is %v: %w", i, j, entry, ErrInvalidCost)
+				}
+			}
+			if value != mirror {
+				return nil, fmt.Errorf("matching: (%d, %d) is %v but (%d, %d) is %v: %w", i, j, value, j, i, mirror, ErrAsymmetricMatrix)
+			}
+			if !math.IsInf(value, 1) {
+				in.edges = append(in.edges, blossom.Edge{U: i, V: j, Weight: value})
+			}
+		}
+	}
+
+	return in, nil
+}
+
+// solve runs the shared Blossom engine on the snapshot.
+//
+// Implementation:
+//   - Stage 1: Pick the engine mode; a minimum-weight perfect matching is a
+//     maximum-weight perfect matching of the negated costs.
+//   - Stage 2: Run blossom.Solve with blossom.DefaultEps.
+//   - Stage 3: For minimize, negate the vertex duals back into cost space.
+//
+// Errors:
+//   - ErrNoPerfectMatching when minimize finds no perfect matching.
+//   - Any other engine error wrapped; it signals an internal certificate failure.
+//
+// Complexity:
+//   - That of blossom.Solve.
+func (in *generalInstance) solve(minimize, maxCardinality bool) (*blossom.Matching, error) {
+	edges, mode := in.edges, blossom.ModeMaxWeight
+	switch {
+	case minimize:
+		mode = blossom.ModePerfect
+		edges = make([]blossom.Edge, len(in.edges))
+		for i, edge := range in.edges {
+			edges[i] = blossom.Edge{U: edge.U, V: edge.V, Weight: -edge.Weight}
+		}
+	case maxCardinality:
+		mode = blossom.ModeMaxCardinality
+	}
+
+	m, err := blossom.Solve(in.n, edges, mode, blossom.DefaultEps)
+	if errors.Is(err, blossom.ErrIncompleteGraph) {
+		return nil, ErrNoPerfectMatching
+	}
+	if err != nil {
+		return nil, fmt.Errorf("matching: blossom engine: %w", err)
+	}
+
+	if minimize {
+		for v := range m.VertexDuals {
+			m.VertexDuals[v] = -m.VertexDuals[v]
+		}
+	}
+
+	return m, nil
+}
+
+// weight sums the snapshot weights of the matched edges, so a minimum-cost
+// result never carries a negated zero.
+//
+// Complexity:
+//   - Time O(n), Space O(1).
+func (in *generalInstance) weight(m *blossom.Matching) float64 {
+	total := 0.0
+	for v, mate := range m.Mate {
+		if v < mate {
+			total += in.edges[m.MateEdge[v]].Weight
+		}
+	}
+
+	return total
+}
+
+// graphResult publishes m in vertex IDs.
+//
+// Complexity:
+//   - Time O(V + total blossom size), Space the same.
+func (in *generalInstance) graphResult(m *blossom.Matching) *WeightedResult {
+	result := &WeightedResult{
+		Weight:       in.weight(m),
+		Mate:         make(map[string]string),
+		VertexDuals:  make(map[string]float64, in.n),
+		Blossoms:     make([][]string, 0, len(m.Blossoms)),
+		BlossomDuals: m.BlossomDuals,
+	}
+
+	for v, vertexID := range in.ids {
+		result.VertexDuals[vertexID] = m.VertexDuals[v]
+		mate := m.Mate[v]
+		if mate < 0 {
+			continue
+		}
+		result.Mate[vertexID] = in.ids[mate]
+		if v < mate {
+			result.Pairs = append(result.Pairs, Pair{U: vertexID, V: in.ids[mate], EdgeID: in.edgeIDs[m.MateEdge[v]]})
+		}
+	}
+	for _, members := range m.Blossoms {
+		names := make([]string, len(members))
+		for i, v := range members {
+			names[i] = in.ids[v]
+		}
+		result.Blossoms = append(result.Blossoms, names)
+	}
+
+	return result
+}
+
+// matrixResult publishes m by row index.
+//
+// Complexity:
+//   - Time O(n), Space O(1) beyond m.
+func (in *generalInstance) matrixResult(m *blossom.Matching) *WeightedMatrixResult {
+	return &WeightedMatrixResult{
+		Weight:       in.weight(m),
+		Mate:         m.Mate,
+		VertexDuals:  m.VertexDuals,
+		Blossoms:     m.Blossoms,
+		BlossomDuals: m.BlossomDuals,
+	}
+}
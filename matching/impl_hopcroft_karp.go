@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+import (
+	"context"
+	"math"
+)
+
+// hopcroftKarp carries the state of one Hopcroft-Karp run.
+//
+// AI-Hints:
+//   - dist is the BFS layer of left vertices; limit is the layer at which a
+//     free right vertex was first seen, so only shortest augmenting paths are
+//     used in a phase.
+type hopcroftKarp struct {
+	b     *bipartiteGraph
+	mate  []int
+	dist  []int
+	next  []int
+	limit int
+}
+
+// runHopcroftKarp computes a maximum matching of b.
+//
+// Implementation:
+//   - Stage 1: BFS from all free left vertices builds layers up to the first
+//     free right vertex.
+//   - Stage 2: DFS along the layers augments a maximal set of vertex-disjoint
+//     shortest paths; next makes each phase O(E).
+//   - Stage 3: Repeat until no augmenting path exists.
+//
+// Errors:
+//   - ctx.Err() between phases.
+//
+// Determinism:
+//   - Free vertices and neighbours are visited in snapshot order.
+//
+// Complexity:
+//   - Time O(E sqrt(V)): at most O(sqrt(V)) phases of O(E). Space O(V).
+func runHopcroftKarp(ctx context.Context, b *bipartiteGraph) ([]int, error) {
+	n := len(b.ids)
+	h := &hopcroftKarp{b: b, mate: make([]int, n), dist: make([]int, n), next: make([]int, n)}
+	for v := range h.mate {
+		h.mate[v] = -1
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if !h.layer() {
+			return h.mate, nil
+		}
+		for u := 0; u < n; u++ {
+			h.next[u] = 0
+		}
+		for u := 0; u < n; u++ {
+			if b.left[u] && h.mate[u] < 0 {
+				h.augment(u)
+			}
+		}
+	}
+}
+
+// layer runs the BFS phase and reports whether a free right vertex is reachable.
+func (h *hopcroftKarp) layer() bool {
+	var queue []int
+	for u := range h.dist {
+		h.dist[u] = math.MaxInt
+		if h.b.left[u] && h.mate[u] < 0 {
+			h.dist[u] = 0
+			queue = append(queue, u)
+		}
+	}
+
+	h.limit = math.MaxInt
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		if h.dist[u] >= h.limit {
+			continue
+		}
+		for _, r := range h.b.adj[u] {
+			w := h.mate[r]
+			switch {
+			case w < 0:
+				if h.limit == math.MaxInt {
+					h.limit = h.dist[u] + 1
+				}
+			case h.dist[w] == math.MaxInt:
+				h.dist[w] = h.dist[u] + 1
+				queue = append(queue, w)
+			}
+		}
+	}
+
+	return h.limit != math.MaxInt
+}
+
+// augment searches a shortest augmenting path from left vertex u along the
+// layers and flips it.
+//
+// Notes:
+//   - A dead end drops u from the layers for the rest of the phase.
+func (h *hopcroftKarp) augment(u int) bool {
+	for ; h.next[u] < len(h.b.adj[u]); h.next[u]++ {
+		r := h.b.adj[u][h.next[u]]
+		w := h.mate[r]
+		if (w < 0 && h.dist[u]+1 == h.limit) || (w >= 0 && h.dist[w] == h.dist[u]+1 && h.augment(w)) {
+			h.mate[u], h.mate[r] = r, u
+			h.next[u]++
+			return true
+		}
+	}
+	h.dist[u] = math.MaxInt
+
+	return false
+}
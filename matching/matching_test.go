@@ -0,0 +1,834 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+	"github.com/katalvlaran/lvlath/matching"
+	"github.com/katalvlaran/lvlath/matrix"
+)
+
+// AI-HINTS (file):
+//   - Matching sizes are checked against flow.MaxFlow on the classic unit
+//     network, and every matching is replayed against the graph.
+//   - Optimality is also checked through the certificates the results carry:
+//     a Konig cover of equal size, and assignment potentials whose sum equals
+//     the cost.
+//   - Assignments are compared with brute force over all injections.
+
+// buildRandomBipartite returns a mixed multigraph over l left and r right
+// vertices, interleaved in core.Vertices() order, and the left IDs.
+func buildRandomBipartite(t *testing.T, rng *rand.Rand, l, r, edges int) (*core.Graph, []string) {
+	t.Helper()
+
+	g, err := core.NewGraph(core.WithMultiEdges(), core.WithMixedEdges())
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	var left []string
+	for v := 0; v < l+r; v++ {
+		id := fmt.Sprintf("v%02d", v)
+		if err = g.AddVertex(id); err != nil {
+			t.Fatalf("AddVertex: %v", err)
+		}
+		if v%2 == 0 && len(left) < l || v-len(left) >= r {
+			left = append(left, id)
+		}
+	}
+	isLeft := make(map[string]bool)
+	var right []string
+	for _, id := range left {
+		isLeft[id] = true
+	}
+	for _, id := range g.Vertices() {
+		if !isLeft[id] {
+			right = append(right, id)
+		}
+	}
+	if len(left) == 0 || len(right) == 0 {
+		return g, left
+	}
+	for e := 0; e < edges; e++ {
+		from, to := left[rng.Intn(len(left))], right[rng.Intn(len(right))]
+		if rng.Intn(2) == 0 {
+			from, to = to, from
+		}
+		if _, err = g.AddEdge(from, to, 0, core.WithEdgeDirected(rng.Intn(3) == 0)); err != nil {
+			t.Fatalf("AddEdge: %v", err)
+		}
+	}
+
+	return g, left
+}
+
+// maxFlowMatchingSize solves the same instance as a unit-capacity flow network.
+func maxFlowMatchingSize(t *testing.T, g *core.Graph, left []string) float64 {
+	t.Helper()
+
+	network, err := core.NewGraph(core.WithDirected(true), core.WithWeighted())
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	for _, id := range []string{"source", "sink"} {
+		if err = network.AddVertex(id); err != nil {
+			t.Fatalf("AddVertex: %v", err)
+		}
+	}
+	isLeft := make(map[string]bool)
+	for _, id := range left {
+		isLeft[id] = true
+	}
+	add := func(from, to string) {
+		if _, err := network.AddEdge(from, to, 1); err != nil {
+			t.Fatalf("AddEdge: %v", err)
+		}
+	}
+	for _, id := range g.Vertices() {
+		if isLeft[id] {
+			add("source", "L"+id)
+		} else {
+			add("R"+id, "sink")
+		}
+	}
+	for _, edge := range g.Edges() {
+		from, to := edge.From, edge.To
+		if !isLeft[from] {
+			from, to = to, from
+		}
+		if !network.HasEdge("L"+from, "R"+to) {
+			add("L"+from, "R"+to)
+		}
+	}
+
+	result, err := flow.MaxFlow(network, "source", "sink")
+	if err != nil {
+		t.Fatalf("MaxFlow: %v", err)
+	}
+
+	return result.Value
+}
+
+// mustValidBipartite replays result against g.
+func mustValidBipartite(t *testing.T, g *core.Graph, result *matching.BipartiteResult) {
+	t.Helper()
+
+	isLeft := make(map[string]bool)
+	for _, id := range result.Left {
+		isLeft[id] = true
+	}
+	if len(result.Left)+len(result.Right) != len(g.Vertices()) {
+		t.Fatalf("sides %v | %v do not cover the graph", result.Left, result.Right)
+	}
+	edgesByID := make(map[string]*core.Edge)
+	for _, edge := range g.Edges() {
+		if isLeft[edge.From] == isLeft[edge.To] {
+			t.Fatalf("edge %s lies inside one side", edge.ID)
+		}
+		edgesByID[edge.ID] = edge
+	}
+
+	matched := make(map[string]bool)
+	for _, pair := range result.Pairs {
+		edge := edgesByID[pair.EdgeID]
+		if edge == nil || !isLeft[pair.U] || isLeft[pair.V] ||
+			!(edge.From == pair.U && edge.To == pair.V || edge.From == pair.V && edge.To == pair.U) {
+			t.Fatalf("pair %+v is not a left-right edge", pair)
+		}
+		if matched[pair.U] || matched[pair.V] {
+			t.Fatalf("pair %+v reuses a vertex", pair)
+		}
+		matched[pair.U], matched[pair.V] = true, true
+		if result.Mate[pair.U] != pair.V || result.Mate[pair.V] != pair.U {
+			t.Fatalf("Mate disagrees with pair %+v", pair)
+		}
+	}
+	if result.Size != len(result.Pairs) || len(result.Mate) != 2*result.Size {
+		t.Fatalf("Size %d, %d pairs, %d mates", result.Size, len(result.Pairs), len(result.Mate))
+	}
+
+	inCover := make(map[string]bool)
+	for _, id := range result.Cover {
+		inCover[id] = true
+	}
+	if len(result.Cover) != result.Size {
+		t.Fatalf("cover %v has %d vertices for a matching of %d", result.Cover, len(result.Cover), result.Size)
+	}
+	for _, edge := range g.Edges() {
+		if !inCover[edge.From] && !inCover[edge.To] {
+			t.Fatalf("cover %v misses edge %s", result.Cover, edge.ID)
+		}
+	}
+}
+
+func TestHopcroftKarp_MatchesMaxFlow(t *testing.T) {
+	rng := rand.New(rand.NewSource(47))
+	for trial := 0; trial < 200; trial++ {
+		l, r := rng.Intn(9), rng.Intn(9)
+		g, left := buildRandomBipartite(t, rng, l, r, rng.Intn(3*(l+r)+1))
+		want := maxFlowMatchingSize(t, g, left)
+
+		explicit, err := matching.HopcroftKarp(g, matching.WithLeft(left...))
+		if err != nil {
+			t.Fatalf("trial %d: %v", trial, err)
+		}
+		mustValidBipartite(t, g, explicit)
+		if float64(explicit.Size) != want || fmt.Sprint(explicit.Left) != fmt.Sprint(left) {
+			t.Fatalf("trial %d: size %d over %v, max flow %g over %v", trial, explicit.Size, explicit.Left, want, left)
+		}
+
+		auto, err := matching.HopcroftKarp(g)
+		if err != nil {
+			t.Fatalf("trial %d auto: %v", trial, err)
+		}
+		mustValidBipartite(t, g, auto)
+		if float64(auto.Size) != want {
+			t.Fatalf("trial %d auto: size %d, max flow %g", trial, auto.Size, want)
+		}
+	}
+}
+
+func TestHopcroftKarp_LongAugmentingPaths(t *testing.T) {
+	// A ladder whose greedy first phase leaves one long augmenting path.
+	g, err := core.NewGraph()
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	const n = 300
+	for i := 0; i < n; i++ {
+		if _, err = g.AddEdge(fmt.Sprintf("a%03d", i), fmt.Sprintf("b%03d", i), 0); err != nil {
+			t.Fatalf("AddEdge: %v", err)
+		}
+		if i+1 < n {
+			if _, err = g.AddEdge(fmt.Sprintf("a%03d", i+1), fmt.Sprintf("b%03d", i), 0); err != nil {
+				t.Fatalf("AddEdge: %v", err)
+			}
+		}
+	}
+
+	result, err := matching.HopcroftKarp(g)
+	if err != nil {
+		t.Fatalf("HopcroftKarp: %v", err)
+	}
+	mustValidBipartite(t, g, result)
+	if result.Size != n {
+		t.Fatalf("size %d, want %d", result.Size, n)
+	}
+}
+
+// bruteForceAssignment tries every injection of the smaller side.
+func bruteForceAssignment(costs [][]float64) float64 {
+	rows, cols := len(costs), len(costs[0])
+	best := math.Inf(1)
+	usedRows, usedCols := make([]bool, rows), make([]bool, cols)
+	var search func(assigned int, cost float64)
+	search = func(assigned int, cost float64) {
+		if assigned == min(rows, cols) {
+			best = math.Min(best, cost)
+			return
+		}
+		if rows <= cols {
+			for j := 0; j < cols; j++ {
+				if !usedCols[j] {
+					usedCols[j] = true
+					search(assigned+1, cost+costs[assigned][j])
+					usedCols[j] = false
+				}
+			}
+			return
+		}
+		for i := 0; i < rows; i++ {
+			if !usedRows[i] {
+				usedRows[i] = true
+				search(assigned+1, cost+costs[i][assigned])
+				usedRows[i] = false
+			}
+		}
+	}
+	search(0, 0)
+
+	return best
+}
+
+// mustOptimalAssignment checks the assignment and its dual certificate.
+func mustOptimalAssignment(t *testing.T, costs [][]float64, result *matching.AssignmentResult) {
+	t.Helper()
+
+	rows, cols := len(costs), len(costs[0])
+	assigned, cost := 0, 0.0
+	for i, j := range result.RowToCol {
+		if j < 0 {
+			continue
+		}
+		if result.ColToRow[j] != i {
+			t.Fatalf("row %d -> col %d, but col %d -> row %d", i, j, j, result.ColToRow[j])
+		}
+		assigned++
+		cost += costs[i][j]
+	}
+	if assigned != min(rows, cols) || cost != result.Cost {
+		t.Fatalf("%d assigned with cost %g, result says %g", assigned, cost, result.Cost)
+	}
+
+	dual := 0.0
+	for i, u := range result.RowPotentials {
+		dual += u
+		for j, v := range result.ColPotentials {
+			if u+v > costs[i][j]+1e-9 {
+				t.Fatalf("potentials %g + %g exceed cost %g at (%d, %d)", u, v, costs[i][j], i, j)
+			}
+		}
+	}
+	for _, v := range result.ColPotentials {
+		dual += v
+	}
+	larger := result.ColPotentials
+	if rows > cols {
+		larger = result.RowPotentials
+	}
+	for _, p := range larger {
+		if p > 1e-9 {
+			t.Fatalf("potential %g of the larger side is positive", p)
+		}
+	}
+	if math.Abs(dual-result.Cost) > 1e-9*(1+math.Abs(result.Cost)) {
+		t.Fatalf("dual %g, cost %g", dual, result.Cost)
+	}
+}
+
+func TestAssignment_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	infeasible := 0
+	for trial := 0; trial < 300; trial++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		m, err := matrix.NewZeros(rows, cols, matrix.WithAllowInfDistances())
+		if err != nil {
+			t.Fatalf("NewZeros: %v", err)
+		}
+		costs := make([][]float64, rows)
+		for i := range costs {
+			costs[i] = make([]float64, cols)
+			for j := range costs[i] {
+				costs[i][j] = float64(rng.Intn(21) - 5)
+				if rng.Intn(4) == 0 {
+					costs[i][j] = math.Inf(1)
+				}
+				if err = m.Set(i, j, costs[i][j]); err != nil {
+					t.Fatalf("Set: %v", err)
+				}
+			}
+		}
+
+		want := bruteForceAssignment(costs)
+		result, err := matching.Assignment(m)
+		if math.IsInf(want, 1) {
+			if !errors.Is(err, matching.ErrNoPerfectAssignment) {
+				t.Fatalf("trial %d: want ErrNoPerfectAssignment, got %v", trial, err)
+			}
+			infeasible++
+			continue
+		}
+		if err != nil {
+			t.Fatalf("trial %d: %v", trial, err)
+		}
+		if result.Cost != want {
+			t.Fatalf("trial %d: cost %g, brute force %g", trial, result.Cost, want)
+		}
+		mustOptimalAssignment(t, costs, result)
+	}
+	if infeasible == 0 {
+		t.Fatal("no infeasible instance was generated")
+	}
+}
+
+func TestAssignment_ZeroShape(t *testing.T) {
+	m, err := matrix.NewZeros(0, 3)
+	if err != nil {
+		t.Fatalf("NewZeros: %v", err)
+	}
+	result, err := matching.Assignment(m)
+	if err != nil {
+		t.Fatalf("Assignment: %v", err)
+	}
+	if result.Cost != 0 || len(result.RowToCol) != 0 || fmt.Sprint(result.ColToRow) != "[-1 -1 -1]" ||
+		len(result.ColPotentials) != 3 {
+		t.Fatalf("unexpected %+v", result)
+	}
+}
+
+// weightedInstance is a random general graph with parallel edges, a loop, and
+// one directed edge, plus the per-pair weight the solvers must see. pair holds
+// NaN for absent pairs.
+type weightedInstance struct {
+	g    *core.Graph
+	ids  []string
+	pair [][]float64
+}
+
+// buildRandomWeighted draws an instance over n vertices; minimize picks which
+// parallel edge counts, and integral keeps weights in [-5, 20].
+func buildRandomWeighted(t *testing.T, rng *rand.Rand, n int, density float64, minimize, integral bool) weightedInstance {
+	t.Helper()
+
+	g, err := core.NewGraph(core.WithWeighted(), core.WithMultiEdges(), core.WithMixedEdges(), core.WithLoops())
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	in := weightedInstance{g: g, pair: make([][]float64, n)}
+	for v := 0; v < n; v++ {
+		id := fmt.Sprintf("v%02d", v)
+		if err = g.AddVertex(id); err != nil {
+			t.Fatalf("AddVertex: %v", err)
+		}
+		in.ids = append(in.ids, id)
+		in.pair[v] = make([]float64, n)
+		for u := range in.pair[v] {
+			in.pair[v][u] = math.NaN()
+		}
+	}
+	draw := func() float64 {
+		if integral {
+			return float64(rng.Intn(26) - 5)
+		}
+		return math.Round((rng.Float64()*250-50)*1e3) / 1e3
+	}
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if rng.Float64() >= density {
+				continue
+			}
+			for copies := 1 + rng.Intn(2); copies > 0; copies-- {
+				w := draw()
+				from, to := in.ids[u], in.ids[v]
+				if rng.Intn(2) == 0 {
+					from, to = to, from
+				}
+				var opts []core.EdgeOption
+				if rng.Intn(8) == 0 {
+					opts = append(opts, core.WithEdgeDirected(true))
+				}
+				if _, err = g.AddEdge(from, to, w, opts...); err != nil {
+					t.Fatalf("AddEdge: %v", err)
+				}
+				best := in.pair[u][v]
+				if math.IsNaN(best) || (minimize && w < best) || (!minimize && w > best) {
+					in.pair[u][v], in.pair[v][u] = w, w
+				}
+			}
+		}
+	}
+	if n > 0 {
+		if _, err = g.AddEdge(in.ids[0], in.ids[0], 1000); err != nil {
+			t.Fatalf("AddEdge loop: %v", err)
+		}
+	}
+
+	return in
+}
+
+// bruteForceWeighted returns the best (cardinality, weight) over all matchings:
+// the heaviest one, the heaviest of maximum cardinality, or the cheapest
+// perfect one (NaN weight when none exists).
+func bruteForceWeighted(pair [][]float64, mode string) (int, float64) {
+	n := len(pair)
+	used := make([]bool, n)
+	bestSize, bestWeight := -1, math.NaN()
+	better := func(size int, weight float64) bool {
+		switch {
+		case math.IsNaN(bestWeight):
+			return true
+		case mode == "max":
+			return weight > bestWeight
+		case mode == "cardinality":
+			return size > bestSize || size == bestSize && weight > bestWeight
+		default:
+			return weight < bestWeight
+		}
+	}
+	var search func(size int, weight float64)
+	search = func(size int, weight float64) {
+		u := 0
+		for u < n && used[u] {
+			u++
+		}
+		if u == n {
+			if better(size, weight) {
+				bestSize, bestWeight = size, weight
+			}
+			return
+		}
+		used[u] = true
+		if mode != "perfect" {
+			search(size, weight)
+		}
+		for v := u + 1; v < n; v++ {
+			if !used[v] && !math.IsNaN(pair[u][v]) {
+				used[v] = true
+				search(size+1, weight+pair[u][v])
+				used[v] = false
+			}
+		}
+		used[u] = false
+	}
+	search(0, 0)
+
+	return bestSize, bestWeight
+}
+
+// mustCertifiedWeighted replays a weighted matching over mate and checks its
+// dual certificate under the WeightedResult conventions.
+func mustCertifiedWeighted(t *testing.T, pair [][]float64, mate []int, weight float64,
+	y []float64, blossoms [][]int, z []float64, mode string) {
+	t.Helper()
+
+	n := len(pair)
+	tol := 1e-7 * (1 + math.Abs(weight))
+	sign := 1.0
+	if mode == "perfect" {
+		sign = -1
+	}
+	inside := make([][]bool, len(blossoms))
+	for b, members := range blossoms {
+		inside[b] = make([]bool, n)
+		for _, v := range members {
+			inside[b][v] = true
+		}
+		matched := 0
+		for _, v := range members {
+			if m := mate[v]; m > v && inside[b][m] {
+				matched++
+			}
+		}
+		if len(members)%2 == 0 || matched != (len(members)-1)/2 || z[b] < 0 {
+			t.Fatalf("blossom %v (z=%g) is not a full odd set: %d matched inside", members, z[b], matched)
+		}
+	}
+
+	total, objective, minDual := 0.0, 0.0, math.Inf(1)
+	for _, dual := range y {
+		minDual = math.Min(minDual, dual)
+	}
+	for u := 0; u < n; u++ {
+		if m := mate[u]; m >= 0 {
+			if mate[m] != u || math.IsNaN(pair[u][m]) {
+				t.Fatalf("invalid pair %d-%d", u, m)
+			}
+			if u < m {
+				total += pair[u][m]
+			}
+			objective += y[u]
+		} else {
+			if mode == "perfect" {
+				t.Fatalf("vertex %d unmatched in a perfect matching", u)
+			}
+			if mode == "max" && math.Abs(y[u]) > tol || mode == "cardinality" && y[u] > minDual+tol {
+				t.Fatalf("unmatched vertex %d has dual %g (min %g)", u, y[u], minDual)
+			}
+		}
+		if mode == "max" && y[u] < -tol {
+			t.Fatalf("vertex %d has negative dual %g", u, y[u])
+		}
+		for v := u + 1; v < n; v++ {
+			if math.IsNaN(pair[u][v]) {
+				continue
+			}
+			reduced := y[u] + y[v]
+			for b := range blossoms {
+				if inside[b][u] && inside[b][v] {
+					reduced += sign * z[b]
+				}
+			}
+			slack := sign * (reduced - pair[u][v])
+			if slack < -tol || mate[u] == v && math.Abs(slack) > tol {
+				t.Fatalf("edge %d-%d weight %g has slack %g (matched=%v)", u, v, pair[u][v], slack, mate[u] == v)
+			}
+		}
+	}
+	for b, members := range blossoms {
+		objective += sign * z[b] * float64((len(members)-1)/2)
+	}
+	if math.Abs(total-weight) > tol || math.Abs(objective-weight) > tol {
+		t.Fatalf("weight %g, replayed %g, dual objective %g", weight, total, objective)
+	}
+}
+
+// weightedMatrix stores pair in a Dense matrix with +Inf for absent pairs.
+func weightedMatrix(t *testing.T, pair [][]float64) matrix.Matrix {
+	t.Helper()
+
+	m, err := matrix.NewZeros(len(pair), len(pair), matrix.WithAllowInfDistances())
+	if err != nil {
+		t.Fatalf("NewZeros: %v", err)
+	}
+	for i := range pair {
+		for j, w := range pair[i] {
+			if math.IsNaN(w) {
+				w = math.Inf(1)
+			}
+			if i != j {
+				if err = m.Set(i, j, w); err != nil {
+					t.Fatalf("Set: %v", err)
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+// checkWeighted runs one mode through the graph and matrix APIs and compares
+// both with brute force and with each other.
+func checkWeighted(t *testing.T, in weightedInstance, mode string, brute bool) {
+	t.Helper()
+
+	var (
+		graphResult  *matching.WeightedResult
+		matrixResult *matching.WeightedMatrixResult
+		graphErr     error
+		matrixErr    error
+	)
+	weights := weightedMatrix(t, in.pair)
+	switch mode {
+	case "perfect":
+		graphResult, graphErr = matching.MinWeightPerfect(in.g)
+		matrixResult, matrixErr = matching.MinWeightPerfectMatrix(weights)
+	case "cardinality":
+		graphResult, graphErr = matching.MaxWeight(in.g, matching.WithMaxCardinality())
+		matrixResult, matrixErr = matching.MaxWeightMatrix(weights, matching.WithMaxCardinality())
+	default:
+		graphResult, graphErr = matching.MaxWeight(in.g)
+		matrixResult, matrixErr = matching.MaxWeightMatrix(weights)
+	}
+
+	wantSize, wantWeight := -1, math.NaN()
+	if brute {
+		wantSize, wantWeight = bruteForceWeighted(in.pair, mode)
+	}
+	if brute && math.IsNaN(wantWeight) {
+		if !errors.Is(graphErr, matching.ErrNoPerfectMatching) || !errors.Is(matrixErr, matching.ErrNoPerfectMatching) {
+			t.Fatalf("want ErrNoPerfectMatching, got %v and %v", graphErr, matrixErr)
+		}
+		return
+	}
+	if graphErr != nil || matrixErr != nil {
+		t.Fatalf("graph: %v, matrix: %v", graphErr, matrixErr)
+	}
+
+	index := make(map[string]int, len(in.ids))
+	for v, id := range in.ids {
+		index[id] = v
+	}
+	mate := make([]int, len(in.ids))
+	y := make([]float64, len(in.ids))
+	for v, id := range in.ids {
+		mate[v], y[v] = -1, graphResult.VertexDuals[id]
+		if other, ok := graphResult.Mate[id]; ok {
+			mate[v] = index[other]
+		}
+	}
+	blossoms := make([][]int, len(graphResult.Blossoms))
+	for b, members := range graphResult.Blossoms {
+		for _, id := range members {
+			blossoms[b] = append(blossoms[b], index[id])
+		}
+	}
+	for _, p := range graphResult.Pairs {
+		e, err := in.g.GetEdge(p.EdgeID)
+		if err != nil || index[p.U] >= index[p.V] || mate[index[p.U]] != index[p.V] ||
+			e.Weight != in.pair[index[p.U]][index[p.V]] {
+			t.Fatalf("pair %+v does not name its best edge (%v)", p, err)
+		}
+	}
+	size := len(graphResult.Pairs)
+
+	mustCertifiedWeighted(t, in.pair, mate, graphResult.Weight, y, blossoms, graphResult.BlossomDuals, mode)
+	mustCertifiedWeighted(t, in.pair, matrixResult.Mate, matrixResult.Weight, matrixResult.VertexDuals,
+		matrixResult.Blossoms, matrixResult.BlossomDuals, mode)
+	if fmt.Sprint(mate, y, blossoms, graphResult.BlossomDuals) !=
+		fmt.Sprint(matrixResult.Mate, matrixResult.VertexDuals, matrixResult.Blossoms, matrixResult.BlossomDuals) {
+		t.Fatalf("graph and matrix results differ:\n%v %v\n%v %v", mate, y, matrixResult.Mate, matrixResult.VertexDuals)
+	}
+	if brute && (graphResult.Weight != wantWeight || mode != "max" && size != wantSize) {
+		t.Fatalf("got %d pairs of weight %g, brute force %d of %g", size, graphResult.Weight, wantSize, wantWeight)
+	}
+}
+
+func TestWeighted_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(49))
+	for trial := 0; trial < 600; trial++ {
+		n := rng.Intn(11)
+		density := 0.2 + 0.8*rng.Float64()
+		for _, mode := range []string{"max", "cardinality", "perfect"} {
+			in := buildRandomWeighted(t, rng, n, density, mode == "perfect", true)
+			t.Run(fmt.Sprintf("%d/%s", trial, mode), func(t *testing.T) {
+				checkWeighted(t, in, mode, true)
+			})
+		}
+	}
+}
+
+func TestWeighted_CertificatesOnLargeGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(50))
+	for trial := 0; trial < 12; trial++ {
+		n := 30 + rng.Intn(40)
+		density := []float64{0.08, 0.3, 1}[trial%3]
+		for _, mode := range []string{"max", "cardinality", "perfect"} {
+			in := buildRandomWeighted(t, rng, n, density, mode == "perfect", trial%2 == 0)
+			t.Run(fmt.Sprintf("%d/%s", trial, mode), func(t *testing.T) {
+				if mode == "perfect" {
+					if _, err := matching.MinWeightPerfect(in.g); errors.Is(err, matching.ErrNoPerfectMatching) {
+						t.Skip("no perfect matching")
+					}
+				}
+				checkWeighted(t, in, mode, false)
+			})
+		}
+	}
+}
+
+// rawMatrix is a matrix.Matrix without any numeric policy, so that tests can
+// feed values matrix.Dense refuses to store.
+type rawMatrix [][]float64
+
+func (m rawMatrix) Rows() int                     { return len(m) }
+func (m rawMatrix) Cols() int                     { return len(m[0]) }
+func (m rawMatrix) At(i, j int) (float64, error)  { return m[i][j], nil }
+func (m rawMatrix) Set(i, j int, v float64) error { m[i][j] = v; return nil }
+func (m rawMatrix) Clone() matrix.Matrix          { return m }
+
+func TestMatching_Validation(t *testing.T) {
+	triangle, err := core.NewGraph()
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}} {
+		if _, err = triangle.AddEdge(e[0], e[1], 0); err != nil {
+			t.Fatalf("AddEdge: %v", err)
+		}
+	}
+	loop, err := core.NewGraph(core.WithLoops())
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	if _, err = loop.AddEdge("A", "A", 0); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+	square, err := matrix.NewZeros(2, 2)
+	if err != nil {
+		t.Fatalf("NewZeros: %v", err)
+	}
+	negInf := rawMatrix{{0, 1}, {math.Inf(-1), 0}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "NilGraph", err: matching.ErrNilGraph, run: func() error {
+			_, err := matching.HopcroftKarp(nil)
+			return err
+		}},
+		{name: "OddCycle", err: matching.ErrNotBipartite, run: func() error {
+			_, err := matching.HopcroftKarp(triangle)
+			return err
+		}},
+		{name: "Loop", err: matching.ErrNotBipartite, run: func() error {
+			_, err := matching.HopcroftKarp(loop)
+			return err
+		}},
+		{name: "EdgeInsideLeft", err: matching.ErrNotBipartite, run: func() error {
+			_, err := matching.HopcroftKarp(triangle, matching.WithLeft("A", "B"))
+			return err
+		}},
+		{name: "EmptyLeftID", err: matching.ErrEmptyVertexID, run: func() error {
+			_, err := matching.HopcroftKarp(triangle, matching.WithLeft(""))
+			return err
+		}},
+		{name: "DuplicateLeftID", err: matching.ErrDuplicateVertex, run: func() error {
+			_, err := matching.HopcroftKarp(triangle, matching.WithLeft("A", "A"))
+			return err
+		}},
+		{name: "UnknownLeftID", err: matching.ErrVertexNotFound, run: func() error {
+			_, err := matching.HopcroftKarp(triangle, matching.WithLeft("Z"))
+			return err
+		}},
+		{name: "NilOption", err: matching.ErrNilOption, run: func() error {
+			_, err := matching.Assignment(square, nil)
+			return err
+		}},
+		{name: "NilContext", err: matching.ErrNilContext, run: func() error {
+			_, err := matching.HopcroftKarp(triangle, matching.WithContext(nil)) //nolint:staticcheck // nil is the case under test
+			return err
+		}},
+		{name: "CanceledHopcroftKarp", err: context.Canceled, run: func() error {
+			_, err := matching.HopcroftKarp(loop, matching.WithContext(ctx))
+			return err
+		}},
+		{name: "CanceledAssignment", err: context.Canceled, run: func() error {
+			_, err := matching.Assignment(square, matching.WithContext(ctx))
+			return err
+		}},
+		{name: "NilMatrix", err: matching.ErrNilMatrix, run: func() error {
+			_, err := matching.Assignment(nil)
+			return err
+		}},
+		{name: "NegativeInfinity", err: matching.ErrInvalidCost, run: func() error {
+			_, err := matching.Assignment(negInf)
+			return err
+		}},
+		{name: "NaN", err: matching.ErrInvalidCost, run: func() error {
+			_, err := matching.Assignment(rawMatrix{{math.NaN()}})
+			return err
+		}},
+		{name: "CanceledMaxWeight", err: context.Canceled, run: func() error {
+			_, err := matching.MaxWeight(triangle, matching.WithContext(ctx))
+			return err
+		}},
+		{name: "OddPerfect", err: matching.ErrNoPerfectMatching, run: func() error {
+			_, err := matching.MinWeightPerfect(triangle)
+			return err
+		}},
+		{name: "NilWeightedGraph", err: matching.ErrNilGraph, run: func() error {
+			_, err := matching.MinWeightPerfect(nil)
+			return err
+		}},
+		{name: "NilWeightMatrix", err: matching.ErrNilMatrix, run: func() error {
+			_, err := matching.MaxWeightMatrix(nil)
+			return err
+		}},
+		{name: "NonSquareWeights", err: matching.ErrNonSquareMatrix, run: func() error {
+			_, err := matching.MaxWeightMatrix(rawMatrix{{0, 1}})
+			return err
+		}},
+		{name: "AsymmetricWeights", err: matching.ErrAsymmetricMatrix, run: func() error {
+			_, err := matching.MinWeightPerfectMatrix(rawMatrix{{0, 1}, {2, 0}})
+			return err
+		}},
+		{name: "NegativeInfinityWeight", err: matching.ErrInvalidCost, run: func() error {
+			_, err := matching.MaxWeightMatrix(negInf)
+			return err
+		}},
+		{name: "NoPerfectInMatrix", err: matching.ErrNoPerfectMatching, run: func() error {
+			_, err := matching.MinWeightPerfectMatrix(rawMatrix{{0, math.Inf(1)}, {math.Inf(1), 0}})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
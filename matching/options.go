@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+import "context"
+
+// Options holds the effective policy of one computation.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value is not valid.
+type Options struct {
+	// Left is the explicit left side of a bipartite graph; nil means the
+	// bipartition is detected by two-colouring. Assignment ignores it.
+	Left []string
+
+	// MaxCardinality makes MaxWeight and MaxWeightMatrix maximize weight only
+	// among matchings of maximum cardinality.
+	MaxCardinality bool
+
+	// ctx allows cancellation between phases and rows.
+	ctx context.Context
+}
+
+// Option configures a computation through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns the canonical policy: automatic bipartition and
+// context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{ctx: context.Background()}
+}
+
+// WithLeft fixes the left side of the bipartition; every other vertex is on
+// the right.
+//
+// Behavior highlights:
+//   - Useful when the sides carry meaning (jobs and workers) or when isolated
+//     vertices must stay on a given side.
+//   - The IDs are copied; an empty list puts every vertex on the right.
+//
+// Errors:
+//   - ErrEmptyVertexID, ErrDuplicateVertex.
+//   - ErrVertexNotFound is reported by the computation, which sees the graph.
+func WithLeft(ids ...string) Option {
+	return func(o *Options) error {
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			if id == "" {
+				return ErrEmptyVertexID
+			}
+			if seen[id] {
+				return ErrDuplicateVertex
+			}
+			seen[id] = true
+		}
+		o.Left = append(make([]string, 0, len(ids)), ids...)
+		return nil
+	}
+}
+
+// WithMaxCardinality makes MaxWeight and MaxWeightMatrix return the heaviest
+// matching among those with the largest number of pairs.
+//
+// Behavior highlights:
+//   - Without it, a pair is only taken when it raises the total weight.
+//   - Other computations ignore it.
+func WithMaxCardinality() Option {
+	return func(o *Options) error {
+		o.MaxCardinality = true
+		return nil
+	}
+}
+
+// WithContext sets a cancellation context.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package matching
+
+// Pair is one matched edge.
+//
+// Behavior highlights:
+//   - In bipartite results U is the left endpoint and V the right one; in
+//     weighted results U precedes V in core.Vertices() order.
+//   - EdgeID names the first core.Edges() edge joining U and V; weighted
+//     results name the heaviest (MaxWeight) or cheapest (MinWeightPerfect) one.
+type Pair struct {
+	U      string
+	V      string
+	EdgeID string
+}
+
+// BipartiteResult is a maximum-cardinality matching of a bipartite graph.
+//
+// Behavior highlights:
+//   - Size == len(Pairs) == len(Cover): by Konig's theorem a vertex cover of
+//     the same size as a matching proves that the matching is maximum.
+//   - Mate maps both endpoints of every pair to each other; unmatched vertices
+//     are absent.
+//
+// Determinism:
+//   - Left, Right, and Cover follow core.Vertices(); Pairs follow their U
+//     vertex in core.Vertices() order.
+//
+// AI-Hints:
+//   - Unmatched left vertices are jobs nobody can take; Cover names the
+//     bottleneck vertices that block a larger matching.
+type BipartiteResult struct {
+	Size  int
+	Pairs []Pair
+	Mate  map[string]string
+
+	Left  []string
+	Right []string
+	Cover []string
+}
+
+// AssignmentResult is a minimum-cost assignment of rows to columns.
+//
+// Behavior highlights:
+//   - Every row is assigned when Rows <= Cols, every column otherwise; the
+//     other side has unassigned entries marked -1.
+//   - Cost is the sum of the assigned entries.
+//   - RowPotentials u and ColPotentials v certify optimality: u[i] + v[j] <=
+//     cost(i, j) for every pair, with equality on assigned pairs, and the
+//     potentials of the larger side are <= 0 and zero where unassigned, so
+//     sum(u) + sum(v) == Cost.
+//
+// AI-Hints:
+//   - For maximum profit, negate the matrix and the resulting Cost.
+type AssignmentResult struct {
+	Cost     float64
+	RowToCol []int
+	ColToRow []int
+
+	RowPotentials []float64
+	ColPotentials []float64
+}
+
+// WeightedResult is an optimal weighted matching of a general graph.
+//
+// Behavior highlights:
+//   - Weight is the sum of the matched edge weights.
+//   - Mate maps both endpoints of every pair to each other; unmatched vertices
+//     are absent.
+//   - The duals certify optimality. For MaxWeight, with y = VertexDuals and
+//     z = BlossomDuals >= 0 on the odd vertex sets Blossoms:
+//     y(u) + y(v) + sum(z(B) over B containing u and v) >= weight(uv) on every
+//     edge, with equality on matched edges, and sum(y over matched vertices) +
+//     sum(z(B) * (|B|-1)/2) == Weight. Plain MaxWeight also has y >= 0 and
+//     y == 0 on unmatched vertices; WithMaxCardinality instead gives every
+//     unmatched vertex the smallest y.
+//   - For MinWeightPerfect the blossom terms flip sign: y(u) + y(v) -
+//     sum(z(B)) <= cost(uv), with equality on matched edges, and sum(y) -
+//     sum(z(B) * (|B|-1)/2) == Weight.
+//
+// Determinism:
+//   - Pairs follow their U vertex in core.Vertices() order; Blossoms are listed
+//     in creation order, each following core.Vertices().
+//
+// AI-Hints:
+//   - Checking the dual inequalities edge by edge verifies the result without
+//     trusting the solver.
+type WeightedResult struct {
+	Weight float64
+	Pairs  []Pair
+	Mate   map[string]string
+
+	VertexDuals  map[string]float64
+	Blossoms     [][]string
+	BlossomDuals []float64
+}
+
+// WeightedMatrixResult is an optimal weighted matching over a symmetric
+// matrix, indexed by row.
+//
+// Behavior highlights:
+//   - Mate[i] is the partner of i, or -1 when i is unmatched.
+//   - Weight and the duals follow the WeightedResult conventions.
+type WeightedMatrixResult struct {
+	Weight float64
+	Mate   []int
+
+	VertexDuals  []float64
+	Blossoms     [][]int
+	BlossomDuals []float64
+}
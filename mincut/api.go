@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut
+
+import (
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// MinCut computes a global minimum cut: the lightest set of edges whose removal
+// disconnects g.
+//
+// Implementation:
+//   - Stage 1: Validate the graph and assemble options.
+//   - Stage 2: A disconnected graph has a zero cut around the component of the
+//     first vertex.
+//   - Stage 3: Otherwise run the selected algorithm on the merged snapshot.
+//   - Stage 4: Publish the shores and crossing edges from the original graph.
+//
+// Behavior highlights:
+//   - Edge weights are capacities; parallel edges add up and loops are ignored.
+//   - Side always contains the first vertex of core.Vertices().
+//
+// Inputs:
+//   - g: an undirected weighted graph with at least two vertices.
+//   - opts: WithAlgorithm, WithSeed, WithTrials, WithContext.
+//
+// Returns:
+//   - *Cut: the minimum cut and its shores.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrTooFewVertices, ErrDirectedEdge,
+//     ErrInvalidWeight.
+//   - ErrNilOption, ErrUnsupportedAlgorithm, ErrBadTrials, ErrNilContext.
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Stoer-Wagner is deterministic; Karger-Stein is for equal seeds.
+//
+// Complexity:
+//   - Stoer-Wagner: Time O(V (V + E) log V), Space O(V + E).
+//   - Karger-Stein: Time O(T * V^2 log V) for T trials, Space O(V^2).
+//
+// AI-Hints:
+//   - Value is the edge connectivity of the network for unit weights: how many
+//     links must fail before it splits.
+//   - Prefer Stoer-Wagner; Karger-Stein suits very dense graphs or
+//     cross-checking.
+func MinCut(g *core.Graph, opts ...Option) (*Cut, error) {
+	s, err := newSnapshot(g)
+	if err != nil {
+		return nil, err
+	}
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = config.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if inSide := s.component(0); inSide != nil {
+		return s.cut(inSide), nil
+	}
+
+	var members []int
+	switch config.Algorithm {
+	case AlgorithmKargerStein:
+		_, members, err = runKargerStein(s, config)
+	default:
+		adj := make([]map[int]float64, len(s.ids))
+		groups := make([][]int, len(s.ids))
+		for v := range adj {
+			adj[v] = make(map[int]float64, len(s.adj[v]))
+			for u, w := range s.adj[v] {
+				adj[v][u] = w
+			}
+			groups[v] = []int{v}
+		}
+		_, members, err = stoerWagner(config.ctx, adj, groups)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	inSide := make([]bool, len(s.ids))
+	for _, v := range members {
+		inSide[v] = true
+	}
+	if !inSide[0] {
+		for v := range inSide {
+			inSide[v] = !inSide[v]
+		}
+	}
+
+	return s.cut(inSide), nil
+}
+
+// GomoryHu builds a Gomory-Hu tree of g, answering minimum-cut queries for
+// every vertex pair.
+//
+// Implementation:
+//   - Stage 1: Validate the graph and assemble options.
+//   - Stage 2: Run Gusfield's method: V-1 flow.MaxFlow calls on g.
+//
+// Behavior highlights:
+//   - Pair queries then cost O(V) with no further max flow.
+//   - Disconnected vertex pairs are joined by zero-weight tree edges.
+//
+// Inputs:
+//   - g: an undirected weighted graph with at least two vertices.
+//   - opts: WithContext; algorithm options are ignored.
+//
+// Returns:
+//   - *Tree: the cut tree; see Tree.Graph, Tree.MinCut, Tree.MinCutValue.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrTooFewVertices, ErrDirectedEdge,
+//     ErrInvalidWeight, ErrNilOption, ErrNilContext.
+//   - flow.MaxFlow errors, including ctx.Err().
+//
+// Determinism:
+//   - Equal graphs give equal trees.
+//
+// Complexity:
+//   - V-1 max flows with flow's default Dinic kernel, plus O(V^2).
+//
+// AI-Hints:
+//   - Use it for all-pairs network reliability: the weakest link between any
+//     two sites is one tree lookup.
+func GomoryHu(g *core.Graph, opts ...Option) (*Tree, error) {
+	s, err := newSnapshot(g)
+	if err != nil {
+		return nil, err
+	}
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return runGomoryHu(g, s, config)
+}
+
+// Graph returns the tree as an undirected weighted core.Graph; the weight of a
+// tree edge is the minimum cut value between its endpoints.
+//
+// Returns:
+//   - A caller-owned copy; nil for a nil tree.
+//
+// Complexity:
+//   - Time O(V), Space O(V).
+func (t *Tree) Graph() *core.Graph {
+	if t == nil {
+		return nil
+	}
+
+	return t.graph.Clone()
+}
+
+// MinCutValue returns the minimum cut value between u and v: the lightest edge
+// on their tree path.
+//
+// Errors:
+//   - ErrNilTree, ErrEmptyVertexID, ErrVertexNotFound, ErrSameVertex.
+//
+// Complexity:
+//   - Time O(V), Space O(V).
+func (t *Tree) MinCutValue(u, v string) (float64, error) {
+	a, b, err := t.pair(u, v)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.weight[t.lightestEdge(a, b)], nil
+}
+
+// MinCut returns a minimum cut separating u from v.
+//
+// Implementation:
+//   - Stage 1: Find the lightest tree edge on the u-v path.
+//   - Stage 2: Removing it splits the tree; u's part is Side.
+//
+// Errors:
+//   - ErrNilTree, ErrEmptyVertexID, ErrVertexNotFound, ErrSameVertex.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V).
+func (t *Tree) MinCut(u, v string) (*Cut, error) {
+	a, b, err := t.pair(u, v)
+	if err != nil {
+		return nil, err
+	}
+
+	below := t.subtree(t.lightestEdge(a, b))
+	if !below[a] {
+		for w := range below {
+			below[w] = !below[w]
+		}
+	}
+
+	return t.snap.cut(below), nil
+}
+
+// pair validates a query pair and returns its indices.
+func (t *Tree) pair(u, v string) (int, int, error) {
+	if t == nil {
+		return 0, 0, ErrNilTree
+	}
+	if u == "" || v == "" {
+		return 0, 0, ErrEmptyVertexID
+	}
+	a, ok := t.snap.index[u]
+	if !ok {
+		return 0, 0, fmt.Errorf("mincut: vertex %q: %w", u, ErrVertexNotFound)
+	}
+	b, ok := t.snap.index[v]
+	if !ok {
+		return 0, 0, fmt.Errorf("mincut: vertex %q: %w", v, ErrVertexNotFound)
+	}
+	if a == b {
+		return 0, 0, ErrSameVertex
+	}
+
+	return a, b, nil
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package mincut computes global minimum cuts and Gomory-Hu trees of
+// undirected weighted core.Graph values, for network reliability questions
+// that a single s-t cut cannot answer.
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - MinCut(g, opts...)
+//     The lightest set of edges whose removal disconnects g, with both shores
+//     and the crossing edge IDs. Stoer-Wagner (exact, default) or Karger-Stein
+//     (randomized, seeded).
+//
+//   - GomoryHu(g, opts...)
+//     A weighted tree on the vertices of g in which the lightest edge on the
+//     u-v path equals the u-v minimum cut, for every pair. Tree.Graph() returns
+//     it as a core.Graph; Tree.MinCutValue and Tree.MinCut answer pair queries.
+//
+// -----------------------------------------------------------------------------
+// -- WHY ----------------------------------------------------------------------
+//
+// flow.MaxFlow answers "how many links separate A from B?" for one pair. The
+// weakest point of the whole network is the minimum over all pairs, and
+// V(V-1)/2 max-flow runs are wasteful: Stoer-Wagner needs no flows at all,
+// and a Gomory-Hu tree answers every pair after only V-1 flows.
+//
+// -----------------------------------------------------------------------------
+// -- HOW ----------------------------------------------------------------------
+//
+//   - Stoer-Wagner: each phase grows a maximum-adjacency order; the last vertex
+//     added is separated from the rest by a cut of weight equal to its key.
+//     Merging the last two vertices and repeating V-1 times visits a minimum cut.
+//   - Karger-Stein: contract random edges (weight-proportional) down to
+//     n/sqrt(2) vertices twice, recurse on both, keep the lighter cut; repeat
+//     for WithTrials runs. Small graphs are finished exactly by Stoer-Wagner.
+//   - Gomory-Hu: Gusfield's method; vertex u gets parent p, one flow.MaxFlow
+//     run between u and p gives the tree weight, and later vertices on u's
+//     shore are re-parented to u. No graph contraction is needed.
+//
+// Options:
+//
+//   - WithAlgorithm(AlgorithmStoerWagner | AlgorithmKargerStein), WithSeed(seed),
+//     WithTrials(n), WithContext(ctx)
+//
+// Errors:
+//
+//   - ErrNilGraph, ErrUnweightedGraph, ErrDirectedEdge, ErrInvalidWeight,
+//     ErrTooFewVertices
+//   - ErrNilOption, ErrUnsupportedAlgorithm, ErrBadTrials, ErrNilContext
+//   - ErrNilTree, ErrEmptyVertexID, ErrVertexNotFound, ErrSameVertex from
+//     Tree queries
+//   - ctx.Err() on cancellation
+//
+// Complexity:
+//
+//   - Stoer-Wagner: O(V (V + E) log V) time, O(V + E) space.
+//   - Karger-Stein: O(V^2 log V) per trial; the default ceil(log2 V)^2 trials
+//     fail with probability polynomially small in V.
+//   - GomoryHu: V-1 flow.MaxFlow runs; each tree query is O(V).
+//
+// AI-Hints:
+//   - Loops and zero-weight edges never cross a cut and are ignored; parallel
+//     edges add up.
+//   - A disconnected graph has a minimum cut of 0: one component against the rest.
+//   - The global minimum cut equals the lightest Gomory-Hu tree edge; build the
+//     tree only when pair values are needed too.
+package mincut
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	ErrNilGraph = errors.New("mincut: graph is nil")
+
+	// ErrUnweightedGraph reports that the graph does not expose weighted edges.
+	//
+	// AI-Hints:
+	//   - Weights are capacities; build the graph with core.WithWeighted().
+	ErrUnweightedGraph = errors.New("mincut: graph must be weighted")
+
+	// ErrDirectedEdge reports a directed edge; cuts here are undirected.
+	//
+	// AI-Hints:
+	//   - For s-t cuts of directed networks use flow.MaxFlow.
+	ErrDirectedEdge = errors.New("mincut: graph must not contain directed edges")
+
+	// ErrInvalidWeight reports a negative, NaN, or infinite edge weight.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending edge ID.
+	ErrInvalidWeight = errors.New("mincut: edge weight must be finite and non-negative")
+
+	// ErrTooFewVertices reports a graph with fewer than two vertices, which has
+	// no cut.
+	ErrTooFewVertices = errors.New("mincut: graph needs at least two vertices")
+
+	// ErrNilOption reports that a nil Option was passed.
+	ErrNilOption = errors.New("mincut: option is nil")
+
+	// ErrUnsupportedAlgorithm reports an unknown Algorithm value.
+	ErrUnsupportedAlgorithm = errors.New("mincut: unsupported algorithm")
+
+	// ErrBadTrials reports a Karger-Stein trial count below one.
+	ErrBadTrials = errors.New("mincut: trial count must be >= 1")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	ErrNilContext = errors.New("mincut: context is nil")
+
+	// ErrNilTree reports a method call on a nil *Tree.
+	ErrNilTree = errors.New("mincut: tree is nil")
+
+	// ErrEmptyVertexID reports that the caller passed an empty vertex ID.
+	ErrEmptyVertexID = errors.New("mincut: vertex id is empty")
+
+	// ErrVertexNotFound reports a vertex that was not part of the graph when the
+	// tree was built.
+	ErrVertexNotFound = errors.New("mincut: vertex not found")
+
+	// ErrSameVertex reports a pair query with identical endpoints.
+	ErrSameVertex = errors.New("mincut: vertices must be distinct")
+)
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut_test
+
+import (
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/mincut"
+)
+
+// ExampleMinCut finds the weakest point of two data-centre rings joined by
+// two long-haul links, then asks the Gomory-Hu tree about individual pairs.
+func ExampleMinCut() {
+	graph, _ := core.NewGraph(core.WithWeighted())
+	// Gbps per link: each ring is well meshed, the long-haul links are thin.
+	for _, link := range []struct {
+		from, to string
+		gbps     float64
+	}{
+		{"AMS", "FRA", 40}, {"FRA", "PAR", 40}, {"PAR", "AMS", 40},
+		{"NYC", "CHI", 40}, {"CHI", "DAL", 40}, {"DAL", "NYC", 40},
+		{"AMS", "NYC", 10}, {"PAR", "DAL", 15},
+	} {
+		_, _ = graph.AddEdge(link.from, link.to, link.gbps)
+	}
+
+	cut, _ := mincut.MinCut(graph)
+	fmt.Println("weakest cut:", cut.Value, cut.Side, "|", cut.Other)
+
+	tree, _ := mincut.GomoryHu(graph)
+	local, _ := tree.MinCutValue("AMS", "FRA")
+	remote, _ := tree.MinCutValue("FRA", "CHI")
+	fmt.Println("AMS-FRA:", local, "FRA-CHI:", remote)
+
+	// Output:
+	// weakest cut: 25 [AMS FRA PAR] | [CHI DAL NYC]
+	// AMS-FRA: 80 FRA-CHI: 25
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// snapshot is the index-based view of an undirected capacity graph.
+//
+// AI-Hints:
+//   - adj[u][v] is the total weight of the non-loop edges joining u and v;
+//     parallel edges are merged and zero-weight pairs are absent.
+//   - edges keeps the original edges for publishing crossing edge IDs.
+type snapshot struct {
+	ids   []string
+	index map[string]int
+	adj   []map[int]float64
+	edges []*core.Edge
+}
+
+// newSnapshot validates g and builds its snapshot.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrTooFewVertices, ErrDirectedEdge,
+//     ErrInvalidWeight.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V + E).
+func newSnapshot(g *core.Graph) (*snapshot, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if !g.Weighted() {
+		return nil, ErrUnweightedGraph
+	}
+
+	ids := g.Vertices()
+	if len(ids) < 2 {
+		return nil, ErrTooFewVertices
+	}
+	s := &snapshot{
+		ids:   ids,
+		index: make(map[string]int, len(ids)),
+		adj:   make([]map[int]float64, len(ids)),
+		edges: g.Edges(),
+	}
+	for position, vertexID := range ids {
+		s.index[vertexID] = position
+		s.adj[position] = make(map[int]float64)
+	}
+
+	for _, edge := range s.edges {
+		if edge.Directed {
+			return nil, fmt.Errorf("mincut: edge %q: %w", edge.ID, ErrDirectedEdge)
+		}
+		if math.IsNaN(edge.Weight) || math.IsInf(edge.Weight, 0) || edge.Weight < 0 {
+			return nil, fmt.Errorf("mincut: edge %q has weight %v: %w", edge.ID, edge.Weight, ErrInvalidWeight)
+		}
+		if edge.From == edge.To || edge.Weight == 0 {
+			continue
+		}
+		u, v := s.index[edge.From], s.index[edge.To]
+		s.adj[u][v] += edge.Weight
+		s.adj[v][u] += edge.Weight
+	}
+
+	return s, nil
+}
+
+// cut publishes the partition given by inSide as a Cut.
+//
+// Implementation:
+//   - Stage 1: Split the vertices by inSide, in snapshot order.
+//   - Stage 2: Sum the crossing edges in core.Edges() order.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V).
+func (s *snapshot) cut(inSide []bool) *Cut {
+	c := &Cut{}
+	for position, vertexID := range s.ids {
+		if inSide[position] {
+			c.Side = append(c.Side, vertexID)
+		} else {
+			c.Other = append(c.Other, vertexID)
+		}
+	}
+	for _, edge := range s.edges {
+		if edge.Weight == 0 || inSide[s.index[edge.From]] == inSide[s.index[edge.To]] {
+			continue
+		}
+		c.Value += edge.Weight
+		c.Edges = append(c.Edges, edge.ID)
+	}
+
+	return c
+}
+
+// component marks the vertices reachable from start, or returns nil when
+// start reaches every vertex, that is, when the graph is connected.
+//
+// Complexity:
+//   - Time O(V + E), Space O(V).
+func (s *snapshot) component(start int) []bool {
+	seen := make([]bool, len(s.ids))
+	seen[start] = true
+	stack, count := []int{start}, 1
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for v := range s.adj[u] {
+			if !seen[v] {
+				seen[v] = true
+				count++
+				stack = append(stack, v)
+			}
+		}
+	}
+	if count == len(s.ids) {
+		return nil
+	}
+
+	return seen
+}
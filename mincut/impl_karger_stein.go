@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut
+
+import (
+	"context"
+	"math"
+	"math/bits"
+	"math/rand"
+	"sort"
+)
+
+// ksBaseSize is the vertex count below which Karger-Stein stops contracting
+// and solves exactly with Stoer-Wagner.
+const ksBaseSize = 6
+
+// ksEdge is one merged edge of a contracted graph, with u < v.
+type ksEdge struct {
+	u, v int
+	w    float64
+}
+
+// ksGraph is a contracted multigraph: groups[i] lists the original vertices
+// merged into vertex i.
+type ksGraph struct {
+	groups [][]int
+	edges  []ksEdge
+}
+
+// kargerStein carries the shared state of one Karger-Stein run.
+type kargerStein struct {
+	ctx context.Context
+	rng *rand.Rand
+}
+
+// defaultTrials returns ceil(log2 n)^2, at least 1.
+func defaultTrials(n int) int {
+	log := bits.Len(uint(n - 1))
+
+	return max(1, log*log)
+}
+
+// runKargerStein computes a minimum cut of a connected snapshot with high
+// probability.
+//
+// Implementation:
+//   - Stage 1: Build the uncontracted ksGraph.
+//   - Stage 2: Run Trials independent recursive contractions from one seeded
+//     generator and keep the lightest cut.
+//
+// Errors:
+//   - ctx.Err() between recursion steps.
+//
+// Determinism:
+//   - Equal snapshots, seeds, and trial counts give equal cuts.
+//
+// Complexity:
+//   - Time O(T * V^2 log V) for T trials, Space O(V^2).
+func runKargerStein(s *snapshot, config Options) (float64, []int, error) {
+	n := len(s.ids)
+	h := ksGraph{groups: make([][]int, n)}
+	for u := 0; u < n; u++ {
+		h.groups[u] = []int{u}
+		for v, w := range s.adj[u] {
+			if u < v {
+				h.edges = append(h.edges, ksEdge{u: u, v: v, w: w})
+			}
+		}
+	}
+	sortEdges(h.edges)
+
+	trials := config.Trials
+	if trials == 0 {
+		trials = defaultTrials(n)
+	}
+
+	k := &kargerStein{ctx: config.ctx, rng: rand.New(rand.NewSource(config.Seed))}
+	best := math.Inf(1)
+	var side []int
+	for trial := 0; trial < trials; trial++ {
+		value, members, err := k.solve(h)
+		if err != nil {
+			return 0, nil, err
+		}
+		if value < best {
+			best, side = value, members
+		}
+	}
+
+	return best, side, nil
+}
+
+// solve runs one recursive contraction on h.
+//
+// Implementation:
+//   - Stage 1: Small graphs are solved exactly by Stoer-Wagner.
+//   - Stage 2: Otherwise contract twice, independently, to ceil(1 + n/sqrt2)
+//     vertices and recurse on both; a fixed minimum cut survives one
+//     contraction with probability at least 1/2.
+//
+// Complexity:
+//   - Time O(n^2 log n), Space O(n^2) for an n-vertex h.
+func (k *kargerStein) solve(h ksGraph) (float64, []int, error) {
+	if err := k.ctx.Err(); err != nil {
+		return 0, nil, err
+	}
+
+	n := len(h.groups)
+	if n <= ksBaseSize {
+		adj := make([]map[int]float64, n)
+		groups := make([][]int, n)
+		for v := range adj {
+			adj[v] = make(map[int]float64)
+			groups[v] = append([]int(nil), h.groups[v]...)
+		}
+		for _, e := range h.edges {
+			adj[e.u][e.v] += e.w
+			adj[e.v][e.u] += e.w
+		}
+
+		return stoerWagner(k.ctx, adj, groups)
+	}
+
+	target := int(math.Ceil(1 + float64(n)/math.Sqrt2))
+	best := math.Inf(1)
+	var side []int
+	for branch := 0; branch < 2; branch++ {
+		value, members, err := k.solve(k.contract(h, target))
+		if err != nil {
+			return 0, nil, err
+		}
+		if value < best {
+			best, side = value, members
+		}
+	}
+
+	return best, side, nil
+}
+
+// contract merges random edges of h until target vertices remain.
+//
+// Implementation:
+//   - Stage 1: Give every edge an exponential clock with rate equal to its
+//     weight; contracting in clock order picks each next edge with probability
+//     proportional to its weight, as weighted Karger contraction requires.
+//   - Stage 2: Union endpoints in clock order until target components remain.
+//   - Stage 3: Relabel components and merge the surviving edges.
+//
+// Notes:
+//   - h must be connected, which contraction preserves, so target is reached.
+//
+// Complexity:
+//   - Time O(m log m) for m edges of h, Space O(n + m).
+func (k *kargerStein) contract(h ksGraph, target int) ksGraph {
+	n := len(h.groups)
+	clock := make([]float64, len(h.edges))
+	order := make([]int, len(h.edges))
+	for i, e := range h.edges {
+		clock[i] = k.rng.ExpFloat64() / e.w
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return clock[order[a]] < clock[order[b]] })
+
+	d := newDSU(n)
+	components := n
+	for _, i := range order {
+		if components <= target {
+			break
+		}
+		if d.union(h.edges[i].u, h.edges[i].v) {
+			components--
+		}
+	}
+
+	label := make([]int, n)
+	for v := range label {
+		label[v] = -1
+	}
+	out := ksGraph{}
+	for v := 0; v < n; v++ {
+		root := d.find(v)
+		if label[root] < 0 {
+			label[root] = len(out.groups)
+			out.groups = append(out.groups, nil)
+		}
+		out.groups[label[root]] = append(out.groups[label[root]], h.groups[v]...)
+	}
+
+	merged := make(map[[2]int]float64)
+	for _, e := range h.edges {
+		a, b := label[d.find(e.u)], label[d.find(e.v)]
+		if a == b {
+			continue
+		}
+		if a > b {
+			a, b = b, a
+		}
+		merged[[2]int{a, b}] += e.w
+	}
+	for pair, w := range merged {
+		out.edges = append(out.edges, ksEdge{u: pair[0], v: pair[1], w: w})
+	}
+	sortEdges(out.edges)
+
+	return out
+}
+
+// sortEdges orders edges by (u, v) so that clocks are drawn deterministically.
+func sortEdges(edges []ksEdge) {
+	sort.Slice(edges, func(a, b int) bool {
+		if edges[a].u != edges[b].u {
+			return edges[a].u < edges[b].u
+		}
+		return edges[a].v < edges[b].v
+	})
+}
+
+// dsu is a union-find structure with path halving and union by size.
+type dsu struct {
+	parent []int
+	size   []int
+}
+
+// newDSU returns n singleton sets.
+func newDSU(n int) *dsu {
+	d := &dsu{parent: make([]int, n), size: make([]int, n)}
+	for v := range d.parent {
+		d.parent[v], d.size[v] = v, 1
+	}
+
+	return d
+}
+
+// find returns the representative of v.
+func (d *dsu) find(v int) int {
+	for d.parent[v] != v {
+		d.parent[v] = d.parent[d.parent[v]]
+		v = d.parent[v]
+	}
+
+	return v
+}
+
+// union merges the sets of u and v and reports whether they were distinct.
+func (d *dsu) union(u, v int) bool {
+	u, v = d.find(u), d.find(v)
+	if u == v {
+		return false
+	}
+	if d.size[u] < d.size[v] {
+		u, v = v, u
+	}
+	d.parent[v] = u
+	d.size[u] += d.size[v]
+
+	return true
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut
+
+import (
+	"container/heap"
+	"context"
+	"math"
+)
+
+// swItem is one lazy max-heap entry: a vertex and its key when pushed.
+type swItem struct {
+	key    float64
+	vertex int
+}
+
+// swQueue is a max-heap of swItem ordered by (key desc, vertex asc).
+type swQueue []swItem
+
+func (q swQueue) Len() int { return len(q) }
+
+func (q swQueue) Less(i, j int) bool {
+	if q[i].key != q[j].key {
+		return q[i].key > q[j].key
+	}
+
+	return q[i].vertex < q[j].vertex
+}
+
+func (q swQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *swQueue) Push(x any) { *q = append(*q, x.(swItem)) }
+
+func (q *swQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return item
+}
+
+// stoerWagner computes a global minimum cut of a connected weighted graph.
+//
+// Implementation:
+//   - Stage 1: A phase grows a set A from an arbitrary vertex, always adding
+//     the vertex most tightly connected to A (maximum adjacency order).
+//   - Stage 2: The weight joining the last vertex to the rest is a minimum cut
+//     separating the last two vertices; keep the lightest one seen.
+//   - Stage 3: Merge the last two vertices and repeat until one vertex remains.
+//
+// Inputs:
+//   - adj: merged adjacency of the current vertices; consumed by merging.
+//   - groups: original vertices behind each current vertex; consumed.
+//
+// Returns:
+//   - The minimum cut value and the original vertices of one shore.
+//
+// Errors:
+//   - ctx.Err() between phases.
+//
+// Determinism:
+//   - Heap ties are broken by vertex index, and every key is accumulated in
+//     extraction order, so map iteration order cannot change the result.
+//
+// Complexity:
+//   - Time O(V (V + E) log V), Space O(V + E).
+func stoerWagner(ctx context.Context, adj []map[int]float64, groups [][]int) (float64, []int, error) {
+	n := len(adj)
+	active := make([]bool, n)
+	for v := range active {
+		active[v] = true
+	}
+	key := make([]float64, n)
+	inA := make([]bool, n)
+
+	best := math.Inf(1)
+	var side []int
+	for remaining := n; remaining > 1; remaining-- {
+		if err := ctx.Err(); err != nil {
+			return 0, nil, err
+		}
+
+		q := make(swQueue, 0, remaining)
+		for v := 0; v < n; v++ {
+			if active[v] {
+				key[v], inA[v] = 0, false
+				q = append(q, swItem{vertex: v})
+			}
+		}
+		heap.Init(&q)
+
+		prev, last := -1, -1
+		for q.Len() > 0 {
+			item := heap.Pop(&q).(swItem)
+			u := item.vertex
+			if inA[u] || item.key != key[u] {
+				continue // stale entry
+			}
+			inA[u] = true
+			prev, last = last, u
+			for v, w := range adj[u] {
+				if !inA[v] {
+					key[v] += w
+					heap.Push(&q, swItem{key: key[v], vertex: v})
+				}
+			}
+		}
+
+		if key[last] < best {
+			best = key[last]
+			side = append([]int(nil), groups[last]...)
+		}
+
+		// Merge last into prev.
+		groups[prev] = append(groups[prev], groups[last]...)
+		groups[last] = nil
+		for x, w := range adj[last] {
+			delete(adj[x], last)
+			if x == prev {
+				continue
+			}
+			adj[prev][x] += w
+			adj[x][prev] += w
+		}
+		adj[last] = nil
+		active[last] = false
+	}
+
+	return best, side, nil
+}
@@ -0,0 +1,355 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/flow"
+	"github.com/katalvlaran/lvlath/internal/testutil"
+	"github.com/katalvlaran/lvlath/mincut"
+)
+
+// AI-HINTS (file):
+//   - Global cuts are checked against exhaustive enumeration of all shores,
+//     pair cuts against flow.MaxFlow.
+//   - Every published Cut is recomputed from the graph: shores, crossing edges,
+//     and value must agree.
+//   - Integer weights keep every comparison exact.
+
+// buildRandomGraph constructs a reproducible undirected weighted multigraph,
+// occasionally with loops and zero weights.
+func buildRandomGraph(t *testing.T, rng *rand.Rand, vertexCount, edgeCount int) *core.Graph {
+	t.Helper()
+
+	return testutil.RandomGraph(t, rng, testutil.Spec{
+		Vertices: vertexCount,
+		Edges:    edgeCount,
+		Mode:     testutil.Undirected,
+		Loops:    true,
+	})
+}
+
+// bruteForceMinCut enumerates every shore containing the first vertex.
+func bruteForceMinCut(g *core.Graph) float64 {
+	ids := g.Vertices()
+	index := make(map[string]int, len(ids))
+	for position, vertexID := range ids {
+		index[vertexID] = position
+	}
+
+	best := math.Inf(1)
+	for mask := 1; mask < 1<<len(ids)-1; mask += 2 {
+		value := 0.0
+		for _, edge := range g.Edges() {
+			if (mask>>index[edge.From])&1 != (mask>>index[edge.To])&1 {
+				value += edge.Weight
+			}
+		}
+		best = math.Min(best, value)
+	}
+
+	return best
+}
+
+// mustConsistentCut recomputes cut from g.
+func mustConsistentCut(t *testing.T, g *core.Graph, cut *mincut.Cut) {
+	t.Helper()
+
+	side := make(map[string]bool)
+	for _, vertexID := range cut.Side {
+		side[vertexID] = true
+	}
+	if len(cut.Side) == 0 || len(cut.Other) == 0 || len(cut.Side)+len(cut.Other) != len(g.Vertices()) {
+		t.Fatalf("shores %v | %v do not partition the vertices", cut.Side, cut.Other)
+	}
+	for _, vertexID := range cut.Other {
+		if side[vertexID] {
+			t.Fatalf("vertex %s is on both shores", vertexID)
+		}
+	}
+
+	value := 0.0
+	var crossing []string
+	for _, edge := range g.Edges() {
+		if edge.Weight > 0 && side[edge.From] != side[edge.To] {
+			value += edge.Weight
+			crossing = append(crossing, edge.ID)
+		}
+	}
+	if value != cut.Value || fmt.Sprint(crossing) != fmt.Sprint(cut.Edges) {
+		t.Fatalf("cut reports %g over %v, graph says %g over %v", cut.Value, cut.Edges, value, crossing)
+	}
+}
+
+func TestMinCut_MatchesBruteForce(t *testing.T) {
+	algorithms := []mincut.Algorithm{mincut.AlgorithmStoerWagner, mincut.AlgorithmKargerStein}
+	rng := rand.New(rand.NewSource(46))
+	for trial := 0; trial < 150; trial++ {
+		vertices := 2 + rng.Intn(10)
+		g := buildRandomGraph(t, rng, vertices, rng.Intn(3*vertices+1))
+		want := bruteForceMinCut(g)
+
+		for _, algorithm := range algorithms {
+			cut, err := mincut.MinCut(g, mincut.WithAlgorithm(algorithm), mincut.WithSeed(int64(trial)))
+			if err != nil {
+				t.Fatalf("trial %d %s: %v", trial, algorithm, err)
+			}
+			if cut.Value != want {
+				t.Fatalf("trial %d %s: value %g, brute force %g", trial, algorithm, cut.Value, want)
+			}
+			if cut.Side[0] != g.Vertices()[0] {
+				t.Fatalf("trial %d %s: Side %v misses the first vertex", trial, algorithm, cut.Side)
+			}
+			mustConsistentCut(t, g, cut)
+		}
+	}
+}
+
+func TestMinCut_KargerStein_SeedIsReproducible(t *testing.T) {
+	rng := rand.New(rand.NewSource(47))
+	g := buildRandomGraph(t, rng, 40, 200)
+
+	first, err := mincut.MinCut(g, mincut.WithAlgorithm(mincut.AlgorithmKargerStein), mincut.WithSeed(7), mincut.WithTrials(3))
+	if err != nil {
+		t.Fatalf("MinCut: %v", err)
+	}
+	second, err := mincut.MinCut(g, mincut.WithAlgorithm(mincut.AlgorithmKargerStein), mincut.WithSeed(7), mincut.WithTrials(3))
+	if err != nil {
+		t.Fatalf("MinCut: %v", err)
+	}
+	if fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Fatalf("equal seeds gave %v and %v", first, second)
+	}
+
+	exact, err := mincut.MinCut(g)
+	if err != nil {
+		t.Fatalf("MinCut: %v", err)
+	}
+	auto, err := mincut.MinCut(g, mincut.WithAlgorithm(mincut.AlgorithmKargerStein))
+	if err != nil {
+		t.Fatalf("MinCut: %v", err)
+	}
+	if auto.Value != exact.Value {
+		t.Fatalf("Karger-Stein %g, Stoer-Wagner %g", auto.Value, exact.Value)
+	}
+}
+
+func TestMinCut_KargerStein_ContractsByWeight(t *testing.T) {
+	// Two heavy rings joined by a light complete bipartite bridge: most edges
+	// cross the bridge, so uniform contraction would merge across it almost
+	// always, weighted contraction almost never.
+	g, err := core.NewGraph(core.WithWeighted())
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		mustAdd(t, g, fmt.Sprintf("a%d", i), fmt.Sprintf("a%d", (i+1)%10), 100)
+		mustAdd(t, g, fmt.Sprintf("b%d", i), fmt.Sprintf("b%d", (i+1)%10), 100)
+		for j := 0; j < 10; j++ {
+			mustAdd(t, g, fmt.Sprintf("a%d", i), fmt.Sprintf("b%d", j), 0.25)
+		}
+	}
+
+	for seed := int64(0); seed < 10; seed++ {
+		cut, err := mincut.MinCut(g, mincut.WithAlgorithm(mincut.AlgorithmKargerStein), mincut.WithSeed(seed), mincut.WithTrials(1))
+		if err != nil {
+			t.Fatalf("MinCut: %v", err)
+		}
+		if cut.Value != 25 || len(cut.Side) != 10 {
+			t.Fatalf("seed %d: got %g over %v, want the 25 bridge", seed, cut.Value, cut.Side)
+		}
+	}
+}
+
+// mustAdd adds a weighted edge or fails the test.
+func mustAdd(t *testing.T, g *core.Graph, from, to string, weight float64) {
+	t.Helper()
+
+	if _, err := g.AddEdge(from, to, weight); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+}
+
+func TestGomoryHu_PairCutsMatchMaxFlow(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	for trial := 0; trial < 40; trial++ {
+		vertices := 2 + rng.Intn(9)
+		g := buildRandomGraph(t, rng, vertices, rng.Intn(3*vertices+1))
+
+		tree, err := mincut.GomoryHu(g)
+		if err != nil {
+			t.Fatalf("trial %d: %v", trial, err)
+		}
+		treeGraph := tree.Graph()
+		if len(treeGraph.Vertices()) != vertices || len(treeGraph.Edges()) != vertices-1 {
+			t.Fatalf("trial %d: tree has %d vertices and %d edges", trial, len(treeGraph.Vertices()), len(treeGraph.Edges()))
+		}
+
+		lightest := math.Inf(1)
+		for _, edge := range treeGraph.Edges() {
+			lightest = math.Min(lightest, edge.Weight)
+		}
+		if global := bruteForceMinCut(g); lightest != global {
+			t.Fatalf("trial %d: lightest tree edge %g, global min cut %g", trial, lightest, global)
+		}
+
+		ids := g.Vertices()
+		for i := range ids {
+			for j := range ids {
+				if i == j {
+					continue
+				}
+				want, err := flow.MaxFlow(g, ids[i], ids[j])
+				if err != nil {
+					t.Fatalf("MaxFlow: %v", err)
+				}
+				value, err := tree.MinCutValue(ids[i], ids[j])
+				if err != nil {
+					t.Fatalf("MinCutValue: %v", err)
+				}
+				cut, err := tree.MinCut(ids[i], ids[j])
+				if err != nil {
+					t.Fatalf("MinCut: %v", err)
+				}
+				if value != want.Value || cut.Value != want.Value {
+					t.Fatalf("trial %d %s-%s: tree %g, cut %g, max flow %g", trial, ids[i], ids[j], value, cut.Value, want.Value)
+				}
+				if !contains(cut.Side, ids[i]) || contains(cut.Side, ids[j]) {
+					t.Fatalf("trial %d: cut %v does not separate %s from %s", trial, cut.Side, ids[i], ids[j])
+				}
+				mustConsistentCut(t, g, cut)
+			}
+		}
+	}
+}
+
+// contains reports whether values holds value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestMinCut_Validation(t *testing.T) {
+	weighted := func(opts ...core.GraphOption) *core.Graph {
+		g, err := core.NewGraph(append([]core.GraphOption{core.WithWeighted()}, opts...)...)
+		if err != nil {
+			t.Fatalf("NewGraph: %v", err)
+		}
+		return g
+	}
+	ok := weighted()
+	if _, err := ok.AddEdge("A", "B", 1); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+	single := weighted()
+	if err := single.AddVertex("A"); err != nil {
+		t.Fatalf("AddVertex: %v", err)
+	}
+	directed := weighted(core.WithDirected(true))
+	if _, err := directed.AddEdge("A", "B", 1); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+	negative := weighted()
+	if _, err := negative.AddEdge("A", "B", -1); err != nil {
+		t.Fatalf("AddEdge: %v", err)
+	}
+	unweighted, err := core.NewGraph()
+	if err != nil {
+		t.Fatalf("NewGraph: %v", err)
+	}
+	tree, err := mincut.GomoryHu(ok)
+	if err != nil {
+		t.Fatalf("GomoryHu: %v", err)
+	}
+	var nilTree *mincut.Tree
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "NilGraph", err: mincut.ErrNilGraph, run: func() error {
+			_, err := mincut.MinCut(nil)
+			return err
+		}},
+		{name: "Unweighted", err: mincut.ErrUnweightedGraph, run: func() error {
+			_, err := mincut.GomoryHu(unweighted)
+			return err
+		}},
+		{name: "TooFewVertices", err: mincut.ErrTooFewVertices, run: func() error {
+			_, err := mincut.MinCut(single)
+			return err
+		}},
+		{name: "Directed", err: mincut.ErrDirectedEdge, run: func() error {
+			_, err := mincut.MinCut(directed)
+			return err
+		}},
+		{name: "NegativeWeight", err: mincut.ErrInvalidWeight, run: func() error {
+			_, err := mincut.GomoryHu(negative)
+			return err
+		}},
+		{name: "NilOption", err: mincut.ErrNilOption, run: func() error {
+			_, err := mincut.MinCut(ok, nil)
+			return err
+		}},
+		{name: "UnsupportedAlgorithm", err: mincut.ErrUnsupportedAlgorithm, run: func() error {
+			_, err := mincut.MinCut(ok, mincut.WithAlgorithm("bogus"))
+			return err
+		}},
+		{name: "BadTrials", err: mincut.ErrBadTrials, run: func() error {
+			_, err := mincut.MinCut(ok, mincut.WithTrials(0))
+			return err
+		}},
+		{name: "NilContext", err: mincut.ErrNilContext, run: func() error {
+			_, err := mincut.MinCut(ok, mincut.WithContext(nil)) //nolint:staticcheck // nil is the case under test
+			return err
+		}},
+		{name: "CanceledMinCut", err: context.Canceled, run: func() error {
+			_, err := mincut.MinCut(ok, mincut.WithContext(ctx))
+			return err
+		}},
+		{name: "CanceledGomoryHu", err: context.Canceled, run: func() error {
+			_, err := mincut.GomoryHu(ok, mincut.WithContext(ctx))
+			return err
+		}},
+		{name: "NilTree", err: mincut.ErrNilTree, run: func() error {
+			_, err := nilTree.MinCutValue("A", "B")
+			return err
+		}},
+		{name: "EmptyVertexID", err: mincut.ErrEmptyVertexID, run: func() error {
+			_, err := tree.MinCut("", "B")
+			return err
+		}},
+		{name: "VertexNotFound", err: mincut.ErrVertexNotFound, run: func() error {
+			_, err := tree.MinCutValue("A", "Z")
+			return err
+		}},
+		{name: "SameVertex", err: mincut.ErrSameVertex, run: func() error {
+			_, err := tree.MinCut("A", "A")
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut
+
+import "context"
+
+// Algorithm identifies the global minimum-cut algorithm.
+//
+// Behavior highlights:
+//   - AlgorithmStoerWagner is the default: deterministic and exact.
+//   - AlgorithmKargerStein is randomized; it finds a minimum cut with high
+//     probability, and the seed makes every run reproducible.
+type Algorithm string
+
+const (
+	// AlgorithmStoerWagner runs V-1 maximum-adjacency phases.
+	AlgorithmStoerWagner Algorithm = "stoer_wagner"
+
+	// AlgorithmKargerStein runs recursive random contraction.
+	AlgorithmKargerStein Algorithm = "karger_stein"
+)
+
+// Options holds the effective policy of one computation.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value is not valid.
+type Options struct {
+	// Algorithm selects the global minimum-cut algorithm; GomoryHu ignores it.
+	Algorithm Algorithm
+
+	// Seed drives AlgorithmKargerStein.
+	Seed int64
+
+	// Trials is the number of independent Karger-Stein runs; 0 means
+	// ceil(log2 V)^2, which fails with probability about 1/V.
+	Trials int
+
+	// ctx allows cancellation between phases, recursion steps, and max flows.
+	ctx context.Context
+}
+
+// Option configures a computation through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns the canonical policy: AlgorithmStoerWagner, Seed 1,
+// automatic trial count, and context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{
+		Algorithm: AlgorithmStoerWagner,
+		Seed:      1,
+		ctx:       context.Background(),
+	}
+}
+
+// WithAlgorithm selects the global minimum-cut algorithm.
+//
+// Errors:
+//   - ErrUnsupportedAlgorithm for values other than the Algorithm constants.
+func WithAlgorithm(algorithm Algorithm) Option {
+	return func(o *Options) error {
+		switch algorithm {
+		case AlgorithmStoerWagner, AlgorithmKargerStein:
+			o.Algorithm = algorithm
+			return nil
+		default:
+			return ErrUnsupportedAlgorithm
+		}
+	}
+}
+
+// WithSeed sets the seed used by AlgorithmKargerStein.
+func WithSeed(seed int64) Option {
+	return func(o *Options) error {
+		o.Seed = seed
+		return nil
+	}
+}
+
+// WithTrials sets the number of independent Karger-Stein runs.
+//
+// Behavior highlights:
+//   - One run finds a given minimum cut with probability Omega(1/log V); more
+//     runs trade time for confidence.
+//
+// Errors:
+//   - ErrBadTrials if trials < 1.
+func WithTrials(trials int) Option {
+	return func(o *Options) error {
+		if trials < 1 {
+			return ErrBadTrials
+		}
+		o.Trials = trials
+		return nil
+	}
+}
+
+// WithContext sets a cancellation context.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+
+	return config, nil
+}
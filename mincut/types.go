@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package mincut
+
+import "github.com/katalvlaran/lvlath/core"
+
+// Cut is a partition of the vertices into two non-empty shores.
+//
+// Behavior highlights:
+//   - Value is the total weight of Edges, the edges with one end on each shore.
+//   - MinCut puts the first vertex of core.Vertices() in Side; Tree.MinCut puts
+//     the first query vertex there.
+//   - Loops and zero-weight edges never appear in Edges.
+//
+// Determinism:
+//   - Side and Other follow core.Vertices(); Edges follows core.Edges().
+type Cut struct {
+	Value float64
+
+	Side  []string
+	Other []string
+	Edges []string
+}
+
+// Tree is a Gomory-Hu tree: a weighted tree on the graph's vertices that
+// encodes a minimum u-v cut for every pair.
+//
+// Behavior highlights:
+//   - The minimum u-v cut value equals the lightest edge on the tree path
+//     between u and v.
+//   - Removing that edge splits the tree into the two shores of a minimum u-v
+//     cut of the original graph.
+//
+// Inputs:
+//   - Constructed through GomoryHu only.
+//
+// Complexity:
+//   - Space O(V + E): the tree and a snapshot of the original edges.
+//
+// Notes:
+//   - The tree describes the graph at build time; rebuild after changes.
+//   - Immutable after construction, so concurrent queries are safe.
+//
+// AI-Hints:
+//   - V-1 max flows answer all V(V-1)/2 pair queries; the lightest tree edge
+//     overall is a global minimum cut.
+type Tree struct {
+	graph    *core.Graph
+	snap     *snapshot
+	parent   []int
+	weight   []float64
+	depth    []int
+	children [][]int
+}
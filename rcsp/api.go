@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package rcsp
+
+import "github.com/katalvlaran/lvlath/core"
+
+// Solve finds the cheapest sourceID -> targetID route whose total resource does
+// not exceed the budget, and optionally the full cost/resource Pareto front.
+//
+// Implementation:
+//   - Stage 1: Validate the graph and endpoints; assemble options.
+//   - Stage 2: Snapshot costs (Edge.Weight) and resources into dense arcs.
+//   - Stage 3: Run label-setting search with dominance and lower-bound pruning.
+//
+// Behavior highlights:
+//   - Directed edges are traversed From->To; undirected edges both ways.
+//   - Returned routes are simple paths: non-negative cycles never improve a label.
+//   - sourceID == targetID yields the empty route with both totals 0.
+//
+// Inputs:
+//   - g: a weighted graph with finite non-negative weights (the cost).
+//   - sourceID, targetID: existing vertices.
+//   - opts: WithResource (required), WithBudget, WithParetoFront, WithMaxLabels,
+//     WithContext.
+//
+// Returns:
+//   - *Result: the optimal route and, when requested, the Pareto front.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrEmptySourceID, ErrSourceNotFound,
+//     ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrNilOption, ErrNilResource, ErrMissingResource, ErrBadBudget,
+//     ErrBadMaxLabels, ErrNilContext.
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrInvalidResource (wrapped with the edge ID).
+//   - ErrNoPath if no route meets the budget; ErrOverflow; ErrLabelLimit.
+//   - ctx.Err() on cancellation; no partial Result is returned.
+//
+// Determinism:
+//   - Equal inputs give equal routes and fronts; ties in cost are resolved by
+//     smaller resource, then by label creation order.
+//
+// Complexity:
+//   - Worst case exponential (the problem is NP-hard); dominance and the
+//     resource lower bound keep typical road-network instances near Dijkstra cost.
+//
+// AI-Hints:
+//   - Use WithMaxLabels as a memory guard for untrusted inputs.
+//   - For cost-only routing use dijkstra; it is strictly cheaper.
+func Solve(g *core.Graph, sourceID, targetID string, opts ...Option) (*Result, error) {
+	if err := validateInputs(g, sourceID, targetID); err != nil {
+		return nil, err
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	s, index, err := newSearch(g, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.run(index[sourceID], index[targetID])
+}
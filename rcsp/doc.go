@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package rcsp solves the resource-constrained shortest path problem over
+// core.Graph: the cheapest route whose consumption of a second per-edge
+// resource stays within a budget.
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - Solve(g, sourceID, targetID, opts...)
+//     Returns the cheapest feasible route (cost = Edge.Weight, resource = caller
+//     function) with both totals, and optionally the full Pareto front of
+//     non-dominated (cost, resource) routes within the budget.
+//
+// -----------------------------------------------------------------------------
+// -- WHY ----------------------------------------------------------------------
+//
+// Logistics routing rarely optimizes one quantity alone: the cheapest route may
+// be too slow, the fastest too expensive. A single weighted Dijkstra cannot
+// express "minimize cost subject to time <= T"; the problem is NP-hard, but
+// label setting with dominance solves realistic road-network instances quickly.
+//
+// -----------------------------------------------------------------------------
+// -- HOW ----------------------------------------------------------------------
+//
+//   - The graph is snapshot once into dense arcs; the resource function is called
+//     once per edge. Directed edges give one arc, undirected edges two.
+//   - A reverse Dijkstra over resources yields, for every vertex, the least
+//     resource still needed to reach the target; labels that cannot finish within
+//     the budget are never created.
+//   - Labels are popped in (cost, resource) order. Because costs are non-negative,
+//     every label settled earlier at a vertex is at most as expensive, so a label
+//     is dominated exactly when an earlier one used no more resource: one float
+//     per vertex decides dominance.
+//   - The first settled target label is optimal. With WithParetoFront the search
+//     continues, and every later settled target label extends the front.
+//
+// Options:
+//
+//   - WithResource(fn) (required), WithBudget(limit), WithParetoFront(),
+//     WithMaxLabels(limit), WithContext(ctx)
+//
+// Errors:
+//
+//   - ErrNilGraph, ErrUnweightedGraph, ErrEmptySourceID, ErrSourceNotFound,
+//     ErrEmptyTargetID, ErrTargetNotFound
+//   - ErrNilOption, ErrNilResource, ErrMissingResource, ErrBadBudget,
+//     ErrBadMaxLabels, ErrNilContext
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrInvalidResource, ErrOverflow
+//   - ErrNoPath, ErrLabelLimit
+//
+// Complexity:
+//
+//   - Worst case exponential in the number of labels; O(L log L + (V + E) log V)
+//     for L created labels. Space O(L + V + E).
+//
+// AI-Hints:
+//   - Use dijkstra when only one quantity matters.
+//   - Swap roles (cost as resource and vice versa) to minimize time under a cost cap.
+package rcsp
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package rcsp
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	//
+	// AI-Hints:
+	//   - This is an input-contract failure detected before any allocation.
+	ErrNilGraph = errors.New("rcsp: graph is nil")
+
+	// ErrUnweightedGraph reports that the graph does not expose weighted edges.
+	// Edge.Weight is the cost being minimized.
+	//
+	// AI-Hints:
+	//   - Do not silently coerce unweighted graphs into unit costs.
+	ErrUnweightedGraph = errors.New("rcsp: graph must be weighted")
+
+	// ErrEmptySourceID reports that the caller passed an empty source vertex ID.
+	ErrEmptySourceID = errors.New("rcsp: source vertex id is empty")
+
+	// ErrSourceNotFound reports that the source vertex does not exist in the graph.
+	ErrSourceNotFound = errors.New("rcsp: source vertex not found")
+
+	// ErrEmptyTargetID reports that the caller passed an empty target vertex ID.
+	ErrEmptyTargetID = errors.New("rcsp: target vertex id is empty")
+
+	// ErrTargetNotFound reports that the target vertex does not exist in the graph.
+	ErrTargetNotFound = errors.New("rcsp: target vertex not found")
+
+	// ErrInvalidWeight reports a NaN or infinite edge cost.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending edge ID.
+	ErrInvalidWeight = errors.New("rcsp: edge weight is NaN or Inf")
+
+	// ErrNegativeWeight reports a negative edge cost. Label setting finalizes
+	// labels in cost order, which is unsound with negative costs.
+	//
+	// AI-Hints:
+	//   - Reweight with bellmanford.Potentials first if costs can be negative.
+	ErrNegativeWeight = errors.New("rcsp: edge weight is negative")
+
+	// ErrMissingResource reports that no resource function was configured.
+	//
+	// AI-Hints:
+	//   - Without a second resource the problem is plain dijkstra.
+	ErrMissingResource = errors.New("rcsp: resource function is required")
+
+	// ErrNilResource reports that WithResource received a nil function.
+	ErrNilResource = errors.New("rcsp: resource function is nil")
+
+	// ErrInvalidResource reports a NaN, infinite, or negative resource value
+	// returned for some edge.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending edge ID.
+	ErrInvalidResource = errors.New("rcsp: edge resource must be finite and >= 0")
+
+	// ErrBadBudget reports a NaN or negative resource budget.
+	//
+	// AI-Hints:
+	//   - Omit WithBudget (or pass +Inf) for an unconstrained Pareto sweep.
+	ErrBadBudget = errors.New("rcsp: budget must be >= 0 and not NaN")
+
+	// ErrBadMaxLabels reports a negative label limit.
+	ErrBadMaxLabels = errors.New("rcsp: max labels must be >= 0")
+
+	// ErrLabelLimit reports that the search created more labels than MaxLabels.
+	//
+	// AI-Hints:
+	//   - The problem is NP-hard; the limit bounds memory on adversarial inputs.
+	ErrLabelLimit = errors.New("rcsp: label limit exceeded")
+
+	// ErrOverflow reports that an accumulated cost or resource left the finite
+	// float64 range.
+	ErrOverflow = errors.New("rcsp: cost or resource overflow")
+
+	// ErrNilOption reports that a nil functional option was supplied.
+	ErrNilOption = errors.New("rcsp: option is nil")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	//
+	// AI-Hints:
+	//   - Use context.Background() instead of nil.
+	ErrNilContext = errors.New("rcsp: context is nil")
+
+	// ErrNoPath reports that no source-target path satisfies the budget.
+	//
+	// AI-Hints:
+	//   - The target may still be reachable when the budget is relaxed.
+	ErrNoPath = errors.New("rcsp: no feasible path")
+)
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package rcsp_test
+
+import (
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/rcsp"
+)
+
+// ExampleSolve finds the cheapest delivery route that arrives within 30 minutes.
+func ExampleSolve() {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	minutes := make(map[string]float64)
+	road := func(from, to string, cost, time float64) {
+		edgeID, _ := graph.AddEdge(from, to, cost)
+		minutes[edgeID] = time
+	}
+	road("Depot", "Toll", 9, 10)
+	road("Toll", "Client", 9, 10)
+	road("Depot", "Village", 2, 25)
+	road("Village", "Client", 2, 25)
+	road("Depot", "Ring", 6, 12)
+	road("Ring", "Client", 6, 12)
+
+	result, _ := rcsp.Solve(graph, "Depot", "Client",
+		rcsp.WithResource(func(edge core.Edge) float64 { return minutes[edge.ID] }),
+		rcsp.WithBudget(30),
+		rcsp.WithParetoFront(),
+	)
+	fmt.Println(result.VertexIDs, result.Cost, result.Resource)
+	for _, path := range result.Front {
+		fmt.Println(path.Cost, path.Resource)
+	}
+
+	// Output:
+	// [Depot Ring Client] 12 24
+	// 12 24
+	// 18 20
+}
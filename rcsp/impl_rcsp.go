@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package rcsp
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// arc is one traversal direction of an edge with both of its consumptions.
+type arc struct {
+	edgeID   string
+	to       int
+	cost     float64
+	resource float64
+}
+
+// label is one partial path ending at vertex. parent indexes the settled label
+// it extends (-1 for the source label); seq fixes heap order among equal keys.
+type label struct {
+	vertex   int
+	cost     float64
+	resource float64
+	parent   int
+	edgeID   string
+	seq      int
+}
+
+// labelQueue is a min-heap of labels ordered by (cost, resource, seq).
+type labelQueue []label
+
+func (q labelQueue) Len() int { return len(q) }
+
+func (q labelQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	if q[i].resource != q[j].resource {
+		return q[i].resource < q[j].resource
+	}
+
+	return q[i].seq < q[j].seq
+}
+
+func (q labelQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *labelQueue) Push(x any) { *q = append(*q, x.(label)) }
+
+func (q *labelQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return item
+}
+
+// search is the dense snapshot and state of one constrained run.
+//
+// AI-Hints:
+//   - The snapshot is built once; the resource function is never called during search.
+type search struct {
+	ids      []string
+	outgoing [][]arc
+	incoming [][]arc
+	config   Options
+}
+
+// validateInputs checks the graph and both endpoints.
+//
+// Errors:
+//   - ErrNilGraph, ErrUnweightedGraph, ErrEmptySourceID, ErrSourceNotFound,
+//     ErrEmptyTargetID, ErrTargetNotFound.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func validateInputs(g *core.Graph, sourceID, targetID string) error {
+	if g == nil {
+		return ErrNilGraph
+	}
+	if !g.Weighted() {
+		return ErrUnweightedGraph
+	}
+	if sourceID == "" {
+		return ErrEmptySourceID
+	}
+	if !g.HasVertex(sourceID) {
+		return ErrSourceNotFound
+	}
+	if targetID == "" {
+		return ErrEmptyTargetID
+	}
+	if !g.HasVertex(targetID) {
+		return ErrTargetNotFound
+	}
+
+	return nil
+}
+
+// newSearch snapshots g into dense forward and reverse arcs.
+//
+// Implementation:
+//   - Stage 1: Index vertices in g.Vertices() order.
+//   - Stage 2: Validate each edge's cost and resource once, in g.Edges() order.
+//   - Stage 3: Emit one arc per traversal direction (a loop gives one).
+//
+// Errors:
+//   - ErrInvalidWeight, ErrNegativeWeight, ErrInvalidResource, wrapped with the edge ID.
+//
+// Complexity:
+//   - Time O(V log V + E log E) for the sorted core surfaces, Space O(V + E).
+func newSearch(g *core.Graph, config Options) (*search, map[string]int, error) {
+	ids := g.Vertices()
+	index := make(map[string]int, len(ids))
+	for position, vertexID := range ids {
+		index[vertexID] = position
+	}
+
+	s := &search{
+		ids:      ids,
+		outgoing: make([][]arc, len(ids)),
+		incoming: make([][]arc, len(ids)),
+		config:   config,
+	}
+
+	for _, edge := range g.Edges() {
+		if math.IsNaN(edge.Weight) || math.IsInf(edge.Weight, 0) {
+			return nil, nil, fmt.Errorf("%w: edge_id=%q weight=%g", ErrInvalidWeight, edge.ID, edge.Weight)
+		}
+		if edge.Weight < 0 {
+			return nil, nil, fmt.Errorf("%w: edge_id=%q weight=%g", ErrNegativeWeight, edge.ID, edge.Weight)
+		}
+		resource := config.Resource(*edge)
+		if math.IsNaN(resource) || math.IsInf(resource, 0) || resource < 0 {
+			return nil, nil, fmt.Errorf("%w: edge_id=%q resource=%g", ErrInvalidResource, edge.ID, resource)
+		}
+
+		from, to := index[edge.From], index[edge.To]
+		s.addArc(from, to, edge.ID, edge.Weight, resource)
+		if !edge.Directed && from != to {
+			s.addArc(to, from, edge.ID, edge.Weight, resource)
+		}
+	}
+
+	return s, index, nil
+}
+
+// addArc records from->to in the forward list and to<-from in the reverse list.
+func (s *search) addArc(from, to int, edgeID string, cost, resource float64) {
+	s.outgoing[from] = append(s.outgoing[from], arc{edgeID: edgeID, to: to, cost: cost, resource: resource})
+	s.incoming[to] = append(s.incoming[to], arc{edgeID: edgeID, to: from, cost: cost, resource: resource})
+}
+
+// resourceBounds returns, for every vertex, the least resource needed to reach
+// target, computed by a reverse Dijkstra over resources.
+//
+// Behavior highlights:
+//   - +Inf marks vertices that cannot reach target at all.
+//   - The bound is exact for resources alone, hence admissible for pruning.
+//
+// Complexity:
+//   - Time O((V + E) log V), Space O(V).
+func (s *search) resourceBounds(target int) []float64 {
+	bounds := make([]float64, len(s.ids))
+	for position := range bounds {
+		bounds[position] = math.Inf(1)
+	}
+	bounds[target] = 0
+
+	queue := labelQueue{{vertex: target}}
+	for queue.Len() > 0 {
+		item := heap.Pop(&queue).(label)
+		if item.cost > bounds[item.vertex] {
+			continue
+		}
+		for _, a := range s.incoming[item.vertex] {
+			candidate := item.cost + a.resource
+			if candidate < bounds[a.to] {
+				bounds[a.to] = candidate
+				heap.Push(&queue, label{vertex: a.to, cost: candidate})
+			}
+		}
+	}
+
+	return bounds
+}
+
+// run executes label-setting search from source to target.
+//
+// Implementation:
+//   - Stage 1: Compute resource lower bounds to target; prune infeasible starts.
+//   - Stage 2: Pop labels in (cost, resource) order. A label is dominated iff a
+//     label settled earlier at its vertex has resource <= its resource, because
+//     every earlier label also has cost <= its cost.
+//   - Stage 3: Extend settled labels, discarding extensions that cannot meet the
+//     budget even on the resource-cheapest completion, or that are already dominated.
+//   - Stage 4: Record settled target labels; stop at the first unless the
+//     Pareto front is requested.
+//
+// Errors:
+//   - ErrNoPath, ErrOverflow, ErrLabelLimit, ctx.Err().
+//
+// Determinism:
+//   - Arc order and the (cost, resource, seq) heap order are fixed.
+//
+// Complexity:
+//   - Time O(L log L + V log V) for L created labels; L is exponential in the
+//     worst case (the problem is NP-hard), Space O(L + V + E).
+//
+// AI-Hints:
+//   - Do not extend labels from the target: any cycle back to it is dominated.
+func (s *search) run(source, target int) (*Result, error) {
+	bounds := s.resourceBounds(target)
+	if bounds[source] > s.config.Budget {
+		return nil, ErrNoPath
+	}
+
+	bestResource := make([]float64, len(s.ids))
+	for position := range bestResource {
+		bestResource[position] = math.Inf(1)
+	}
+
+	var settled []label
+	var front []int
+	created := 1
+	queue := labelQueue{{vertex: source, parent: -1}}
+
+	for queue.Len() > 0 {
+		if err := s.config.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		current := heap.Pop(&queue).(label)
+		if current.resource >= bestResource[current.vertex] {
+			continue
+		}
+		bestResource[current.vertex] = current.resource
+		settled = append(settled, current)
+		parent := len(settled) - 1
+
+		if current.vertex == target {
+			front = append(front, parent)
+			if !s.config.ParetoFront {
+				break
+			}
+			continue
+		}
+
+		for _, a := range s.outgoing[current.vertex] {
+			cost := current.cost + a.cost
+			resource := current.resource + a.resource
+			if math.IsInf(cost, 1) || math.IsInf(resource, 1) {
+				return nil, fmt.Errorf("%w: edge_id=%q", ErrOverflow, a.edgeID)
+			}
+			if math.IsInf(bounds[a.to], 1) || resource+bounds[a.to] > s.config.Budget {
+				continue
+			}
+			if resource >= bestResource[a.to] {
+				continue
+			}
+
+			created++
+			if s.config.MaxLabels > 0 && created > s.config.MaxLabels {
+				return nil, ErrLabelLimit
+			}
+			heap.Push(&queue, label{
+				vertex:   a.to,
+				cost:     cost,
+				resource: resource,
+				parent:   parent,
+				edgeID:   a.edgeID,
+				seq:      created,
+			})
+		}
+	}
+
+	if len(front) == 0 {
+		return nil, ErrNoPath
+	}
+
+	result := &Result{Path: s.path(settled, front[0])}
+	if s.config.ParetoFront {
+		result.Front = make([]Path, 0, len(front))
+		for _, position := range front {
+			result.Front = append(result.Front, s.path(settled, position))
+		}
+	}
+
+	return result, nil
+}
+
+// path rebuilds the route ending at settled[position].
+//
+// Complexity:
+//   - Time O(k), Space O(k) for a k-vertex route.
+func (s *search) path(settled []label, position int) Path {
+	end := settled[position]
+	var vertexIDs, edgeIDs []string
+	for ; position >= 0; position = settled[position].parent {
+		vertexIDs = append(vertexIDs, s.ids[settled[position].vertex])
+		if settled[position].parent >= 0 {
+			edgeIDs = append(edgeIDs, settled[position].edgeID)
+		}
+	}
+	reverse(vertexIDs)
+	reverse(edgeIDs)
+	if edgeIDs == nil {
+		edgeIDs = []string{}
+	}
+
+	return Path{VertexIDs: vertexIDs, EdgeIDs: edgeIDs, Cost: end.cost, Resource: end.resource}
+}
+
+// reverse reverses ids in place.
+func reverse(ids []string) {
+	for left, right := 0, len(ids)-1; left < right; left, right = left+1, right-1 {
+		ids[left], ids[right] = ids[right], ids[left]
+	}
+}
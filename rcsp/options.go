@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package rcsp
+
+import (
+	"context"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// Options holds the effective policy of one constrained search.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value has no resource function.
+type Options struct {
+	// Resource returns the second per-edge resource (time, fuel, risk).
+	Resource func(edge core.Edge) float64
+
+	// Budget bounds the total resource of a feasible path (inclusive).
+	Budget float64
+
+	// ParetoFront requests every non-dominated (cost, resource) path within budget.
+	ParetoFront bool
+
+	// MaxLabels bounds the number of labels created; 0 means unlimited.
+	MaxLabels int
+
+	// ctx allows cancellation between label pops.
+	ctx context.Context
+}
+
+// Option configures a constrained search through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns the canonical policy: no resource function, Budget = +Inf,
+// no Pareto front, unlimited labels, and context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{
+		Budget: math.Inf(1),
+		ctx:    context.Background(),
+	}
+}
+
+// WithResource sets the per-edge resource function. It is required.
+//
+// Behavior highlights:
+//   - Called once per edge during the snapshot; undirected edges consume the
+//     same resource in both directions.
+//
+// Errors:
+//   - ErrNilResource if resource is nil.
+//
+// AI-Hints:
+//   - core.Edge carries one weight only; look secondary values up by Edge.ID:
+//     WithResource(func(e core.Edge) float64 { return minutes[e.ID] }).
+func WithResource(resource func(edge core.Edge) float64) Option {
+	return func(o *Options) error {
+		if resource == nil {
+			return ErrNilResource
+		}
+		o.Resource = resource
+		return nil
+	}
+}
+
+// WithBudget sets the inclusive upper bound on the total resource.
+//
+// Errors:
+//   - ErrBadBudget if budget is NaN or negative.
+func WithBudget(budget float64) Option {
+	return func(o *Options) error {
+		if math.IsNaN(budget) || budget < 0 {
+			return ErrBadBudget
+		}
+		o.Budget = budget
+		return nil
+	}
+}
+
+// WithParetoFront requests Result.Front: every non-dominated path within budget.
+//
+// AI-Hints:
+//   - Without it the search stops at the first (cheapest) feasible target label.
+func WithParetoFront() Option {
+	return func(o *Options) error {
+		o.ParetoFront = true
+		return nil
+	}
+}
+
+// WithMaxLabels bounds the number of labels the search may create.
+//
+// Errors:
+//   - ErrBadMaxLabels if limit is negative. 0 means unlimited.
+func WithMaxLabels(limit int) Option {
+	return func(o *Options) error {
+		if limit < 0 {
+			return ErrBadMaxLabels
+		}
+		o.MaxLabels = limit
+		return nil
+	}
+}
+
+// WithContext sets the context used for cancellation.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+//
+// AI-Hints:
+//   - Cancellation surfaces as ctx.Err() with no partial Result.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//   - ErrMissingResource when no resource function was configured.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+	if config.Resource == nil {
+		return Options{}, ErrMissingResource
+	}
+
+	return config, nil
+}
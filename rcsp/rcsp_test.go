@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package rcsp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/internal/testutil"
+	"github.com/katalvlaran/lvlath/rcsp"
+)
+
+// AI-HINTS (file):
+//   - Integer costs and resources keep every comparison exact.
+//   - The oracle enumerates simple paths; label setting must reproduce the
+//     cheapest feasible route and the exact set of non-dominated (cost, resource) pairs.
+
+// buildRandomGraph constructs a reproducible mixed multigraph and a resource
+// table keyed by edge ID.
+func buildRandomGraph(t *testing.T, seed int64, vertexCount, edgeCount int) (*core.Graph, map[string]float64) {
+	t.Helper()
+
+	rng := rand.New(rand.NewSource(seed))
+	graph := testutil.RandomGraph(t, rng, testutil.Spec{Vertices: vertexCount, Edges: edgeCount, Mode: testutil.Mixed})
+
+	resources := make(map[string]float64)
+	for _, edge := range graph.Edges() {
+		resources[edge.ID] = float64(rng.Intn(10))
+	}
+
+	return graph, resources
+}
+
+// paretoOracle returns the sorted non-dominated (cost, resource) pairs of all
+// simple sourceID -> targetID paths with resource <= budget.
+func paretoOracle(t *testing.T, graph *core.Graph, resources map[string]float64, sourceID, targetID string, budget float64) [][2]float64 {
+	t.Helper()
+
+	var pairs [][2]float64
+	onPath := map[string]bool{sourceID: true}
+	var walk func(currentID string, cost, resource float64)
+	walk = func(currentID string, cost, resource float64) {
+		if resource > budget {
+			return
+		}
+		if currentID == targetID {
+			pairs = append(pairs, [2]float64{cost, resource})
+			return
+		}
+		edges, err := graph.Neighbors(currentID)
+		if err != nil {
+			t.Fatalf("Neighbors failed: %v", err)
+		}
+		for _, edge := range edges {
+			if edge.Directed && edge.From != currentID {
+				continue
+			}
+			nextID := edge.To
+			if edge.To == currentID {
+				nextID = edge.From
+			}
+			if onPath[nextID] {
+				continue
+			}
+			onPath[nextID] = true
+			walk(nextID, cost+edge.Weight, resource+resources[edge.ID])
+			onPath[nextID] = false
+		}
+	}
+	walk(sourceID, 0, 0)
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	var front [][2]float64
+	for _, pair := range pairs {
+		if len(front) == 0 || pair[1] < front[len(front)-1][1] {
+			front = append(front, pair)
+		}
+	}
+
+	return front
+}
+
+// TestSolve_MatchesEnumeration verifies the optimum and the Pareto front
+// against exhaustive enumeration for several budgets.
+//
+// Implementation:
+//   - Stage 1: Build random mixed multigraphs with integer costs and resources.
+//   - Stage 2: For several pairs and budgets, compare with the oracle.
+//   - Stage 3: Recompute both totals of every returned route from its edges.
+func TestSolve_MatchesEnumeration(t *testing.T) {
+	for seed := int64(1); seed <= 4; seed++ {
+		graph, resources := buildRandomGraph(t, seed, 8, 22)
+		weights := make(map[string]float64)
+		for _, edge := range graph.Edges() {
+			weights[edge.ID] = edge.Weight
+		}
+		resource := rcsp.WithResource(func(edge core.Edge) float64 { return resources[edge.ID] })
+		vertices := graph.Vertices()
+
+		for _, sourceID := range vertices[:2] {
+			for _, targetID := range vertices[len(vertices)-2:] {
+				for _, budget := range []float64{5, 12, 40} {
+					want := paretoOracle(t, graph, resources, sourceID, targetID, budget)
+					res, err := rcsp.Solve(graph, sourceID, targetID, resource, rcsp.WithBudget(budget), rcsp.WithParetoFront())
+					if len(want) == 0 {
+						if !errors.Is(err, rcsp.ErrNoPath) {
+							t.Fatalf("seed %d %s->%s budget %v: err=%v want ErrNoPath", seed, sourceID, targetID, budget, err)
+						}
+						continue
+					}
+					if err != nil {
+						t.Fatalf("seed %d %s->%s budget %v: %v", seed, sourceID, targetID, budget, err)
+					}
+
+					if len(res.Front) != len(want) {
+						t.Fatalf("seed %d %s->%s budget %v: front size %d want %d", seed, sourceID, targetID, budget, len(res.Front), len(want))
+					}
+					for index, path := range res.Front {
+						if path.Cost != want[index][0] || path.Resource != want[index][1] {
+							t.Fatalf("front[%d] = (%v,%v) want %v", index, path.Cost, path.Resource, want[index])
+						}
+						cost, used := 0.0, 0.0
+						for _, edgeID := range path.EdgeIDs {
+							cost += weights[edgeID]
+							used += resources[edgeID]
+						}
+						if cost != path.Cost || used != path.Resource {
+							t.Fatalf("front[%d] edges sum to (%v,%v), reported (%v,%v)", index, cost, used, path.Cost, path.Resource)
+						}
+					}
+					if res.Cost != want[0][0] || res.Resource != want[0][1] {
+						t.Fatalf("best = (%v,%v) want %v", res.Cost, res.Resource, want[0])
+					}
+
+					single, err := rcsp.Solve(graph, sourceID, targetID, resource, rcsp.WithBudget(budget))
+					if err != nil {
+						t.Fatalf("Solve without front failed: %v", err)
+					}
+					if single.Cost != want[0][0] || single.Front != nil {
+						t.Fatalf("single-best mode: cost=%v front=%v", single.Cost, single.Front)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestSolve_BudgetTradeoffAndValidation verifies the budget switch between two
+// routes, the trivial self route, and the sentinel contract.
+//
+// Implementation:
+//   - Stage 1: A fast-but-expensive and a slow-but-cheap route from S to T.
+//   - Stage 2: A tight time budget forces the expensive route.
+//   - Stage 3: Assert validation, label-limit, and cancellation sentinels.
+func TestSolve_BudgetTradeoffAndValidation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithWeighted(), core.WithDirected(true))
+	minutes := make(map[string]float64)
+	add := func(from, to string, cost, time float64) {
+		edgeID, err := graph.AddEdge(from, to, cost)
+		if err != nil {
+			t.Fatalf("AddEdge failed: %v", err)
+		}
+		minutes[edgeID] = time
+	}
+	add("S", "A", 1, 10)
+	add("A", "T", 1, 10)
+	add("S", "B", 5, 1)
+	add("B", "T", 5, 1)
+	resource := rcsp.WithResource(func(edge core.Edge) float64 { return minutes[edge.ID] })
+
+	res, err := rcsp.Solve(graph, "S", "T", resource)
+	if err != nil || res.Cost != 2 || res.Resource != 20 {
+		t.Fatalf("unconstrained: res=%+v err=%v", res, err)
+	}
+	res, err = rcsp.Solve(graph, "S", "T", resource, rcsp.WithBudget(5))
+	if err != nil || res.Cost != 10 || fmt.Sprint(res.VertexIDs) != "[S B T]" {
+		t.Fatalf("budget 5: res=%+v err=%v", res, err)
+	}
+	_, err = rcsp.Solve(graph, "S", "T", resource, rcsp.WithBudget(1))
+	if !errors.Is(err, rcsp.ErrNoPath) {
+		t.Fatalf("budget 1: err=%v want ErrNoPath", err)
+	}
+	res, err = rcsp.Solve(graph, "S", "S", resource)
+	if err != nil || len(res.EdgeIDs) != 0 || res.Cost != 0 {
+		t.Fatalf("self route: res=%+v err=%v", res, err)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{"nil graph", rcsp.ErrNilGraph, func() error { _, e := rcsp.Solve(nil, "S", "T", resource); return e }},
+		{"missing source", rcsp.ErrSourceNotFound, func() error { _, e := rcsp.Solve(graph, "Q", "T", resource); return e }},
+		{"empty target", rcsp.ErrEmptyTargetID, func() error { _, e := rcsp.Solve(graph, "S", "", resource); return e }},
+		{"no resource", rcsp.ErrMissingResource, func() error { _, e := rcsp.Solve(graph, "S", "T"); return e }},
+		{"nil resource", rcsp.ErrNilResource, func() error { _, e := rcsp.Solve(graph, "S", "T", rcsp.WithResource(nil)); return e }},
+		{"bad budget", rcsp.ErrBadBudget, func() error { _, e := rcsp.Solve(graph, "S", "T", resource, rcsp.WithBudget(-1)); return e }},
+		{"nil option", rcsp.ErrNilOption, func() error { _, e := rcsp.Solve(graph, "S", "T", nil); return e }},
+		{"label limit", rcsp.ErrLabelLimit, func() error {
+			_, e := rcsp.Solve(graph, "S", "T", resource, rcsp.WithMaxLabels(1))
+			return e
+		}},
+		{"invalid resource", rcsp.ErrInvalidResource, func() error {
+			_, e := rcsp.Solve(graph, "S", "T", rcsp.WithResource(func(core.Edge) float64 { return -1 }))
+			return e
+		}},
+		{"cancelled", context.Canceled, func() error {
+			_, e := rcsp.Solve(graph, "S", "T", resource, rcsp.WithContext(cancelled))
+			return e
+		}},
+	}
+	for _, tc := range cases {
+		if err = tc.run(); !errors.Is(err, tc.err) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.err)
+		}
+	}
+}
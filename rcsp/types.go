@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package rcsp
+
+// Path is one source-target route with both totals.
+//
+// Behavior highlights:
+//   - EdgeIDs[i] leads from VertexIDs[i] to VertexIDs[i+1]; parallel edges are distinct.
+//   - Cost sums Edge.Weight; Resource sums the configured resource function.
+//
+// AI-Hints:
+//   - Compare routes by EdgeIDs on multigraphs, not by VertexIDs.
+type Path struct {
+	// VertexIDs lists the visited vertices from source to target.
+	VertexIDs []string
+
+	// EdgeIDs lists the traversed edge IDs; len(EdgeIDs) == len(VertexIDs)-1.
+	EdgeIDs []string
+
+	// Cost is the total Edge.Weight of the path.
+	Cost float64
+
+	// Resource is the total resource consumption of the path.
+	Resource float64
+}
+
+// Result is the outcome of Solve.
+//
+// Behavior highlights:
+//   - The embedded Path is the cheapest route whose Resource <= Budget; among
+//     equal costs the one with the smaller resource wins.
+//   - Front is nil unless WithParetoFront was given. It lists every
+//     non-dominated feasible route in increasing Cost and strictly decreasing
+//     Resource order; Front[0] equals the embedded Path.
+//
+// AI-Hints:
+//   - Front[len(Front)-1] is the least resource-hungry feasible route.
+type Result struct {
+	Path
+
+	// Front holds the Pareto-optimal routes when requested.
+	Front []Path
+}
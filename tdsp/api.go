@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp
+
+import (
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// EarliestArrival computes, for every vertex, the earliest arrival time when
+// leaving sourceID at departure, together with one earliest-arrival route.
+//
+// Implementation:
+//   - Stage 1: Validate the graph, the source, and the departure; assemble options.
+//   - Stage 2: Snapshot each edge's travel-time function into dense arcs.
+//   - Stage 3: Run time-dependent Dijkstra on arrival times.
+//
+// Behavior highlights:
+//   - Directed edges are traversed From->To; undirected edges both ways with the
+//     same function.
+//   - Edge.Weight is ignored; travel times come from WithTravelTime only.
+//
+// Inputs:
+//   - g: the network; weighted or not.
+//   - sourceID: the origin vertex.
+//   - departure: the finite departure time at the origin.
+//   - opts: WithTravelTime (required), WithContext.
+//
+// Returns:
+//   - *Result: arrival times (+Inf when unreachable) and the route tree.
+//
+// Errors:
+//   - ErrNilGraph, ErrEmptySourceID, ErrSourceNotFound, ErrBadDeparture.
+//   - ErrNilOption, ErrNilTravelTime, ErrMissingTravelTime, ErrNilContext.
+//   - ErrNilTravelTime wrapped with the edge ID if the callback returns nil.
+//   - ErrOverflow; ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Deterministic for the same graph, departure, and functions.
+//
+// Complexity:
+//   - Time O((V + E) log V + E log k) for k breakpoints per function, Space O(V + E).
+//
+// AI-Hints:
+//   - Arrivals[v] - departure is the travel time; the static special case is dijkstra.
+func EarliestArrival(g *core.Graph, sourceID string, departure float64, opts ...Option) (*Result, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if err := validateEndpoint(g, sourceID, ErrEmptySourceID, ErrSourceNotFound); err != nil {
+		return nil, err
+	}
+	if math.IsNaN(departure) || math.IsInf(departure, 0) {
+		return nil, ErrBadDeparture
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := newNetwork(g, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.earliestArrival(n.index[sourceID], departure)
+}
+
+// ArrivalProfile computes the sourceID -> targetID travel time as an exact
+// piecewise-linear function of the departure time over [from, to].
+//
+// Implementation:
+//   - Stage 1: Validate the graph, both endpoints, and the interval; assemble options.
+//   - Stage 2: Snapshot each edge's travel-time function into dense arcs.
+//   - Stage 3: Run the label-correcting profile search on functions.
+//
+// Behavior highlights:
+//   - For every departure t in [from, to], TravelTimeAt(t) equals the travel
+//     time EarliestArrival reports for departure t (up to float64 rounding).
+//   - from == to degenerates to a single departure.
+//
+// Inputs:
+//   - g: the network.
+//   - sourceID, targetID: existing vertices.
+//   - from, to: the finite departure interval, from <= to.
+//   - opts: WithTravelTime (required), WithMaxBreakpoints, WithContext.
+//
+// Returns:
+//   - *Profile: the travel-time profile; Reachable() is false when no route exists.
+//
+// Errors:
+//   - ErrNilGraph, ErrEmptySourceID, ErrSourceNotFound, ErrEmptyTargetID,
+//     ErrTargetNotFound, ErrBadInterval.
+//   - ErrNilOption, ErrNilTravelTime, ErrMissingTravelTime, ErrBadMaxBreakpoints,
+//     ErrNilContext.
+//   - ErrBreakpointLimit, ErrOverflow; ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Deterministic for the same graph, interval, and functions.
+//
+// Complexity:
+//   - Label-correcting over functions; each label update is O(k) for k breakpoints.
+//     Profiles of realistic networks stay small, but WithMaxBreakpoints guards
+//     adversarial inputs.
+//
+// AI-Hints:
+//   - Pick the departure with Profile.Best, then call EarliestArrival for the route.
+func ArrivalProfile(g *core.Graph, sourceID, targetID string, from, to float64, opts ...Option) (*Profile, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+	if err := validateEndpoint(g, sourceID, ErrEmptySourceID, ErrSourceNotFound); err != nil {
+		return nil, err
+	}
+	if err := validateEndpoint(g, targetID, ErrEmptyTargetID, ErrTargetNotFound); err != nil {
+		return nil, err
+	}
+	if math.IsNaN(from) || math.IsInf(from, 0) || math.IsNaN(to) || math.IsInf(to, 0) || from > to {
+		return nil, ErrBadInterval
+	}
+
+	config, err := applyOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := newNetwork(g, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.arrivalProfile(n.index[sourceID], n.index[targetID], from, to)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package tdsp computes time-dependent shortest paths over core.Graph: every
+// edge carries a FIFO piecewise-linear travel-time function of the departure
+// time, as in transit and congestion-aware road models.
+//
+// -----------------------------------------------------------------------------
+// -- WHAT ---------------------------------------------------------------------
+//
+//   - NewTravelTime(points...)
+//     Validates a piecewise-linear travel-time function (linear between
+//     breakpoints, constant outside them) and its FIFO property.
+//
+//   - EarliestArrival(g, sourceID, departure, opts...)
+//     Earliest arrival time at every vertex for one departure time, with one
+//     earliest-arrival route per vertex.
+//
+//   - ArrivalProfile(g, sourceID, targetID, from, to, opts...)
+//     The exact source-to-target travel time as a piecewise-linear function of
+//     the departure time over [from, to], with Best() for the ideal departure.
+//
+// -----------------------------------------------------------------------------
+// -- WHY ----------------------------------------------------------------------
+//
+// A static weight cannot say that a road is slow at 8:00 and fast at 10:00.
+// Recomputing static shortest paths per departure answers one instant at a
+// time; a profile answers "when should I leave?" in a single search.
+//
+// -----------------------------------------------------------------------------
+// -- HOW ----------------------------------------------------------------------
+//
+//   - FIFO (t + f(t) never decreases) means an earlier arrival at a vertex is
+//     never worse downstream, so waiting never helps and Dijkstra on arrival
+//     times is exact: relax with arrival = t + f(t).
+//   - The profile search keeps, per vertex, a travel-time function of the
+//     source departure time. Linking a label with an arc function adds the
+//     departures whose arrival hits an arc breakpoint; merging takes the lower
+//     envelope, adding crossing points. Vertices are re-queued whenever their
+//     function improves (label correcting), and the search stops once no queued
+//     minimum can beat the target's maximum.
+//
+// Options:
+//
+//   - WithTravelTime(fn) (required), WithMaxBreakpoints(limit), WithContext(ctx)
+//
+// Errors:
+//
+//   - ErrNilGraph, ErrEmptySourceID, ErrSourceNotFound, ErrEmptyTargetID,
+//     ErrTargetNotFound, ErrBadDeparture, ErrBadInterval
+//   - ErrEmptyTravelTime, ErrInvalidTravelTime, ErrNotFIFO
+//   - ErrNilOption, ErrNilTravelTime, ErrMissingTravelTime, ErrBadMaxBreakpoints,
+//     ErrNilContext
+//   - ErrOverflow, ErrBreakpointLimit
+//   - ErrNilResult, ErrNoPath, ErrOutsideInterval from result methods
+//
+// Complexity:
+//
+//   - EarliestArrival: O((V + E) log V + E log k) for k breakpoints per function.
+//   - ArrivalProfile: label correcting over functions; each update is O(k) in
+//     the label size. WithMaxBreakpoints bounds memory.
+//
+// AI-Hints:
+//   - Edge.Weight is ignored; static edges are one-breakpoint functions.
+//   - Use the profile to choose the departure and EarliestArrival for the route.
+package tdsp
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp
+
+import "errors"
+
+var (
+	// ErrNilGraph reports that the caller passed a nil graph pointer.
+	//
+	// AI-Hints:
+	//   - This is an input-contract failure detected before any allocation.
+	ErrNilGraph = errors.New("tdsp: graph is nil")
+
+	// ErrEmptySourceID reports that the caller passed an empty source vertex ID.
+	ErrEmptySourceID = errors.New("tdsp: source vertex id is empty")
+
+	// ErrSourceNotFound reports that the source vertex does not exist in the graph.
+	ErrSourceNotFound = errors.New("tdsp: source vertex not found")
+
+	// ErrEmptyTargetID reports that the caller passed an empty target vertex ID.
+	ErrEmptyTargetID = errors.New("tdsp: target vertex id is empty")
+
+	// ErrTargetNotFound reports that the target vertex does not exist in the
+	// graph or in the result domain.
+	ErrTargetNotFound = errors.New("tdsp: target vertex not found")
+
+	// ErrEmptyTravelTime reports that NewTravelTime received no breakpoints.
+	//
+	// AI-Hints:
+	//   - A single breakpoint is a constant travel time.
+	ErrEmptyTravelTime = errors.New("tdsp: travel time needs at least one breakpoint")
+
+	// ErrInvalidTravelTime reports a NaN or infinite breakpoint time, a NaN,
+	// infinite, or negative duration, or breakpoint times that do not strictly
+	// increase.
+	//
+	// AI-Hints:
+	//   - The error is wrapped with the offending breakpoint index.
+	ErrInvalidTravelTime = errors.New("tdsp: invalid travel time breakpoint")
+
+	// ErrNotFIFO reports a travel-time function under which departing later can
+	// arrive earlier, i.e. a segment with slope below -1.
+	//
+	// AI-Hints:
+	//   - FIFO is what makes waiting pointless and Dijkstra exact; model
+	//     timetabled services with waiting folded into the duration.
+	ErrNotFIFO = errors.New("tdsp: travel time violates FIFO")
+
+	// ErrMissingTravelTime reports that no travel-time callback was configured.
+	ErrMissingTravelTime = errors.New("tdsp: travel time function is required")
+
+	// ErrNilTravelTime reports that WithTravelTime received a nil callback, or
+	// that the callback returned nil for some edge.
+	//
+	// AI-Hints:
+	//   - The per-edge form is wrapped with the edge ID; return a one-breakpoint
+	//     function for static edges.
+	ErrNilTravelTime = errors.New("tdsp: travel time is nil")
+
+	// ErrBadDeparture reports a NaN or infinite departure time.
+	ErrBadDeparture = errors.New("tdsp: departure time must be finite")
+
+	// ErrBadInterval reports a profile interval that is not finite or whose
+	// start exceeds its end.
+	ErrBadInterval = errors.New("tdsp: departure interval must be finite with from <= to")
+
+	// ErrOutsideInterval reports a profile evaluation outside its departure interval.
+	ErrOutsideInterval = errors.New("tdsp: departure outside profile interval")
+
+	// ErrBadMaxBreakpoints reports a negative breakpoint limit.
+	ErrBadMaxBreakpoints = errors.New("tdsp: max breakpoints must be >= 0")
+
+	// ErrBreakpointLimit reports that a profile label grew beyond MaxBreakpoints.
+	//
+	// AI-Hints:
+	//   - Profiles can grow with every merge of competing routes; the limit bounds memory.
+	ErrBreakpointLimit = errors.New("tdsp: breakpoint limit exceeded")
+
+	// ErrOverflow reports that an arrival time left the finite float64 range.
+	ErrOverflow = errors.New("tdsp: arrival time overflow")
+
+	// ErrNilOption reports that a nil functional option was supplied.
+	ErrNilOption = errors.New("tdsp: option is nil")
+
+	// ErrNilContext reports that WithContext received a nil context.
+	//
+	// AI-Hints:
+	//   - Use context.Background() instead of nil.
+	ErrNilContext = errors.New("tdsp: context is nil")
+
+	// ErrNilResult reports that a result method was called on a nil receiver.
+	ErrNilResult = errors.New("tdsp: result is nil")
+
+	// ErrNoPath reports that the queried vertex is unreachable from the source.
+	ErrNoPath = errors.New("tdsp: no path")
+)
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp_test
+
+import (
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/tdsp"
+)
+
+// ExampleArrivalProfile compares a motorway that jams at rush hour with a
+// slower but steady ring road, then picks the best departure in the window.
+func ExampleArrivalProfile() {
+	graph, _ := core.NewGraph(core.WithDirected(true), core.WithMultiEdges())
+	motorway, _ := graph.AddEdge("Home", "Office", 0)
+	ring, _ := graph.AddEdge("Home", "Office", 0)
+
+	// Minutes from 7:00 (t = 0): 20 min free flow, 50 min at 8:00, free again by 9:00.
+	jam, _ := tdsp.NewTravelTime(
+		tdsp.Breakpoint{Time: 0, Duration: 20},
+		tdsp.Breakpoint{Time: 60, Duration: 50},
+		tdsp.Breakpoint{Time: 120, Duration: 20},
+	)
+	steady, _ := tdsp.NewTravelTime(tdsp.Breakpoint{Duration: 35})
+	travel := tdsp.WithTravelTime(func(edge core.Edge) *tdsp.TravelTime {
+		if edge.ID == motorway {
+			return jam
+		}
+		return steady
+	})
+
+	profile, _ := tdsp.ArrivalProfile(graph, "Home", "Office", 0, 120, travel)
+	for _, departure := range []float64{0, 60, 120} {
+		minutes, _ := profile.TravelTimeAt(departure)
+		fmt.Printf("leave at +%v: %v min\n", departure, minutes)
+	}
+
+	result, _ := tdsp.EarliestArrival(graph, "Home", 60, travel)
+	route, _ := result.EdgePathTo("Office")
+	fmt.Println(route[0] == ring, result.Arrivals["Office"])
+
+	// Output:
+	// leave at +0: 20 min
+	// leave at +60: 35 min
+	// leave at +120: 20 min
+	// true 95
+}
@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// profileTolerance is the relative slack used to drop collinear breakpoints
+// and to ignore improvements that are pure rounding noise.
+const profileTolerance = 1e-9
+
+// arrivalProfile runs the label-correcting profile search from source to target
+// over departures in [from, to].
+//
+// Implementation:
+//   - Stage 1: The source label is the zero function over [from, to].
+//   - Stage 2: Pop the vertex whose label has the smallest minimum; stale
+//     entries are skipped by version.
+//   - Stage 3: Link the label with each arc function and merge the result into
+//     the head label by lower envelope; re-queue the head when it improved.
+//   - Stage 4: Stop once the smallest queued minimum reaches the maximum of the
+//     target label: no remaining route can improve any departure.
+//
+// Behavior highlights:
+//   - Labels are travel times as functions of the source departure time, so the
+//     target label is the profile itself.
+//   - A vertex may be settled more than once; that is what makes the search
+//     correct for functions, where no single scalar order exists.
+//
+// Errors:
+//   - ErrBreakpointLimit if a label outgrows MaxBreakpoints.
+//   - ErrOverflow if a travel time leaves the finite range.
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Ties are broken by vertex index; arcs are scanned in snapshot order.
+//
+// Complexity:
+//   - Label-correcting: each improvement costs O(k) for k breakpoints;
+//     in practice a small multiple of Dijkstra pops.
+func (n *network) arrivalProfile(source, target int, from, to float64) (*Profile, error) {
+	labels := make([][]Breakpoint, len(n.ids))
+	versions := make([]int, len(n.ids))
+
+	labels[source] = []Breakpoint{{Time: from}}
+	if to > from {
+		labels[source] = append(labels[source], Breakpoint{Time: to})
+	}
+
+	queue := timeQueue{{vertex: source}}
+	for queue.Len() > 0 {
+		if err := n.config.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		entry := heap.Pop(&queue).(item)
+		if entry.version != versions[entry.vertex] {
+			continue
+		}
+		if labels[target] != nil && entry.key >= maxDuration(labels[target]) {
+			break
+		}
+
+		for _, a := range n.outgoing[entry.vertex] {
+			candidate, err := link(labels[entry.vertex], a.travel)
+			if err != nil {
+				return nil, fmt.Errorf("%w: edge_id=%q", err, a.edgeID)
+			}
+			merged, improved := lowerEnvelope(labels[a.to], candidate)
+			if !improved {
+				continue
+			}
+			if n.config.MaxBreakpoints > 0 && len(merged) > n.config.MaxBreakpoints {
+				return nil, fmt.Errorf("%w: %d breakpoints at %q", ErrBreakpointLimit, len(merged), n.ids[a.to])
+			}
+
+			labels[a.to] = merged
+			versions[a.to]++
+			heap.Push(&queue, item{key: minDuration(merged), vertex: a.to, version: versions[a.to]})
+		}
+	}
+
+	return &Profile{
+		SourceID: n.ids[source],
+		TargetID: n.ids[target],
+		From:     from,
+		To:       to,
+		points:   labels[target],
+	}, nil
+}
+
+// link composes a label with an arc function: the travel time of leaving the
+// source at t, reaching the arc tail after label(t), and traversing the arc.
+//
+// Implementation:
+//   - Stage 1: Keep every label breakpoint.
+//   - Stage 2: Inside each label segment, add the departures whose tail arrival
+//     hits an arc breakpoint; the tail arrival is non-decreasing under FIFO.
+//
+// Behavior highlights:
+//   - Between consecutive output breakpoints both parts are linear, so the
+//     composition is exact.
+//
+// Errors:
+//   - ErrOverflow if a composed duration is infinite.
+//
+// Complexity:
+//   - Time O(k + m log m) for k label and m arc breakpoints, Space O(k + m).
+func link(label []Breakpoint, travel *TravelTime) ([]Breakpoint, error) {
+	arcPoints := travel.points
+	out := make([]Breakpoint, 0, len(label)+len(arcPoints))
+
+	for index, point := range label {
+		arrival := point.Time + point.Duration
+		out = append(out, Breakpoint{Time: point.Time, Duration: point.Duration + travel.At(arrival)})
+		if index+1 == len(label) {
+			break
+		}
+
+		next := label[index+1]
+		nextArrival := next.Time + next.Duration
+		if nextArrival <= arrival {
+			continue
+		}
+		first := sort.Search(len(arcPoints), func(i int) bool { return arcPoints[i].Time > arrival })
+		for _, knot := range arcPoints[first:] {
+			if knot.Time >= nextArrival {
+				break
+			}
+			t := point.Time + (knot.Time-arrival)*(next.Time-point.Time)/(nextArrival-arrival)
+			if t <= point.Time || t >= next.Time {
+				continue
+			}
+			out = append(out, Breakpoint{Time: t, Duration: knot.Time - t + knot.Duration})
+		}
+	}
+
+	for _, point := range out {
+		if math.IsInf(point.Duration, 0) || math.IsInf(point.Time+point.Duration, 0) {
+			return nil, ErrOverflow
+		}
+	}
+
+	return simplify(out), nil
+}
+
+// lowerEnvelope returns min(current, candidate) over their shared domain and
+// whether candidate is better anywhere beyond rounding noise.
+//
+// Implementation:
+//   - Stage 1: Merge both breakpoint sets; both functions are linear between
+//     consecutive merged times.
+//   - Stage 2: Report improvement if candidate is lower at some merged time.
+//   - Stage 3: Emit the minimum, adding each crossing point.
+//
+// Complexity:
+//   - Time O((k + m) log(k + m)), Space O(k + m).
+func lowerEnvelope(current, candidate []Breakpoint) ([]Breakpoint, bool) {
+	if current == nil {
+		return candidate, true
+	}
+
+	times := make([]float64, 0, len(current)+len(candidate))
+	for left, right := 0, 0; left < len(current) || right < len(candidate); {
+		var t float64
+		switch {
+		case right == len(candidate) || (left < len(current) && current[left].Time < candidate[right].Time):
+			t = current[left].Time
+			left++
+		case left == len(current) || candidate[right].Time < current[left].Time:
+			t = candidate[right].Time
+			right++
+		default:
+			t = current[left].Time
+			left++
+			right++
+		}
+		times = append(times, t)
+	}
+
+	currentValues := make([]float64, len(times))
+	candidateValues := make([]float64, len(times))
+	improved := false
+	for index, t := range times {
+		currentValues[index] = evaluate(current, t)
+		candidateValues[index] = evaluate(candidate, t)
+		if candidateValues[index] < currentValues[index]-profileTolerance*(1+math.Abs(currentValues[index])) {
+			improved = true
+		}
+	}
+	if !improved {
+		return current, false
+	}
+
+	out := make([]Breakpoint, 0, 2*len(times))
+	for index, t := range times {
+		out = append(out, Breakpoint{Time: t, Duration: math.Min(currentValues[index], candidateValues[index])})
+		if index+1 == len(times) {
+			break
+		}
+
+		gap, nextGap := currentValues[index]-candidateValues[index], currentValues[index+1]-candidateValues[index+1]
+		if (gap < 0 && nextGap > 0) || (gap > 0 && nextGap < 0) {
+			fraction := gap / (gap - nextGap)
+			crossing := t + (times[index+1]-t)*fraction
+			if crossing > t && crossing < times[index+1] {
+				value := currentValues[index] + (currentValues[index+1]-currentValues[index])*fraction
+				out = append(out, Breakpoint{Time: crossing, Duration: value})
+			}
+		}
+	}
+
+	return simplify(out), true
+}
+
+// simplify drops breakpoints that lie on the segment between their kept
+// predecessor and their successor, within profileTolerance.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func simplify(points []Breakpoint) []Breakpoint {
+	if len(points) <= 2 {
+		return points
+	}
+
+	kept := points[:1]
+	for index := 1; index < len(points)-1; index++ {
+		anchor, point, next := kept[len(kept)-1], points[index], points[index+1]
+		if point.Time <= anchor.Time {
+			continue
+		}
+		line := interpolate(anchor, next, point.Time)
+		if math.Abs(line-point.Duration) <= profileTolerance*(1+math.Abs(point.Duration)) {
+			continue
+		}
+		kept = append(kept, point)
+	}
+
+	return append(kept, points[len(points)-1])
+}
+
+// minDuration returns the smallest duration of a non-empty breakpoint list.
+func minDuration(points []Breakpoint) float64 {
+	least := points[0].Duration
+	for _, point := range points[1:] {
+		least = math.Min(least, point.Duration)
+	}
+
+	return least
+}
+
+// maxDuration returns the largest duration of a non-empty breakpoint list.
+func maxDuration(points []Breakpoint) float64 {
+	most := points[0].Duration
+	for _, point := range points[1:] {
+		most = math.Max(most, point.Duration)
+	}
+
+	return most
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// arc is one traversal direction of an edge with its travel-time function.
+type arc struct {
+	edgeID string
+	to     int
+	travel *TravelTime
+}
+
+// item is one queue entry: a vertex keyed by a time value. version lets the
+// profile search discard entries superseded by a later improvement.
+type item struct {
+	key     float64
+	vertex  int
+	version int
+}
+
+// timeQueue is a min-heap of items ordered by (key, vertex).
+type timeQueue []item
+
+func (q timeQueue) Len() int { return len(q) }
+
+func (q timeQueue) Less(i, j int) bool {
+	if q[i].key != q[j].key {
+		return q[i].key < q[j].key
+	}
+
+	return q[i].vertex < q[j].vertex
+}
+
+func (q timeQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *timeQueue) Push(x any) { *q = append(*q, x.(item)) }
+
+func (q *timeQueue) Pop() any {
+	old := *q
+	entry := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return entry
+}
+
+// network is the dense snapshot shared by both searches.
+//
+// AI-Hints:
+//   - The travel-time callback is called once per edge; searches never call it.
+type network struct {
+	ids      []string
+	index    map[string]int
+	outgoing [][]arc
+	config   Options
+}
+
+// validateEndpoint checks one endpoint identifier against g.
+//
+// Errors:
+//   - emptyErr or missingErr.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func validateEndpoint(g *core.Graph, vertexID string, emptyErr, missingErr error) error {
+	if vertexID == "" {
+		return emptyErr
+	}
+	if !g.HasVertex(vertexID) {
+		return missingErr
+	}
+
+	return nil
+}
+
+// newNetwork snapshots g into dense outgoing arcs.
+//
+// Implementation:
+//   - Stage 1: Index vertices in g.Vertices() order.
+//   - Stage 2: Resolve each edge's travel-time function once, in g.Edges() order.
+//   - Stage 3: Emit one arc per traversal direction (a loop gives one).
+//
+// Errors:
+//   - ErrNilTravelTime, wrapped with the edge ID.
+//
+// Complexity:
+//   - Time O(V log V + E log E) for the sorted core surfaces, Space O(V + E).
+func newNetwork(g *core.Graph, config Options) (*network, error) {
+	ids := g.Vertices()
+	index := make(map[string]int, len(ids))
+	for position, vertexID := range ids {
+		index[vertexID] = position
+	}
+
+	n := &network{
+		ids:      ids,
+		index:    index,
+		outgoing: make([][]arc, len(ids)),
+		config:   config,
+	}
+
+	for _, edge := range g.Edges() {
+		travel := config.TravelTime(*edge)
+		if travel == nil || len(travel.points) == 0 {
+			return nil, fmt.Errorf("%w: edge_id=%q", ErrNilTravelTime, edge.ID)
+		}
+
+		from, to := index[edge.From], index[edge.To]
+		n.outgoing[from] = append(n.outgoing[from], arc{edgeID: edge.ID, to: to, travel: travel})
+		if !edge.Directed && from != to {
+			n.outgoing[to] = append(n.outgoing[to], arc{edgeID: edge.ID, to: from, travel: travel})
+		}
+	}
+
+	return n, nil
+}
+
+// earliestArrival runs time-dependent Dijkstra from source leaving at departure.
+//
+// Implementation:
+//   - Stage 1: Seed the source at the departure time.
+//   - Stage 2: Pop the earliest tentative arrival; it is final under FIFO.
+//   - Stage 3: Relax each arc with arrival = t + travel.At(t); keep strict improvements.
+//
+// Behavior highlights:
+//   - FIFO makes the arrival function of each arc non-decreasing, so an earlier
+//     arrival at a vertex is never worse downstream and waiting never helps.
+//
+// Errors:
+//   - ErrOverflow if an arrival time leaves the finite range.
+//   - ctx.Err() on cancellation.
+//
+// Determinism:
+//   - Ties are broken by vertex index, then by arc order (strict improvement only).
+//
+// Complexity:
+//   - Time O((V + E) log V) plus O(log k) per arc evaluation, Space O(V).
+func (n *network) earliestArrival(source int, departure float64) (*Result, error) {
+	arrivals := make([]float64, len(n.ids))
+	for position := range arrivals {
+		arrivals[position] = math.Inf(1)
+	}
+	parent := make([]int, len(n.ids))
+	parentEdge := make([]string, len(n.ids))
+	settled := make([]bool, len(n.ids))
+
+	arrivals[source] = departure
+	parent[source] = -1
+	queue := timeQueue{{key: departure, vertex: source}}
+	for queue.Len() > 0 {
+		if err := n.config.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		entry := heap.Pop(&queue).(item)
+		if settled[entry.vertex] {
+			continue
+		}
+		settled[entry.vertex] = true
+
+		for _, a := range n.outgoing[entry.vertex] {
+			if settled[a.to] {
+				continue
+			}
+			candidate := entry.key + a.travel.At(entry.key)
+			if math.IsInf(candidate, 1) {
+				return nil, fmt.Errorf("%w: edge_id=%q departure=%g", ErrOverflow, a.edgeID, entry.key)
+			}
+			if candidate < arrivals[a.to] {
+				arrivals[a.to] = candidate
+				parent[a.to] = entry.vertex
+				parentEdge[a.to] = a.edgeID
+				heap.Push(&queue, item{key: candidate, vertex: a.to})
+			}
+		}
+	}
+
+	result := &Result{
+		SourceID:  n.ids[source],
+		Departure: departure,
+		Arrivals:  make(map[string]float64, len(n.ids)),
+		Prev:      make(map[string]string, len(n.ids)),
+		PrevEdge:  make(map[string]string, len(n.ids)),
+	}
+	for position, vertexID := range n.ids {
+		result.Arrivals[vertexID] = arrivals[position]
+		result.Prev[vertexID] = ""
+		if position != source && !math.IsInf(arrivals[position], 1) {
+			result.Prev[vertexID] = n.ids[parent[position]]
+			result.PrevEdge[vertexID] = parentEdge[position]
+		}
+	}
+
+	return result, nil
+}
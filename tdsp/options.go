@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp
+
+import (
+	"context"
+
+	"github.com/katalvlaran/lvlath/core"
+)
+
+// Options holds the effective policy of one time-dependent search.
+//
+// AI-Hints:
+//   - Configure through WithXxx options; the zero value has no travel-time callback.
+type Options struct {
+	// TravelTime returns the travel-time function of an edge.
+	TravelTime func(edge core.Edge) *TravelTime
+
+	// MaxBreakpoints bounds the size of any profile label; 0 means unlimited.
+	MaxBreakpoints int
+
+	// ctx allows cancellation between queue pops.
+	ctx context.Context
+}
+
+// Option configures a time-dependent search through a safe, error-returning option model.
+type Option func(*Options) error
+
+// DefaultOptions returns the canonical policy: no travel-time callback,
+// unlimited breakpoints, and context.Background().
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func DefaultOptions() Options {
+	return Options{
+		ctx: context.Background(),
+	}
+}
+
+// WithTravelTime sets the per-edge travel-time callback. It is required.
+//
+// Behavior highlights:
+//   - Called once per edge during the snapshot; undirected edges use the same
+//     function in both directions.
+//
+// Errors:
+//   - ErrNilTravelTime if travelTime is nil.
+//
+// AI-Hints:
+//   - Build functions once and look them up by Edge.ID:
+//     WithTravelTime(func(e core.Edge) *tdsp.TravelTime { return schedule[e.ID] }).
+func WithTravelTime(travelTime func(edge core.Edge) *TravelTime) Option {
+	return func(o *Options) error {
+		if travelTime == nil {
+			return ErrNilTravelTime
+		}
+		o.TravelTime = travelTime
+		return nil
+	}
+}
+
+// WithMaxBreakpoints bounds the number of breakpoints of any profile label.
+//
+// Errors:
+//   - ErrBadMaxBreakpoints if limit is negative. 0 means unlimited.
+//
+// AI-Hints:
+//   - Only ArrivalProfile is affected; EarliestArrival works on scalars.
+func WithMaxBreakpoints(limit int) Option {
+	return func(o *Options) error {
+		if limit < 0 {
+			return ErrBadMaxBreakpoints
+		}
+		o.MaxBreakpoints = limit
+		return nil
+	}
+}
+
+// WithContext sets the context used for cancellation.
+//
+// Errors:
+//   - ErrNilContext if ctx is nil.
+//
+// AI-Hints:
+//   - Cancellation surfaces as ctx.Err() with no partial result.
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// applyOptions applies opts in order on top of DefaultOptions.
+//
+// Errors:
+//   - ErrNilOption for nil options; any error returned by an option.
+//   - ErrMissingTravelTime when no travel-time callback was configured.
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func applyOptions(opts ...Option) (Options, error) {
+	config := DefaultOptions()
+
+	for _, opt := range opts {
+		if opt == nil {
+			return Options{}, ErrNilOption
+		}
+		if err := opt(&config); err != nil {
+			return Options{}, err
+		}
+	}
+	if config.TravelTime == nil {
+		return Options{}, ErrMissingTravelTime
+	}
+
+	return config, nil
+}
@@ -0,0 +1,350 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/core"
+	"github.com/katalvlaran/lvlath/tdsp"
+)
+
+// AI-HINTS (file):
+//   - EarliestArrival is checked against a Bellman-Ford style fixpoint over
+//     scalar arrival times, which needs no ordering argument at all.
+//   - ArrivalProfile is checked by sampling: at every arc breakpoint and on a
+//     dense grid, the profile must equal a fresh EarliestArrival run.
+
+const tolerance = 1e-6
+
+// randomTravelTime returns a FIFO function with a few breakpoints in [0, 100].
+func randomTravelTime(t *testing.T, rng *rand.Rand) *tdsp.TravelTime {
+	t.Helper()
+
+	var points []tdsp.Breakpoint
+	clock := float64(rng.Intn(20))
+	duration := float64(1 + rng.Intn(20))
+	for count := 1 + rng.Intn(5); count > 0; count-- {
+		points = append(points, tdsp.Breakpoint{Time: clock, Duration: duration})
+		gap := float64(1 + rng.Intn(25))
+		clock += gap
+		duration = math.Max(1, math.Max(duration-gap, duration+float64(rng.Intn(31)-15)))
+	}
+
+	travel, err := tdsp.NewTravelTime(points...)
+	if err != nil {
+		t.Fatalf("NewTravelTime failed: %v", err)
+	}
+
+	return travel
+}
+
+// buildRandomNetwork returns a mixed multigraph and its travel-time functions.
+func buildRandomNetwork(t *testing.T, seed int64, vertexCount, edgeCount int) (*core.Graph, map[string]*tdsp.TravelTime) {
+	t.Helper()
+
+	graph, err := core.NewGraph(core.WithDirected(true), core.WithMixedEdges(), core.WithMultiEdges())
+	if err != nil {
+		t.Fatalf("NewGraph failed: %v", err)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	for index := 0; index < vertexCount; index++ {
+		if err = graph.AddVertex(fmt.Sprintf("v%02d", index)); err != nil {
+			t.Fatalf("AddVertex failed: %v", err)
+		}
+	}
+
+	functions := make(map[string]*tdsp.TravelTime)
+	for index := 0; index < edgeCount; index++ {
+		from, to := rng.Intn(vertexCount), rng.Intn(vertexCount)
+		if from == to {
+			continue
+		}
+		edgeID, err := graph.AddEdge(fmt.Sprintf("v%02d", from), fmt.Sprintf("v%02d", to), 0,
+			core.WithEdgeDirected(rng.Intn(3) != 0))
+		if err != nil {
+			t.Fatalf("AddEdge failed: %v", err)
+		}
+		functions[edgeID] = randomTravelTime(t, rng)
+	}
+
+	return graph, functions
+}
+
+// arrivalOracle relaxes every traversal direction until no arrival improves.
+func arrivalOracle(graph *core.Graph, functions map[string]*tdsp.TravelTime, sourceID string, departure float64) map[string]float64 {
+	arrivals := make(map[string]float64)
+	for _, vertexID := range graph.Vertices() {
+		arrivals[vertexID] = math.Inf(1)
+	}
+	arrivals[sourceID] = departure
+
+	relax := func(from, to string, travel *tdsp.TravelTime) bool {
+		if math.IsInf(arrivals[from], 1) {
+			return false
+		}
+		candidate := arrivals[from] + travel.At(arrivals[from])
+		if candidate < arrivals[to] {
+			arrivals[to] = candidate
+			return true
+		}
+		return false
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, edge := range graph.Edges() {
+			if relax(edge.From, edge.To, functions[edge.ID]) {
+				changed = true
+			}
+			if !edge.Directed && relax(edge.To, edge.From, functions[edge.ID]) {
+				changed = true
+			}
+		}
+	}
+
+	return arrivals
+}
+
+// TestEarliestArrival_MatchesOracle verifies arrival times and replays routes.
+//
+// Implementation:
+//   - Stage 1: Build random mixed multigraphs with random FIFO functions.
+//   - Stage 2: For several departures compare every arrival with the fixpoint.
+//   - Stage 3: Replay each route edge by edge and compare the final arrival.
+func TestEarliestArrival_MatchesOracle(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		graph, functions := buildRandomNetwork(t, seed, 10, 26)
+		option := tdsp.WithTravelTime(func(edge core.Edge) *tdsp.TravelTime { return functions[edge.ID] })
+		sourceID := graph.Vertices()[0]
+
+		for _, departure := range []float64{-10, 0, 7.5, 33, 80, 200} {
+			want := arrivalOracle(graph, functions, sourceID, departure)
+			res, err := tdsp.EarliestArrival(graph, sourceID, departure, option)
+			if err != nil {
+				t.Fatalf("seed %d: EarliestArrival failed: %v", seed, err)
+			}
+
+			for vertexID, arrival := range want {
+				got := res.Arrivals[vertexID]
+				if math.IsInf(arrival, 1) {
+					if !math.IsInf(got, 1) {
+						t.Fatalf("seed %d dep %v %q: got %v want +Inf", seed, departure, vertexID, got)
+					}
+					_, err = res.PathTo(vertexID)
+					if !errors.Is(err, tdsp.ErrNoPath) {
+						t.Fatalf("unreachable PathTo err=%v", err)
+					}
+					continue
+				}
+				if math.Abs(got-arrival) > tolerance {
+					t.Fatalf("seed %d dep %v %q: got %v want %v", seed, departure, vertexID, got, arrival)
+				}
+
+				vertexIDs, err := res.PathTo(vertexID)
+				if err != nil {
+					t.Fatalf("PathTo(%q) failed: %v", vertexID, err)
+				}
+				edgeIDs, err := res.EdgePathTo(vertexID)
+				if err != nil || len(edgeIDs) != len(vertexIDs)-1 {
+					t.Fatalf("EdgePathTo(%q) = %v, %v", vertexID, edgeIDs, err)
+				}
+				clock := departure
+				for _, edgeID := range edgeIDs {
+					clock += functions[edgeID].At(clock)
+				}
+				if clock != got {
+					t.Fatalf("route replay to %q arrives %v, reported %v", vertexID, clock, got)
+				}
+			}
+		}
+	}
+}
+
+// TestArrivalProfile_MatchesSampling verifies the profile against per-departure runs.
+//
+// Implementation:
+//   - Stage 1: Build random networks and pick several source-target pairs.
+//   - Stage 2: Sample every arc breakpoint time and a dense grid in [from, to].
+//   - Stage 3: Compare the profile with EarliestArrival; Best must be a minimum.
+func TestArrivalProfile_MatchesSampling(t *testing.T) {
+	const from, to = 0.0, 120.0
+
+	for seed := int64(1); seed <= 5; seed++ {
+		graph, functions := buildRandomNetwork(t, seed, 9, 24)
+		option := tdsp.WithTravelTime(func(edge core.Edge) *tdsp.TravelTime { return functions[edge.ID] })
+
+		samples := []float64{from, to}
+		for step := 0; step <= 480; step++ {
+			samples = append(samples, from+(to-from)*float64(step)/480)
+		}
+		for _, travel := range functions {
+			for _, point := range travel.Breakpoints() {
+				if point.Time >= from && point.Time <= to {
+					samples = append(samples, point.Time)
+				}
+			}
+		}
+
+		vertices := graph.Vertices()
+		for _, sourceID := range vertices[:2] {
+			for _, targetID := range vertices[len(vertices)-3:] {
+				profile, err := tdsp.ArrivalProfile(graph, sourceID, targetID, from, to, option)
+				if err != nil {
+					t.Fatalf("seed %d: ArrivalProfile failed: %v", seed, err)
+				}
+				bestDeparture, bestTravel := profile.Best()
+
+				for _, departure := range samples {
+					res, err := tdsp.EarliestArrival(graph, sourceID, departure, option)
+					if err != nil {
+						t.Fatalf("EarliestArrival failed: %v", err)
+					}
+					want := res.Arrivals[targetID] - departure
+					got, err := profile.TravelTimeAt(departure)
+					if err != nil {
+						t.Fatalf("TravelTimeAt failed: %v", err)
+					}
+					if math.IsInf(want, 1) != math.IsInf(got, 1) || (!math.IsInf(want, 1) && math.Abs(got-want) > tolerance) {
+						t.Fatalf("seed %d %s->%s at %v: profile %v want %v", seed, sourceID, targetID, departure, got, want)
+					}
+					if want < bestTravel-tolerance {
+						t.Fatalf("Best() = (%v, %v) but departing at %v takes %v", bestDeparture, bestTravel, departure, want)
+					}
+				}
+				if profile.Reachable() {
+					check, _ := profile.TravelTimeAt(bestDeparture)
+					if math.Abs(check-bestTravel) > tolerance {
+						t.Fatalf("Best() is not on the profile: %v vs %v", check, bestTravel)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestTravelTime_Contract verifies construction, FIFO checking, and evaluation.
+//
+// Implementation:
+//   - Stage 1: Evaluate a two-segment function, including both extrapolations.
+//   - Stage 2: Assert every construction sentinel.
+func TestTravelTime_Contract(t *testing.T) {
+	travel, err := tdsp.NewTravelTime(
+		tdsp.Breakpoint{Time: 10, Duration: 5},
+		tdsp.Breakpoint{Time: 20, Duration: 15},
+		tdsp.Breakpoint{Time: 30, Duration: 6},
+	)
+	if err != nil {
+		t.Fatalf("NewTravelTime failed: %v", err)
+	}
+	for departure, want := range map[float64]float64{0: 5, 10: 5, 15: 10, 25: 10.5, 30: 6, 99: 6} {
+		if got := travel.At(departure); got != want {
+			t.Fatalf("At(%v) = %v want %v", departure, got, want)
+		}
+	}
+
+	cases := []struct {
+		name   string
+		err    error
+		points []tdsp.Breakpoint
+	}{
+		{"empty", tdsp.ErrEmptyTravelTime, nil},
+		{"nan time", tdsp.ErrInvalidTravelTime, []tdsp.Breakpoint{{Time: math.NaN(), Duration: 1}}},
+		{"negative duration", tdsp.ErrInvalidTravelTime, []tdsp.Breakpoint{{Time: 0, Duration: -1}}},
+		{"infinite duration", tdsp.ErrInvalidTravelTime, []tdsp.Breakpoint{{Time: 0, Duration: math.Inf(1)}}},
+		{"unsorted", tdsp.ErrInvalidTravelTime, []tdsp.Breakpoint{{Time: 5, Duration: 1}, {Time: 5, Duration: 1}}},
+		{"overtaking", tdsp.ErrNotFIFO, []tdsp.Breakpoint{{Time: 0, Duration: 10}, {Time: 2, Duration: 1}}},
+	}
+	for _, tc := range cases {
+		if _, err = tdsp.NewTravelTime(tc.points...); !errors.Is(err, tc.err) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.err)
+		}
+	}
+}
+
+// TestSearch_Validation verifies the entry-point sentinels, the breakpoint
+// limit, and the unreachable profile.
+//
+// Implementation:
+//   - Stage 1: A two-vertex network plus an isolated vertex.
+//   - Stage 2: Assert each sentinel through a table of closures.
+//   - Stage 3: An unreachable target yields a +Inf profile.
+func TestSearch_Validation(t *testing.T) {
+	graph, _ := core.NewGraph(core.WithDirected(true))
+	edgeID, _ := graph.AddEdge("A", "B", 0)
+	_ = graph.AddVertex("Z")
+	peak, _ := tdsp.NewTravelTime(tdsp.Breakpoint{Time: 0, Duration: 4}, tdsp.Breakpoint{Time: 10, Duration: 9})
+	option := tdsp.WithTravelTime(func(edge core.Edge) *tdsp.TravelTime {
+		if edge.ID == edgeID {
+			return peak
+		}
+		return nil
+	})
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{"nil graph", tdsp.ErrNilGraph, func() error { _, e := tdsp.EarliestArrival(nil, "A", 0, option); return e }},
+		{"empty source", tdsp.ErrEmptySourceID, func() error { _, e := tdsp.EarliestArrival(graph, "", 0, option); return e }},
+		{"missing source", tdsp.ErrSourceNotFound, func() error { _, e := tdsp.EarliestArrival(graph, "Q", 0, option); return e }},
+		{"bad departure", tdsp.ErrBadDeparture, func() error { _, e := tdsp.EarliestArrival(graph, "A", math.NaN(), option); return e }},
+		{"no function", tdsp.ErrMissingTravelTime, func() error { _, e := tdsp.EarliestArrival(graph, "A", 0); return e }},
+		{"nil function", tdsp.ErrNilTravelTime, func() error { _, e := tdsp.EarliestArrival(graph, "A", 0, tdsp.WithTravelTime(nil)); return e }},
+		{"nil option", tdsp.ErrNilOption, func() error { _, e := tdsp.EarliestArrival(graph, "A", 0, nil); return e }},
+		{"nil context", tdsp.ErrNilContext, func() error {
+			_, e := tdsp.EarliestArrival(graph, "A", 0, option, tdsp.WithContext(nil)) //nolint:staticcheck // deliberate nil context
+			return e
+		}},
+		{"cancelled", context.Canceled, func() error {
+			_, e := tdsp.EarliestArrival(graph, "A", 0, option, tdsp.WithContext(cancelled))
+			return e
+		}},
+		{"empty target", tdsp.ErrEmptyTargetID, func() error { _, e := tdsp.ArrivalProfile(graph, "A", "", 0, 1, option); return e }},
+		{"missing target", tdsp.ErrTargetNotFound, func() error { _, e := tdsp.ArrivalProfile(graph, "A", "Q", 0, 1, option); return e }},
+		{"bad interval", tdsp.ErrBadInterval, func() error { _, e := tdsp.ArrivalProfile(graph, "A", "B", 5, 1, option); return e }},
+		{"bad limit", tdsp.ErrBadMaxBreakpoints, func() error {
+			_, e := tdsp.ArrivalProfile(graph, "A", "B", 0, 1, option, tdsp.WithMaxBreakpoints(-1))
+			return e
+		}},
+		{"limit", tdsp.ErrBreakpointLimit, func() error {
+			_, e := tdsp.ArrivalProfile(graph, "A", "B", 0, 10, option, tdsp.WithMaxBreakpoints(1))
+			return e
+		}},
+	}
+	for _, tc := range cases {
+		if err := tc.run(); !errors.Is(err, tc.err) {
+			t.Fatalf("%s: err=%v want %v", tc.name, err, tc.err)
+		}
+	}
+
+	_ = graph.AddVertex("C")
+	_, _ = graph.AddEdge("Z", "C", 0)
+	_, err := tdsp.EarliestArrival(graph, "A", 0, option)
+	if !errors.Is(err, tdsp.ErrNilTravelTime) {
+		t.Fatalf("callback returning nil: err=%v", err)
+	}
+
+	profile, err := tdsp.ArrivalProfile(graph, "A", "Z", 0, 10, tdsp.WithTravelTime(func(core.Edge) *tdsp.TravelTime { return peak }))
+	if err != nil || profile.Reachable() {
+		t.Fatalf("unreachable profile: %+v, %v", profile, err)
+	}
+	travel, err := profile.TravelTimeAt(3)
+	if err != nil || !math.IsInf(travel, 1) {
+		t.Fatalf("unreachable TravelTimeAt = %v, %v", travel, err)
+	}
+	if _, err = profile.TravelTimeAt(11); !errors.Is(err, tdsp.ErrOutsideInterval) {
+		t.Fatalf("outside interval: err=%v", err)
+	}
+}
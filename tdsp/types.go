@@ -0,0 +1,346 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+package tdsp
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Breakpoint is one sample of a piecewise-linear travel-time function: leaving
+// at Time takes Duration.
+type Breakpoint struct {
+	// Time is the departure time at the edge tail.
+	Time float64
+
+	// Duration is the travel time when departing at Time.
+	Duration float64
+}
+
+// TravelTime is an immutable FIFO piecewise-linear travel-time function.
+//
+// Behavior highlights:
+//   - Durations are interpolated linearly between breakpoints and held constant
+//     before the first and after the last one.
+//   - FIFO holds: Time + Duration never decreases, so leaving later never
+//     arrives earlier.
+//
+// AI-Hints:
+//   - Periodic (daily) schedules are expressed by unrolling the period over the
+//     planning horizon; the function itself is not periodic.
+type TravelTime struct {
+	points []Breakpoint
+}
+
+// NewTravelTime validates and copies points into a travel-time function.
+//
+// Implementation:
+//   - Stage 1: Reject an empty list.
+//   - Stage 2: Check every breakpoint for finite values and a non-negative duration.
+//   - Stage 3: Check strictly increasing times and the FIFO property per segment.
+//
+// Inputs:
+//   - points: breakpoints in strictly increasing Time order.
+//
+// Returns:
+//   - *TravelTime: a detached function.
+//
+// Errors:
+//   - ErrEmptyTravelTime if points is empty.
+//   - ErrInvalidTravelTime, wrapped with the breakpoint index.
+//   - ErrNotFIFO, wrapped with the segment index.
+//
+// Complexity:
+//   - Time O(k), Space O(k) for k breakpoints.
+//
+// AI-Hints:
+//   - NewTravelTime(Breakpoint{Duration: w}) is the static edge of weight w.
+func NewTravelTime(points ...Breakpoint) (*TravelTime, error) {
+	if len(points) == 0 {
+		return nil, ErrEmptyTravelTime
+	}
+
+	for index, point := range points {
+		if math.IsNaN(point.Time) || math.IsInf(point.Time, 0) {
+			return nil, fmt.Errorf("%w: breakpoint %d time=%g", ErrInvalidTravelTime, index, point.Time)
+		}
+		if math.IsNaN(point.Duration) || math.IsInf(point.Duration, 0) || point.Duration < 0 {
+			return nil, fmt.Errorf("%w: breakpoint %d duration=%g", ErrInvalidTravelTime, index, point.Duration)
+		}
+		if index == 0 {
+			continue
+		}
+
+		previous := points[index-1]
+		if point.Time <= previous.Time {
+			return nil, fmt.Errorf("%w: breakpoint %d time=%g not after %g", ErrInvalidTravelTime, index, point.Time, previous.Time)
+		}
+		if point.Time+point.Duration < previous.Time+previous.Duration {
+			return nil, fmt.Errorf("%w: segment %d arrives at %g after departing later than one arriving at %g",
+				ErrNotFIFO, index-1, point.Time+point.Duration, previous.Time+previous.Duration)
+		}
+	}
+
+	return &TravelTime{points: append([]Breakpoint(nil), points...)}, nil
+}
+
+// At returns the travel time when departing at departure.
+//
+// Complexity:
+//   - Time O(log k), Space O(1).
+func (f *TravelTime) At(departure float64) float64 {
+	return evaluate(f.points, departure)
+}
+
+// Breakpoints returns a copy of the defining breakpoints.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func (f *TravelTime) Breakpoints() []Breakpoint {
+	return append([]Breakpoint(nil), f.points...)
+}
+
+// evaluate interpolates points at t with constant extrapolation at both ends.
+func evaluate(points []Breakpoint, t float64) float64 {
+	last := len(points) - 1
+	if t <= points[0].Time {
+		return points[0].Duration
+	}
+	if t >= points[last].Time {
+		return points[last].Duration
+	}
+
+	upper := sort.Search(len(points), func(i int) bool { return points[i].Time > t })
+
+	return interpolate(points[upper-1], points[upper], t)
+}
+
+// interpolate evaluates the segment a-b at t, a.Time <= t <= b.Time.
+func interpolate(a, b Breakpoint, t float64) float64 {
+	if t == a.Time {
+		return a.Duration
+	}
+	if t == b.Time {
+		return b.Duration
+	}
+
+	return a.Duration + (b.Duration-a.Duration)*(t-a.Time)/(b.Time-a.Time)
+}
+
+// Result is the outcome of EarliestArrival: the earliest arrival time at every
+// vertex for one departure time at the source, with the witness tree.
+//
+// Behavior highlights:
+//   - Arrivals[source] = Departure; +Inf marks unreachable vertices.
+//   - Prev and PrevEdge describe one earliest-arrival route per reached vertex;
+//     departing each hop immediately is optimal under FIFO.
+//
+// Errors:
+//   - Query methods return ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound,
+//     and ErrNoPath for path queries to unreachable vertices.
+//
+// Complexity:
+//   - ArrivalTo is O(1); PathTo and EdgePathTo are O(k) for a k-vertex route.
+//
+// AI-Hints:
+//   - Travel time to v is Arrivals[v] - Departure.
+type Result struct {
+	SourceID  string
+	Departure float64
+	Arrivals  map[string]float64
+	Prev      map[string]string
+	PrevEdge  map[string]string
+}
+
+// ArrivalTo returns the earliest arrival time at vertexID; +Inf means unreachable.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func (r *Result) ArrivalTo(vertexID string) (float64, error) {
+	if r == nil {
+		return 0, ErrNilResult
+	}
+	if vertexID == "" {
+		return 0, ErrEmptyTargetID
+	}
+
+	arrival, ok := r.Arrivals[vertexID]
+	if !ok {
+		return 0, ErrTargetNotFound
+	}
+
+	return arrival, nil
+}
+
+// PathTo reconstructs the earliest-arrival route from the source to vertexID.
+//
+// Errors:
+//   - ErrNilResult, ErrEmptyTargetID, ErrTargetNotFound.
+//   - ErrNoPath if vertexID is unreachable.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func (r *Result) PathTo(vertexID string) ([]string, error) {
+	vertexIDs, _, err := r.trace(vertexID)
+
+	return vertexIDs, err
+}
+
+// EdgePathTo returns the edge IDs of the earliest-arrival route to vertexID.
+//
+// Behavior highlights:
+//   - Parallel edges are distinguished; the source route is empty.
+//
+// Errors:
+//   - Same as PathTo.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func (r *Result) EdgePathTo(vertexID string) ([]string, error) {
+	_, edgeIDs, err := r.trace(vertexID)
+
+	return edgeIDs, err
+}
+
+// trace walks Prev and PrevEdge back from vertexID to the source.
+func (r *Result) trace(vertexID string) ([]string, []string, error) {
+	arrival, err := r.ArrivalTo(vertexID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if math.IsInf(arrival, 1) {
+		return nil, nil, ErrNoPath
+	}
+
+	vertexIDs := []string{vertexID}
+	edgeIDs := []string{}
+	for currentID := vertexID; currentID != r.SourceID; {
+		edgeIDs = append(edgeIDs, r.PrevEdge[currentID])
+		currentID = r.Prev[currentID]
+		vertexIDs = append(vertexIDs, currentID)
+	}
+	reverse(vertexIDs)
+	reverse(edgeIDs)
+
+	return vertexIDs, edgeIDs, nil
+}
+
+// reverse reverses ids in place.
+func reverse(ids []string) {
+	for left, right := 0, len(ids)-1; left < right; left, right = left+1, right-1 {
+		ids[left], ids[right] = ids[right], ids[left]
+	}
+}
+
+// Profile is the outcome of ArrivalProfile: the source-to-target travel time as
+// a piecewise-linear function of the departure time over [From, To].
+//
+// Behavior highlights:
+//   - The function is exact up to float64 rounding; it is the lower envelope
+//     of the travel times of every route.
+//   - An unreachable target gives a profile with +Inf everywhere.
+//
+// Errors:
+//   - Evaluation outside [From, To] returns ErrOutsideInterval.
+//
+// Complexity:
+//   - Evaluation is O(log k) for k breakpoints.
+//
+// AI-Hints:
+//   - Use EarliestArrival at the chosen departure time to obtain the route.
+type Profile struct {
+	SourceID string
+	TargetID string
+	From     float64
+	To       float64
+
+	points []Breakpoint
+}
+
+// Reachable reports whether the target can be reached at all.
+func (p *Profile) Reachable() bool {
+	return p != nil && len(p.points) > 0
+}
+
+// TravelTimeAt returns the travel time when departing the source at departure;
+// +Inf means unreachable.
+//
+// Errors:
+//   - ErrNilResult if the receiver is nil.
+//   - ErrOutsideInterval if departure is outside [From, To] or NaN.
+//
+// Complexity:
+//   - Time O(log k), Space O(1).
+func (p *Profile) TravelTimeAt(departure float64) (float64, error) {
+	if p == nil {
+		return 0, ErrNilResult
+	}
+	if !(departure >= p.From && departure <= p.To) {
+		return 0, fmt.Errorf("%w: %g not in [%g, %g]", ErrOutsideInterval, departure, p.From, p.To)
+	}
+	if len(p.points) == 0 {
+		return math.Inf(1), nil
+	}
+
+	return evaluate(p.points, departure), nil
+}
+
+// ArrivalAt returns departure + TravelTimeAt(departure).
+//
+// Errors:
+//   - Same as TravelTimeAt.
+//
+// Complexity:
+//   - Time O(log k), Space O(1).
+func (p *Profile) ArrivalAt(departure float64) (float64, error) {
+	travelTime, err := p.TravelTimeAt(departure)
+	if err != nil {
+		return 0, err
+	}
+
+	return departure + travelTime, nil
+}
+
+// Best returns the departure with the shortest travel time in [From, To] and
+// that travel time; the earliest such departure wins ties.
+//
+// Behavior highlights:
+//   - The minimum of a piecewise-linear function is attained at a breakpoint.
+//   - An unreachable target gives (From, +Inf).
+//
+// Complexity:
+//   - Time O(k), Space O(1).
+func (p *Profile) Best() (float64, float64) {
+	if !p.Reachable() {
+		if p == nil {
+			return 0, math.Inf(1)
+		}
+		return p.From, math.Inf(1)
+	}
+
+	best := p.points[0]
+	for _, point := range p.points[1:] {
+		if point.Duration < best.Duration {
+			best = point
+		}
+	}
+
+	return best.Time, best.Duration
+}
+
+// Breakpoints returns a copy of the profile breakpoints; nil if unreachable.
+//
+// Complexity:
+//   - Time O(k), Space O(k).
+func (p *Profile) Breakpoints() []Breakpoint {
+	if !p.Reachable() {
+		return nil
+	}
+
+	return append([]Breakpoint(nil), p.points...)
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (C) 2025-2026 katalvlaran
+
+// Package tsp_test pins the exact Christofides output on the existing fixtures.
+// The values were recorded from the Blossom engine and must not move when the
+// engine is refactored or extended; a change here is a behavior change. Small
+// fixtures pin the whole tour, large ones the cost and an FNV-1a fingerprint.
+package tsp_test
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/katalvlaran/lvlath/matrix"
+	"github.com/katalvlaran/lvlath/tsp"
+)
+
+// tourFingerprint hashes a tour with FNV-1a over its decimal vertex IDs.
+func tourFingerprint(tour []int) uint64 {
+	h := fnv.New64a()
+	for _, vertex := range tour {
+		_, _ = fmt.Fprintf(h, "%d,", vertex)
+	}
+
+	return h.Sum64()
+}
+
+func TestChristofidesBlossomPinnedOutputs(t *testing.T) {
+	t.Parallel()
+
+	octagon := make([][2]float64, 8)
+	for vertex := range octagon {
+		theta := 2 * math.Pi * float64(vertex) / 8
+		radius := 1.0 + 0.02*math.Sin(3*theta)
+		octagon[vertex] = [2]float64{radius * math.Cos(theta), radius * math.Sin(theta)}
+	}
+
+	cases := []struct {
+		name        string
+		dist        matrix.Matrix
+		start       int
+		cost        float64
+		tour        []int
+		fingerprint uint64
+	}{
+		{
+			name: "hexagon",
+			dist: euclid([][2]float64{
+				{1, 0},
+				{0.5, math.Sqrt(3) / 2},
+				{-0.5, math.Sqrt(3) / 2},
+				{-1, 0},
+				{-0.5, -math.Sqrt(3) / 2},
+				{0.5, -math.Sqrt(3) / 2},
+			}),
+			cost: 6,
+			tour: []int{0, 1, 2, 3, 4, 5, 0},
+		},
+		{
+			name: "perturbed-octagon",
+			dist: euclid(octagon),
+			cost: 6.12597991,
+			tour: []int{0, 1, 2, 3, 4, 5, 6, 7, 0},
+		},
+		{
+			name:  "k16-seed2",
+			dist:  seededSymmetricComplete(16, 2),
+			start: 2,
+			cost:  268.98,
+			tour:  []int{2, 10, 8, 11, 15, 12, 14, 1, 3, 0, 4, 5, 9, 6, 7, 13, 2},
+		},
+		{
+			name:        "k33-seed33",
+			dist:        seededSymmetricComplete(33, 33),
+			start:       5,
+			cost:        522.75,
+			fingerprint: 0x4825ff1a1e343089,
+		},
+		{
+			name:        "k128-seed424242",
+			dist:        seededSymmetricComplete(128, 424242),
+			cost:        2181.67,
+			fingerprint: 0x377d8dec52c0e749,
+		},
+		{
+			name:        "k192-seed192",
+			dist:        seededSymmetricComplete(192, 192),
+			start:       7,
+			cost:        2158.88,
+			fingerprint: 0x65acf5176dc27e7c,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := tsp.DefaultOptions()
+			opts.Algo = tsp.Christofides
+			opts.Symmetric = true
+			opts.StartVertex = tc.start
+			opts.MatchingAlgo = tsp.BlossomMatch
+			opts.EnableLocalSearch = false
+
+			result, err := tsp.ChristofidesSolve(tc.dist, opts)
+			mustNoError(t, err)
+			mustEqualFloat(t, result.Cost, tc.cost, 1e-8, "pinned Christofides cost")
+
+			if tc.tour != nil && !slices.Equal(result.Tour, tc.tour) {
+				t.Fatalf("tour moved: got %v want %v", result.Tour, tc.tour)
+			}
+			if tc.tour == nil && tourFingerprint(result.Tour) != tc.fingerprint {
+				t.Fatalf("tour fingerprint moved: got %#x want %#x tour=%v",
+					tourFingerprint(result.Tour), tc.fingerprint, result.Tour)
+			}
+		})
+	}
+}
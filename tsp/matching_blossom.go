@@ -3,13 +3,28 @@
 
 // Package tsp exposes the exact MWPM facade selected by BlossomMatch.
 // The facade builds a detached local matching problem, delegates to the dense
-// Blossom engine, verifies the result, and appends matching edges atomically.
+// Blossom engine in internal/blossom, verifies the result, and appends matching
+// edges atomically.
 package tsp
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/katalvlaran/lvlath/internal/blossom"
 	"github.com/katalvlaran/lvlath/matrix"
 )
 
+// blossomErrors maps engine sentinels onto the tsp sentinels of the same meaning.
+var blossomErrors = []struct{ engine, public error }{
+	{blossom.ErrInvalidOptions, ErrInvalidOptions},
+	{blossom.ErrInvalidMatching, ErrInvalidMatching},
+	{blossom.ErrIncompleteGraph, ErrIncompleteGraph},
+	{blossom.ErrNaNInf, ErrNaNInf},
+	{blossom.ErrNegativeWeight, ErrNegativeWeight},
+	{blossom.ErrAsymmetry, ErrAsymmetry},
+}
+
 // blossomMatch computes exact minimum-weight perfect matching for Christofides.
 // It is the only production path for MatchingAlgo==BlossomMatch.
 //
@@ -17,7 +32,7 @@ import (
 //   - Stage 1: Accept the empty odd set as a no-op.
 //   - Stage 2: Snapshot adjacency lengths for atomic rollback.
 //   - Stage 3: Build a detached local matching problem over odd vertices.
-//   - Stage 4: Solve exact MWPM through solveMinimumWeightPerfectMatching.
+//   - Stage 4: Solve exact MWPM through blossom.MinWeightPerfect.
 //   - Stage 5: Verify and append matching pairs to the Christofides multigraph.
 //
 // Behavior highlights:
@@ -79,10 +94,25 @@ func blossomMatch(odd []int, dist matrix.Matrix, adj [][]int, opts Options) (err
 	}
 
 	// Invoke the dense exact MWPM engine and keep all solver state detached from adjacency mutation.
-	match, _, _, err := solveMinimumWeightPerfectMatching(problem, blossomOptions{Eps: opts.Eps})
+	match, err := blossom.MinWeightPerfect(problem.n, problem.w, opts.Eps)
 	if err != nil {
-		return err
+		return fromBlossomError(err)
 	}
 
 	return appendPerfectMatching(problem, match, adj)
 }
+
+// fromBlossomError classifies an engine error under the matching tsp sentinel
+// and keeps the engine error in the chain for diagnostics.
+//
+// Complexity:
+//   - Time O(1), Space O(1).
+func fromBlossomError(err error) error {
+	for _, pair := range blossomErrors {
+		if errors.Is(err, pair.engine) {
+			return fmt.Errorf("%w: %w", pair.public, err)
+		}
+	}
+
+	return err
+}
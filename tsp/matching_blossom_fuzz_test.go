@@ -10,49 +10,6 @@ import (
 	"github.com/katalvlaran/lvlath/matrix"
 )
 
-/*func FuzzBlossomMatchesOracleSmall(f *testing.F) {
-	for _, seed := range []int64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89} {
-		f.Add(uint64(seed), 2)
-		f.Add(uint64(seed), 4)
-		f.Add(uint64(seed), 6)
-		f.Add(uint64(seed), 8)
-		f.Add(uint64(seed), 10)
-	}
-
-	f.Fuzz(func(t *testing.T, rawSeed uint64, rawK int) {
-		k := rawK
-		if k < 2 {
-			k = 2
-		}
-		if k > 10 {
-			k = 10
-		}
-		if (k & 1) == 1 {
-			k++
-		}
-
-		seed := int64(rawSeed)
-		problem := internalSeededMatchingProblem(k, seed)
-
-		_, wantCost, err := exactMatchingOracleForTest(problem)
-		if err != nil {
-			t.Fatalf("oracle: %v", err)
-		}
-
-		match, gotCost, stats, err := solveMinimumWeightPerfectMatching(problem, blossomOptions{Eps: DefaultEps})
-		if err != nil {
-			t.Fatalf("blossom: %v k=%d seed=%d stats=%+v", err, k, seed, stats)
-		}
-		if err = verifyPerfectMatching(match); err != nil {
-			t.Fatalf("verify: %v k=%d seed=%d match=%v stats=%+v", err, k, seed, match, stats)
-		}
-		if math.Abs(gotCost-wantCost) > DefaultEps {
-			t.Fatalf("cost got %.12f want %.12f k=%d seed=%d match=%v stats=%+v",
-				gotCost, wantCost, k, seed, match, stats)
-		}
-	})
-}*/
-
 func FuzzChristofidesBlossomMetricComplete(f *testing.F) {
 	seeds := []uint64{1, 2, 3, 5, 8, 13, 21, 34, 55, 80, 86, 89, 1001, 9001}
 	for _, seed := range seeds {
@@ -134,104 +91,6 @@ func seededMetricCompleteForTest(n int, seed int64) *matrix.Dense {
 	return dence
 }
 
-func FuzzBlossomMatchesOracleMixedSmall(f *testing.F) {
-	seeds := []uint64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 1001, 9001}
-	for _, seed := range seeds {
-		for _, k := range []int{2, 4, 6, 8, 10, 12} {
-			for mode := 0; mode < 4; mode++ {
-				f.Add(seed, k, mode)
-			}
-		}
-	}
-
-	f.Fuzz(func(t *testing.T, rawSeed uint64, rawK int, rawMode int) {
-		k := rawK
-		if k < 2 {
-			k = 2
-		}
-		if k > 12 {
-			k = 12
-		}
-		if (k & 1) == 1 {
-			k++
-		}
-
-		mode := rawMode % 4
-		if mode < 0 {
-			mode += 4
-		}
-
-		var problem matchingProblem
-		switch mode {
-		case 0:
-			problem = internalSeededMatchingProblem(k, int64(rawSeed))
-		case 1:
-			problem = internalConstantMatchingProblem(k, 1)
-		case 2:
-			problem = internalWideRangeMatchingProblem(k, int64(rawSeed))
-		case 3:
-			problem = internalNearTieMatchingProblem(k, int64(rawSeed))
-		}
-
-		_, wantCost, err := exactMatchingOracleForTest(problem)
-		if err != nil {
-			t.Fatalf("oracle: %v", err)
-		}
-
-		first, firstCost, firstStats, err := solveMinimumWeightPerfectMatching(problem, blossomOptions{Eps: DefaultEps})
-		if err != nil {
-			t.Fatalf("first blossom: %v k=%d seed=%d mode=%d stats=%+v", err, k, rawSeed, mode, firstStats)
-		}
-		if err = verifyPerfectMatching(first); err != nil {
-			t.Fatalf("first verify: %v match=%v stats=%+v", err, first, firstStats)
-		}
-		if math.Abs(firstCost-wantCost) > 1e-7*math.Max(1, math.Abs(wantCost)) {
-			t.Fatalf("cost got %.12f want %.12f k=%d seed=%d mode=%d match=%v stats=%+v",
-				firstCost, wantCost, k, rawSeed, mode, first, firstStats)
-		}
-
-		second, secondCost, secondStats, err := solveMinimumWeightPerfectMatching(problem, blossomOptions{Eps: DefaultEps})
-		if err != nil {
-			t.Fatalf("second blossom: %v k=%d seed=%d mode=%d stats=%+v", err, k, rawSeed, mode, secondStats)
-		}
-		if secondCost != firstCost {
-			t.Fatalf("nondeterministic cost got %.12f want %.12f k=%d seed=%d mode=%d first=%v second=%v",
-				secondCost, firstCost, k, rawSeed, mode, first, second)
-		}
-		for vertex := range first {
-			if second[vertex] != first[vertex] {
-				t.Fatalf("nondeterministic match[%d] got %d want %d k=%d seed=%d mode=%d",
-					vertex, second[vertex], first[vertex], k, rawSeed, mode)
-			}
-		}
-	})
-}
-
-func internalNearTieMatchingProblem(k int, seed int64) matchingProblem {
-	rng := rand.New(rand.NewSource(seed))
-
-	odd := make([]int, k)
-	w := make([]float64, k*k)
-
-	for vertex := 0; vertex < k; vertex++ {
-		odd[vertex] = vertex
-	}
-
-	for row := 0; row < k; row++ {
-		for col := row + 1; col < k; col++ {
-			value := 1000 + float64(rng.Intn(5)) + rng.Float64()*1e-6
-			w[row*k+col] = value
-			w[col*k+row] = value
-		}
-	}
-
-	return matchingProblem{
-		odd: odd,
-		w:   w,
-		n:   k,
-	}
-}
-
 func validateResultForTest(result *Result, n int, start int, algo Algorithm) error {
 	if result.Algorithm != algo {
 		return fmt.Errorf("algorithm got %v want %v", result.Algorithm, algo)
@@ -19,7 +19,7 @@ const matchingUnmatched = -1
 //
 // Implementation:
 //   - Stage 1: buildMatchingProblem validates odd-set shape and copies costs.
-//   - Stage 2: exactSmallPerfectMatching solves over local indices.
+//   - Stage 2: blossom.MinWeightPerfect solves over local indices.
 //   - Stage 3: appendPerfectMatching maps local pairs back to original vertices.
 //
 // Behavior highlights:
@@ -31,7 +31,7 @@ const matchingUnmatched = -1
 //   - Built from odd-degree MST vertices and the final symmetric distance matrix.
 //
 // Returns:
-//   - Consumed by exact matching helpers.
+//   - Consumed by blossomMatch and appendPerfectMatching.
 //
 // Errors:
 //   - Construction errors are returned by buildMatchingProblem.
@@ -97,62 +97,6 @@ func (p matchingProblem) at(i int, j int) float64 {
 	return p.w[i*p.n+j]
 }
 
-// matchingCost computes the exact cost of a verified local perfect matching.
-// It sums every pair once, using i<match[i] to avoid double-counting symmetric pairs.
-//
-// Implementation:
-//   - Stage 1: Verify the local matching structure.
-//   - Stage 2: Scan local vertices in increasing order.
-//   - Stage 3: Sum problem.at(i, match[i]) only for i<match[i].
-//   - Stage 4: Stabilize the returned cost with round1e9.
-//
-// Behavior highlights:
-//   - Does not mutate problem or match.
-//   - Does not inspect adjacency.
-//   - Uses the matching-local detached cost matrix.
-//
-// Inputs:
-//   - problem: local complete matching instance.
-//   - match: local symmetric perfect matching array.
-//
-// Returns:
-//   - float64: rounded matching cost.
-//   - error: nil on valid perfect matching.
-//
-// Errors:
-//   - ErrInvalidMatching from verifyPerfectMatching.
-//
-// Determinism:
-//   - Fixed increasing local-index scan.
-//
-// Complexity:
-//   - Time O(k), Space O(1).
-//
-// Notes:
-//   - The cost is used for verification and tests; Christofides does not need it for adjacency mutation.
-//
-// AI-Hints:
-//   - Do not sum both i→j and j→i.
-//   - Do not recompute costs from the original matrix here.
-func matchingCost(problem matchingProblem, match []int) (float64, error) {
-	if err := verifyPerfectMatching(match); err != nil {
-		return 0, err
-	}
-	if len(problem.odd) != len(match) || problem.n != len(match) {
-		return 0, ErrInvalidMatching
-	}
-
-	total := 0.0
-
-	for localVertex, partner := range match {
-		if localVertex < partner {
-			total += problem.at(localVertex, partner)
-		}
-	}
-
-	return round1e9(total), nil
-}
-
 // buildMatchingProblem builds the local complete graph induced by odd vertices.
 // It validates local matching shape and copies costs from the shared TSP weight firewall.
 //
@@ -5,8 +5,6 @@ package tsp
 
 import (
 	"errors"
-	"math"
-	"math/rand"
 	"slices"
 	"testing"
 
@@ -82,30 +80,6 @@ func internalCompleteRows(n int) [][]float64 {
 	return rows
 }
 
-func internalSeededMatchingProblem(k int, seed int64) matchingProblem {
-	rng := rand.New(rand.NewSource(seed))
-
-	problem := matchingProblem{
-		odd: make([]int, k),
-		n:   k,
-		w:   make([]float64, k*k),
-	}
-
-	for vertex := 0; vertex < k; vertex++ {
-		problem.odd[vertex] = vertex
-	}
-
-	for row := 0; row < k; row++ {
-		for col := row + 1; col < k; col++ {
-			weight := 1 + float64(rng.Intn(10_000))/100
-			problem.w[row*k+col] = weight
-			problem.w[col*k+row] = weight
-		}
-	}
-
-	return problem
-}
-
 func internalCloneAdj(adj [][]int) [][]int {
 	clone := make([][]int, len(adj))
 	for row := range adj {
@@ -129,87 +103,6 @@ func internalAdjEqual(a [][]int, b [][]int) bool {
 	return true
 }
 
-func internalEdgeID(t *testing.T, engine *blossomEngine, u int, v int) int {
-	t.Helper()
-
-	for edgeID, edge := range engine.edges {
-		if (edge.u == u && edge.v == v) || (edge.u == v && edge.v == u) {
-			return edgeID
-		}
-	}
-
-	t.Fatalf("missing edge %d-%d", u, v)
-	return noEdge
-}
-
-func exactMatchingOracleForTest(problem matchingProblem) ([]int, float64, error) {
-	if problem.n == 0 {
-		return []int{}, 0, nil
-	}
-	if (problem.n&1) == 1 || len(problem.odd) != problem.n || len(problem.w) != problem.n*problem.n {
-		return nil, 0, ErrInvalidMatching
-	}
-
-	used := make([]bool, problem.n)
-	current := make([]int, problem.n)
-	best := make([]int, problem.n)
-
-	for vertex := range current {
-		current[vertex] = matchingUnmatched
-		best[vertex] = matchingUnmatched
-	}
-
-	bestCost := math.Inf(1)
-
-	var search func(float64)
-	search = func(cost float64) {
-		first := matchingUnmatched
-		for vertex := 0; vertex < problem.n; vertex++ {
-			if !used[vertex] {
-				first = vertex
-				break
-			}
-		}
-
-		if first == matchingUnmatched {
-			if cost < bestCost {
-				bestCost = cost
-				copy(best, current)
-			}
-			return
-		}
-
-		used[first] = true
-		for partner := first + 1; partner < problem.n; partner++ {
-			if used[partner] {
-				continue
-			}
-
-			used[partner] = true
-			current[first] = partner
-			current[partner] = first
-
-			search(cost + problem.at(first, partner))
-
-			current[first] = matchingUnmatched
-			current[partner] = matchingUnmatched
-			used[partner] = false
-		}
-		used[first] = false
-	}
-
-	search(0)
-
-	if math.IsInf(bestCost, 1) {
-		return nil, 0, ErrIncompleteGraph
-	}
-	if err := verifyPerfectMatching(best); err != nil {
-		return nil, 0, err
-	}
-
-	return best, round1e9(bestCost), nil
-}
-
 func TestBuildMatchingProblemCopiesLocalCostsInOddOrder(t *testing.T) {
 	rows := [][]float64{
 		{0, 9, 1, 8},
@@ -248,33 +141,6 @@ func TestBuildMatchingProblemRejectsMalformedOddSet(t *testing.T) {
 	}
 }
 
-func TestVerifyPerfectMatchingAndMatchingCost(t *testing.T) {
-	problem := matchingProblem{
-		odd: []int{0, 1, 2, 3},
-		n:   4,
-		w: []float64{
-			0, 2, 5, 6,
-			2, 0, 7, 8,
-			5, 7, 0, 3,
-			6, 8, 3, 0,
-		},
-	}
-
-	match := []int{1, 0, 3, 2}
-
-	if err := verifyPerfectMatching(match); err != nil {
-		t.Fatalf("verifyPerfectMatching: %v", err)
-	}
-
-	cost, err := matchingCost(problem, match)
-	if err != nil {
-		t.Fatalf("matchingCost: %v", err)
-	}
-	if cost != 5 {
-		t.Fatalf("matching cost got %.3f want 5", cost)
-	}
-}
-
 func TestAppendPerfectMatchingAndRollbackAdjacency(t *testing.T) {
 	problem := matchingProblem{
 		odd: []int{3, 1, 2, 0},
@@ -305,3 +171,27 @@ func TestAppendPerfectMatchingAndRollbackAdjacency(t *testing.T) {
 		t.Fatalf("rollback failed: before=%v after=%v", before, adj)
 	}
 }
+
+func TestBlossomMatchTranslatesEngineErrorsAndRollsBack(t *testing.T) {
+	dist := internalMatrixFromRows(internalCompleteRows(4))
+	adj := [][]int{{1}, {0}, {}, {}}
+	before := internalCloneAdj(adj)
+
+	opts := DefaultOptions()
+	opts.Eps = 0
+	err := blossomMatch([]int{0, 1, 2, 3}, dist, adj, opts)
+	if !errors.Is(err, ErrInvalidOptions) {
+		t.Fatalf("invalid eps: got %v, want ErrInvalidOptions", err)
+	}
+	if !internalAdjEqual(adj, before) {
+		t.Fatalf("adjacency changed on error: before=%v after=%v", before, adj)
+	}
+
+	opts.Eps = DefaultEps
+	if err = blossomMatch([]int{0, 1, 2, 3}, dist, adj, opts); err != nil {
+		t.Fatalf("blossomMatch: %v", err)
+	}
+	if len(adj[2]) != 1 || len(adj[3]) != 1 {
+		t.Fatalf("matching edges were not appended: %v", adj)
+	}
+}